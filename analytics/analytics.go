@@ -0,0 +1,212 @@
+// Package analytics computes pipeline statistics from deals on the client,
+// for breakdowns the statistics endpoints of the API do not offer.
+//
+// Deals only tell their current stage, so a deal is taken to have passed
+// every earlier stage of its pipeline.
+package analytics
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/genert/pipedrive-api/pipedrive"
+)
+
+const timeLayout = "2006-01-02 15:04:05"
+
+// LoadDeals returns all deals matching opt.
+func LoadDeals(ctx context.Context, client *pipedrive.Client, opt *pipedrive.DealsListOptions) ([]pipedrive.Deal, error) {
+	deals, _, err := client.Deals.ListAll(ctx, opt)
+
+	return deals, err
+}
+
+// StageConversion represents how many deals moved on from a stage.
+type StageConversion struct {
+	StageID    int
+	StageName  string
+	PipelineID int
+
+	// Reached counts the deals that reached the stage, Advanced those that
+	// reached the next stage of the pipeline or were won.
+	Reached  int
+	Advanced int
+}
+
+// Rate returns the share of deals that advanced, 0 when no deal reached
+// the stage.
+func (c StageConversion) Rate() float64 {
+	if c.Reached == 0 {
+		return 0
+	}
+
+	return float64(c.Advanced) / float64(c.Reached)
+}
+
+// StageConversions returns the conversion of every stage, ordered by
+// pipeline and stage order. Deleted deals are ignored.
+func StageConversions(deals []pipedrive.Deal, stages []pipedrive.Stage) []StageConversion {
+	stages = sortedStages(stages)
+
+	position := make(map[int]int, len(stages))
+
+	for i, stage := range stages {
+		position[stage.ID] = i
+	}
+
+	conversions := make([]StageConversion, len(stages))
+
+	for i, stage := range stages {
+		conversions[i] = StageConversion{StageID: stage.ID, StageName: stage.Name, PipelineID: stage.PipelineID}
+	}
+
+	for _, deal := range deals {
+		current, ok := position[deal.StageID]
+
+		if !ok || deal.Deleted || deal.Status == pipedrive.DealStatusDeleted {
+			continue
+		}
+
+		for i := current; i >= 0 && stages[i].PipelineID == stages[current].PipelineID; i-- {
+			conversions[i].Reached++
+
+			if i < current || deal.Status == pipedrive.DealStatusWon {
+				conversions[i].Advanced++
+			}
+		}
+	}
+
+	return conversions
+}
+
+// StageDuration represents how long open deals have been in a stage.
+type StageDuration struct {
+	StageID int
+	Deals   int
+	Average time.Duration
+}
+
+// AverageTimeInStage returns, per stage, the average time open deals have
+// spent in their current stage up to now, ordered by stage ID.
+func AverageTimeInStage(deals []pipedrive.Deal, now time.Time) []StageDuration {
+	totals := make(map[int]time.Duration)
+	counts := make(map[int]int)
+
+	for _, deal := range deals {
+		if deal.Status != pipedrive.DealStatusOpen {
+			continue
+		}
+
+		since, err := time.Parse(timeLayout, deal.StageChangeTime)
+
+		if err != nil {
+			if since, err = time.Parse(timeLayout, deal.AddTime); err != nil {
+				continue
+			}
+		}
+
+		totals[deal.StageID] += now.Sub(since)
+		counts[deal.StageID]++
+	}
+
+	durations := make([]StageDuration, 0, len(counts))
+
+	for stageID, count := range counts {
+		durations = append(durations, StageDuration{
+			StageID: stageID,
+			Deals:   count,
+			Average: totals[stageID] / time.Duration(count),
+		})
+	}
+
+	sort.Sort(byStageID(durations))
+
+	return durations
+}
+
+// OwnerWinRate represents the closed deals of an owner.
+type OwnerWinRate struct {
+	OwnerID   int
+	OwnerName string
+	Won       int
+	Lost      int
+}
+
+// Rate returns the share of closed deals that were won, 0 when the owner
+// closed no deals.
+func (w OwnerWinRate) Rate() float64 {
+	if w.Won+w.Lost == 0 {
+		return 0
+	}
+
+	return float64(w.Won) / float64(w.Won+w.Lost)
+}
+
+// WinRateByOwner returns the win rate of every owner with closed deals,
+// ordered by owner ID.
+func WinRateByOwner(deals []pipedrive.Deal) []OwnerWinRate {
+	rates := make(map[int]*OwnerWinRate)
+
+	for _, deal := range deals {
+		if deal.Status != pipedrive.DealStatusWon && deal.Status != pipedrive.DealStatusLost {
+			continue
+		}
+
+		rate, ok := rates[deal.UserID.ID]
+
+		if !ok {
+			rate = &OwnerWinRate{OwnerID: deal.UserID.ID, OwnerName: deal.UserID.Name}
+			rates[deal.UserID.ID] = rate
+		}
+
+		if deal.Status == pipedrive.DealStatusWon {
+			rate.Won++
+		} else {
+			rate.Lost++
+		}
+	}
+
+	result := make([]OwnerWinRate, 0, len(rates))
+
+	for _, rate := range rates {
+		result = append(result, *rate)
+	}
+
+	sort.Sort(byOwnerID(result))
+
+	return result
+}
+
+func sortedStages(stages []pipedrive.Stage) []pipedrive.Stage {
+	sorted := make([]pipedrive.Stage, len(stages))
+	copy(sorted, stages)
+	sort.Sort(byPipelineOrder(sorted))
+
+	return sorted
+}
+
+type byPipelineOrder []pipedrive.Stage
+
+func (s byPipelineOrder) Len() int      { return len(s) }
+func (s byPipelineOrder) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+
+func (s byPipelineOrder) Less(i, j int) bool {
+	if s[i].PipelineID != s[j].PipelineID {
+		return s[i].PipelineID < s[j].PipelineID
+	}
+
+	return s[i].OrderNr < s[j].OrderNr
+}
+
+type byStageID []StageDuration
+
+func (s byStageID) Len() int           { return len(s) }
+func (s byStageID) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byStageID) Less(i, j int) bool { return s[i].StageID < s[j].StageID }
+
+type byOwnerID []OwnerWinRate
+
+func (s byOwnerID) Len() int           { return len(s) }
+func (s byOwnerID) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byOwnerID) Less(i, j int) bool { return s[i].OwnerID < s[j].OwnerID }
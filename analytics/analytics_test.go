@@ -0,0 +1,132 @@
+package analytics
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/genert/pipedrive-api/pipedrive"
+)
+
+// stages are two pipelines, listed out of order.
+var stages = []pipedrive.Stage{
+	{ID: 3, Name: "Won over", PipelineID: 1, OrderNr: 3},
+	{ID: 1, Name: "Lead", PipelineID: 1, OrderNr: 1},
+	{ID: 2, Name: "Offer", PipelineID: 1, OrderNr: 2},
+	{ID: 10, Name: "Intake", PipelineID: 2, OrderNr: 1},
+}
+
+func deal(stageID int, status pipedrive.DealStatus) pipedrive.Deal {
+	return pipedrive.Deal{StageID: stageID, Status: status}
+}
+
+func TestStageConversions(t *testing.T) {
+	deleted := deal(2, pipedrive.DealStatusOpen)
+	deleted.Deleted = true
+
+	deals := []pipedrive.Deal{
+		deal(1, pipedrive.DealStatusOpen),
+		deal(2, pipedrive.DealStatusLost),
+		deal(3, pipedrive.DealStatusWon),
+		deal(10, pipedrive.DealStatusOpen),
+		deal(99, pipedrive.DealStatusOpen),
+		deleted,
+	}
+
+	want := []StageConversion{
+		{StageID: 1, StageName: "Lead", PipelineID: 1, Reached: 3, Advanced: 2},
+		{StageID: 2, StageName: "Offer", PipelineID: 1, Reached: 2, Advanced: 1},
+		{StageID: 3, StageName: "Won over", PipelineID: 1, Reached: 1, Advanced: 1},
+		{StageID: 10, StageName: "Intake", PipelineID: 2, Reached: 1, Advanced: 0},
+	}
+
+	if got := StageConversions(deals, stages); !reflect.DeepEqual(got, want) {
+		t.Errorf("StageConversions returned %+v, want %+v", got, want)
+	}
+
+	if stages[0].ID != 3 {
+		t.Error("StageConversions reordered the stages given")
+	}
+}
+
+func TestStageConversion_Rate(t *testing.T) {
+	tests := []struct {
+		conversion StageConversion
+		want       float64
+	}{
+		{conversion: StageConversion{}, want: 0},
+		{conversion: StageConversion{Reached: 4, Advanced: 1}, want: 0.25},
+		{conversion: StageConversion{Reached: 2, Advanced: 2}, want: 1},
+	}
+
+	for _, tt := range tests {
+		if got := tt.conversion.Rate(); got != tt.want {
+			t.Errorf("Rate of %+v returned %v, want %v", tt.conversion, got, tt.want)
+		}
+	}
+}
+
+func TestAverageTimeInStage(t *testing.T) {
+	now := time.Date(2019, 6, 10, 10, 0, 0, 0, time.UTC)
+
+	moved := deal(1, pipedrive.DealStatusOpen)
+	moved.StageChangeTime = "2019-06-08 10:00:00"
+
+	added := deal(1, pipedrive.DealStatusOpen)
+	added.AddTime = "2019-06-06 10:00:00"
+
+	other := deal(2, pipedrive.DealStatusOpen)
+	other.StageChangeTime = "2019-06-09 22:00:00"
+
+	won := deal(2, pipedrive.DealStatusWon)
+	won.StageChangeTime = "2019-01-01 00:00:00"
+
+	unknown := deal(3, pipedrive.DealStatusOpen)
+
+	want := []StageDuration{
+		{StageID: 1, Deals: 2, Average: 72 * time.Hour},
+		{StageID: 2, Deals: 1, Average: 12 * time.Hour},
+	}
+
+	if got := AverageTimeInStage([]pipedrive.Deal{other, moved, won, added, unknown}, now); !reflect.DeepEqual(got, want) {
+		t.Errorf("AverageTimeInStage returned %+v, want %+v", got, want)
+	}
+}
+
+func TestWinRateByOwner(t *testing.T) {
+	owned := func(ownerID int, name string, status pipedrive.DealStatus) pipedrive.Deal {
+		d := deal(1, status)
+		d.UserID.ID = ownerID
+		d.UserID.Name = name
+
+		return d
+	}
+
+	deals := []pipedrive.Deal{
+		owned(2, "Bob", pipedrive.DealStatusLost),
+		owned(1, "Ann", pipedrive.DealStatusWon),
+		owned(1, "Ann", pipedrive.DealStatusLost),
+		owned(1, "Ann", pipedrive.DealStatusWon),
+		owned(3, "Cid", pipedrive.DealStatusOpen),
+	}
+
+	tests := []struct {
+		want OwnerWinRate
+		rate float64
+	}{
+		{want: OwnerWinRate{OwnerID: 1, OwnerName: "Ann", Won: 2, Lost: 1}, rate: 2.0 / 3},
+		{want: OwnerWinRate{OwnerID: 2, OwnerName: "Bob", Lost: 1}, rate: 0},
+	}
+
+	got := WinRateByOwner(deals)
+
+	if len(got) != len(tests) {
+		t.Fatalf("WinRateByOwner returned %+v, want %v owners", got, len(tests))
+	}
+
+	for i, tt := range tests {
+		if got[i] != tt.want || got[i].Rate() != tt.rate {
+			t.Errorf("WinRateByOwner returned %+v with rate %v, want %+v with rate %v", got[i], got[i].Rate(), tt.want, tt.rate)
+		}
+	}
+}
@@ -0,0 +1,405 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/genert/pipedrive-api/pipedrive"
+)
+
+const (
+	defaultDownloadWorkers    = 4
+	defaultDownloadRetries    = 3
+	defaultDownloadRetryDelay = time.Second
+)
+
+// ContentSink is a Sink that also stores the contents of files. FileSink
+// and S3Sink implement it. WriteContent is called concurrently.
+type ContentSink interface {
+	Sink
+
+	// WriteContent stores the content read from r under the name, such
+	// as files/12/report.pdf. When reading fails, nothing may be kept
+	// under the name.
+	WriteContent(name string, r io.Reader) error
+}
+
+// SizeError is returned when the content downloaded for a file does not
+// have the size the file is listed with.
+type SizeError struct {
+	FileID   int
+	Expected int64
+	Actual   int64
+}
+
+func (e *SizeError) Error() string {
+	return fmt.Sprintf("file %v: downloaded %v bytes, expected %v", e.FileID, e.Actual, e.Expected)
+}
+
+// DownloadResult is the outcome of downloading one file.
+type DownloadResult struct {
+	File pipedrive.File
+
+	// Name is the name the content is stored under.
+	Name     string
+	Size     int64
+	Attempts int
+	Err      error
+}
+
+// DownloadReport summarizes a download.
+type DownloadReport struct {
+	Downloaded int
+	Bytes      int64
+
+	// Skipped counts the remote files, such as Google Drive documents,
+	// whose content is not stored by Pipedrive.
+	Skipped int
+
+	Failed []DownloadResult
+}
+
+// Downloader copies the contents of files into a ContentSink. Files are
+// downloaded concurrently through a pipedrive.Queue, which shares the rate
+// budget between the downloads and pauses them all when the rate limit is
+// hit. Failed downloads are retried, and the size of every content is
+// checked against the size the file is listed with.
+type Downloader struct {
+	client *pipedrive.Client
+
+	// Workers is the number of files downloaded at the same time, 4 when
+	// zero.
+	Workers int
+
+	// Rate is the number of downloads started per second, that of
+	// pipedrive.Queue when zero.
+	Rate float64
+
+	// MaxRetries is how often a failed download is retried, 3 when zero.
+	// Downloads are not retried when it is negative.
+	MaxRetries int
+
+	// RetryDelay is the time before the first retry, 1 second when zero.
+	// Every further retry waits one delay longer.
+	RetryDelay time.Duration
+
+	// Name returns the name the content of a file is stored under,
+	// files/<id>/<file name> when nil.
+	Name func(pipedrive.File) string
+
+	// Progress is called after every file, one file at a time.
+	Progress func(DownloadResult)
+}
+
+// NewDownloader returns a Downloader reading with the client.
+func NewDownloader(client *pipedrive.Client) *Downloader {
+	return &Downloader{client: client}
+}
+
+// Download stores the contents of the files in sink, which is not closed.
+// Files failing after all retries are listed in the report. When ctx is
+// done, the files not downloaded yet fail with ctx.Err(), which is also
+// returned.
+func (d *Downloader) Download(ctx context.Context, sink ContentSink, files []pipedrive.File) (*DownloadReport, error) {
+	return d.download(ctx, sink, files, false)
+}
+
+// DownloadIDs stores the contents of the files with the IDs in sink, see
+// Download. The details of every file are looked up first.
+func (d *Downloader) DownloadIDs(ctx context.Context, sink ContentSink, ids []int) (*DownloadReport, error) {
+	files := make([]pipedrive.File, len(ids))
+
+	for i, id := range ids {
+		files[i].ID = id
+	}
+
+	return d.download(ctx, sink, files, true)
+}
+
+// DownloadFor stores the contents of the files attached to a record, such
+// as all files of a deal, in sink, see Download. entity is the resource of
+// the record: deals, persons, organizations or products.
+func (d *Downloader) DownloadFor(ctx context.Context, sink ContentSink, entity string, id int) (*DownloadReport, error) {
+	path := fmt.Sprintf("/%v/%v/files", entity, id)
+	opt := &listOptions{Limit: pageLimit}
+	pager := d.client.NewPager(path, 0)
+
+	var files []pipedrive.File
+
+	for {
+		opt.Start = pager.Start
+		req, err := d.client.NewRequest(http.MethodGet, path, opt, nil)
+
+		if err != nil {
+			return nil, err
+		}
+
+		var page pipedrive.FilesResponse
+
+		if _, err := d.client.Do(ctx, req, &page); err != nil {
+			return nil, err
+		}
+
+		files = append(files, page.Data...)
+
+		if more, err := pager.Next(page.AdditionalData.Pagination, len(page.Data)); !more {
+			if err != nil {
+				return nil, err
+			}
+
+			break
+		}
+	}
+
+	return d.Download(ctx, sink, files)
+}
+
+func (d *Downloader) download(ctx context.Context, sink ContentSink, files []pipedrive.File, lookup bool) (*DownloadReport, error) {
+	workers := d.Workers
+
+	if workers < 1 {
+		workers = defaultDownloadWorkers
+	}
+
+	queue := d.client.NewQueue()
+	queue.Workers = workers
+	queue.Rate = d.Rate
+
+	if err := queue.Start(ctx); err != nil {
+		return nil, err
+	}
+
+	defer queue.Shutdown(ctx)
+
+	report := &DownloadReport{}
+	indexes := make(chan int)
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+
+	for worker := 0; worker < workers; worker++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				result := d.downloadFile(ctx, queue, sink, files[i], lookup)
+
+				mu.Lock()
+				report.add(result)
+
+				if d.Progress != nil {
+					d.Progress(result)
+				}
+
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for i := range files {
+		indexes <- i
+	}
+
+	close(indexes)
+	wg.Wait()
+
+	return report, ctx.Err()
+}
+
+func (r *DownloadReport) add(result DownloadResult) {
+	switch {
+	case result.Err != nil:
+		r.Failed = append(r.Failed, result)
+	case result.File.IsRemote():
+		r.Skipped++
+	default:
+		r.Downloaded++
+		r.Bytes += result.Size
+	}
+}
+
+// downloadFile downloads a file, retrying failures that may pass.
+func (d *Downloader) downloadFile(ctx context.Context, queue *pipedrive.Queue, sink ContentSink, file pipedrive.File, lookup bool) DownloadResult {
+	maxRetries := d.MaxRetries
+
+	switch {
+	case maxRetries == 0:
+		maxRetries = defaultDownloadRetries
+	case maxRetries < 0:
+		maxRetries = 0
+	}
+
+	delay := d.RetryDelay
+
+	if delay <= 0 {
+		delay = defaultDownloadRetryDelay
+	}
+
+	result := DownloadResult{File: file}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			result.Err = err
+			return result
+		}
+
+		result.Attempts++
+		result.Err = d.attempt(ctx, queue, sink, &result, lookup)
+
+		if result.Err == nil || result.Attempts > maxRetries || !retryableDownloadError(result.Err) {
+			return result
+		}
+
+		timer := time.NewTimer(time.Duration(result.Attempts) * delay)
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			result.Err = ctx.Err()
+
+			return result
+		}
+	}
+}
+
+func (d *Downloader) attempt(ctx context.Context, queue *pipedrive.Queue, sink ContentSink, result *DownloadResult, lookup bool) error {
+	if lookup && result.File.FileName == "" {
+		err := <-queue.Enqueue(ctx, pipedrive.PriorityNormal, func(ctx context.Context, c *pipedrive.Client) error {
+			file, _, err := c.Files.GetByID(ctx, result.File.ID)
+
+			if err == nil {
+				result.File = file.Data
+			}
+
+			return err
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if result.File.IsRemote() {
+		return nil
+	}
+
+	result.Name = d.name(result.File)
+
+	// The result is only written by the job, so its error is waited for
+	// even when ctx is done.
+	return <-queue.Enqueue(ctx, pipedrive.PriorityNormal, func(ctx context.Context, c *pipedrive.Client) error {
+		content, _, err := c.Files.Open(ctx, result.File.ID)
+
+		if err != nil {
+			return err
+		}
+
+		defer content.Close()
+
+		expected := result.File.FileSize
+
+		if expected == 0 {
+			expected = content.Size
+		}
+
+		r := &sizeReader{r: content, fileID: result.File.ID, expected: expected}
+
+		if err := sink.WriteContent(result.Name, r); err != nil {
+			if r.err != nil {
+				return r.err
+			}
+
+			return sinkError{err}
+		}
+
+		result.Size = r.n
+
+		return nil
+	})
+}
+
+func (d *Downloader) name(file pipedrive.File) string {
+	if d.Name != nil {
+		return d.Name(file)
+	}
+
+	name := file.FileName
+
+	if name == "" {
+		name = file.Name
+	}
+
+	name = strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r < ' ' {
+			return '_'
+		}
+
+		return r
+	}, name)
+
+	if name == "" || name == "." || name == ".." {
+		name = "file"
+	}
+
+	return fmt.Sprintf("files/%v/%v", file.ID, name)
+}
+
+// sinkError marks errors of the sink, which are not retried.
+type sinkError struct {
+	err error
+}
+
+func (e sinkError) Error() string {
+	return "sink: " + e.err.Error()
+}
+
+// retryableDownloadError reports whether a download may succeed when it
+// is tried again.
+func retryableDownloadError(err error) bool {
+	switch err := err.(type) {
+	case sinkError:
+		return false
+	case *pipedrive.ErrorResponse:
+		code := err.Response.StatusCode
+
+		return code >= 500 || code == http.StatusTooManyRequests || code == http.StatusRequestTimeout
+	}
+
+	return err != context.Canceled && err != context.DeadlineExceeded
+}
+
+// sizeReader counts the bytes read and fails with a *SizeError when the
+// content is not of the expected size, -1 for unknown. It keeps the error
+// of the download, to tell it apart from errors of the sink.
+type sizeReader struct {
+	r        io.Reader
+	fileID   int
+	expected int64
+	n        int64
+	err      error
+}
+
+func (r *sizeReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+
+	if r.expected >= 0 && (r.n > r.expected || err == io.EOF && r.n != r.expected) {
+		err = &SizeError{FileID: r.fileID, Expected: r.expected, Actual: r.n}
+	}
+
+	if err != nil && err != io.EOF {
+		r.err = err
+	}
+
+	return n, err
+}
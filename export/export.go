@@ -0,0 +1,131 @@
+// Package export dumps the entities of a Pipedrive account into a Sink,
+// such as files on disk or objects in S3-compatible storage. Records are
+// written as the JSON returned by the API, one entity at a time, so the
+// export is never held in memory. A Restorer replays an export into
+// another account, and a Downloader copies the contents of files.
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/genert/pipedrive-api/pipedrive"
+)
+
+const pageLimit = 500
+
+// DefaultEntities are the entities exported when Exporter.Entities is
+// empty. Each entity is exported from the resource of the same name.
+var DefaultEntities = []string{
+	"users",
+	"pipelines",
+	"stages",
+	"dealFields",
+	"personFields",
+	"organizationFields",
+	"productFields",
+	"organizations",
+	"persons",
+	"deals",
+	"products",
+	"activities",
+	"notes",
+	"files",
+}
+
+// Sink receives the exported records.
+type Sink interface {
+	// Write writes one record of the given entity.
+	Write(entity string, record json.RawMessage) error
+
+	// Close flushes the written records. The export is incomplete when
+	// Close fails.
+	Close() error
+}
+
+// Exporter exports the entities of an account.
+type Exporter struct {
+	client *pipedrive.Client
+
+	// Entities are the resources to export, DefaultEntities when empty.
+	Entities []string
+
+	// Progress is called after every page with the number of records of
+	// the entity exported so far.
+	Progress func(entity string, exported int)
+}
+
+// NewExporter returns an Exporter reading with the client.
+func NewExporter(client *pipedrive.Client) *Exporter {
+	return &Exporter{client: client}
+}
+
+// Export writes every record of the entities to sink and closes it. The
+// sink is closed even when the export fails.
+func (e *Exporter) Export(ctx context.Context, sink Sink) (err error) {
+	defer func() {
+		if closeErr := sink.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	entities := e.Entities
+
+	if len(entities) == 0 {
+		entities = DefaultEntities
+	}
+
+	for _, entity := range entities {
+		if err := e.exportEntity(ctx, entity, sink); err != nil {
+			return fmt.Errorf("export %v: %v", entity, err)
+		}
+	}
+
+	return nil
+}
+
+func (e *Exporter) exportEntity(ctx context.Context, entity string, sink Sink) error {
+	opt := &listOptions{Limit: pageLimit}
+	pager := e.client.NewPager("/"+entity, 0)
+
+	for {
+		opt.Start = pager.Start
+		req, err := e.client.NewRequest(http.MethodGet, "/"+entity, opt, nil)
+
+		if err != nil {
+			return err
+		}
+
+		var page struct {
+			Data           []json.RawMessage        `json:"data"`
+			AdditionalData pipedrive.AdditionalData `json:"additional_data"`
+		}
+
+		if _, err := e.client.Do(ctx, req, &page); err != nil {
+			return err
+		}
+
+		for _, record := range page.Data {
+			if err := sink.Write(entity, record); err != nil {
+				return err
+			}
+		}
+
+		more, err := pager.Next(page.AdditionalData.Pagination, len(page.Data))
+
+		if e.Progress != nil {
+			e.Progress(entity, pager.Items)
+		}
+
+		if !more {
+			return err
+		}
+	}
+}
+
+type listOptions struct {
+	Start uint `url:"start,omitempty"`
+	Limit uint `url:"limit,omitempty"`
+}
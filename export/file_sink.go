@@ -0,0 +1,109 @@
+package export
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FileSink writes the records of each entity as JSON lines to the file
+// <entity>.jsonl in a directory.
+type FileSink struct {
+	dir   string
+	files map[string]*os.File
+	bufs  map[string]*bufio.Writer
+}
+
+// NewFileSink returns a FileSink writing to dir, which is created when it
+// does not exist. Existing files are replaced.
+func NewFileSink(dir string) (*FileSink, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	return &FileSink{
+		dir:   dir,
+		files: make(map[string]*os.File),
+		bufs:  make(map[string]*bufio.Writer),
+	}, nil
+}
+
+// Write appends a record to the file of the entity.
+func (s *FileSink) Write(entity string, record json.RawMessage) error {
+	buf, ok := s.bufs[entity]
+
+	if !ok {
+		file, err := os.Create(filepath.Join(s.dir, entity+".jsonl"))
+
+		if err != nil {
+			return err
+		}
+
+		buf = bufio.NewWriter(file)
+		s.files[entity] = file
+		s.bufs[entity] = buf
+	}
+
+	if _, err := buf.Write(record); err != nil {
+		return err
+	}
+
+	return buf.WriteByte('\n')
+}
+
+// Close flushes and closes the files.
+func (s *FileSink) Close() error {
+	var err error
+
+	for entity, file := range s.files {
+		if flushErr := s.bufs[entity].Flush(); flushErr != nil && err == nil {
+			err = flushErr
+		}
+
+		if closeErr := file.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+
+	s.files = make(map[string]*os.File)
+	s.bufs = make(map[string]*bufio.Writer)
+
+	return err
+}
+
+// WriteContent implements ContentSink, the content is written to the file
+// name below the directory. A file whose content fails is removed.
+func (s *FileSink) WriteContent(name string, r io.Reader) error {
+	dir := filepath.Clean(s.dir)
+	path := filepath.Join(dir, filepath.FromSlash(name))
+
+	if !strings.HasPrefix(path, dir+string(filepath.Separator)) {
+		return fmt.Errorf("content name %q is outside of the directory", name)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	file, err := os.Create(path)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(file, r)
+
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		os.Remove(path)
+	}
+
+	return err
+}
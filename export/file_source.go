@@ -0,0 +1,52 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// FileSource reads the records written by a FileSink.
+type FileSource struct {
+	dir string
+}
+
+// NewFileSource returns a FileSource reading the files in dir.
+func NewFileSource(dir string) *FileSource {
+	return &FileSource{dir: dir}
+}
+
+// Read calls fn with every record in the file of the entity, in the order
+// they were written. An entity without file has no records.
+func (s *FileSource) Read(entity string, fn func(record json.RawMessage) error) error {
+	file, err := os.Open(filepath.Join(s.dir, entity+".jsonl"))
+
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 64<<20)
+
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+
+		if len(line) == 0 {
+			continue
+		}
+
+		if err := fn(json.RawMessage(append([]byte(nil), line...))); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
@@ -0,0 +1,653 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/genert/pipedrive-api/idmap"
+	"github.com/genert/pipedrive-api/pipedrive"
+)
+
+// Source reads the records of a snapshot written by an Exporter.
+type Source interface {
+	// Read calls fn with every record of the entity. An entity missing
+	// from the snapshot has no records.
+	Read(entity string, fn func(record json.RawMessage) error) error
+}
+
+// restoreEntity describes how the records of an entity are restored.
+type restoreEntity struct {
+	name string
+
+	// fields is the resource of the custom fields, empty for none.
+	fields string
+
+	// writable are the keys copied from the snapshot, refs the keys
+	// holding the ID of a record of another entity.
+	writable []string
+	refs     map[string]string
+}
+
+// restoreOrder lists the restored entities so that every record is
+// created after the records it refers to.
+var restoreOrder = []restoreEntity{
+	{
+		name:     "pipelines",
+		writable: []string{"name", "order_nr", "active", "deal_probability"},
+	},
+	{
+		name:     "stages",
+		writable: []string{"name", "pipeline_id", "order_nr", "deal_probability", "rotten_flag", "rotten_days"},
+		refs:     map[string]string{"pipeline_id": "pipelines"},
+	},
+	{
+		name:     "organizations",
+		fields:   "organizationFields",
+		writable: []string{"name", "owner_id", "visible_to", "address"},
+		refs:     map[string]string{"owner_id": "users"},
+	},
+	{
+		name:     "persons",
+		fields:   "personFields",
+		writable: []string{"name", "owner_id", "org_id", "email", "phone", "visible_to"},
+		refs:     map[string]string{"owner_id": "users", "org_id": "organizations"},
+	},
+	{
+		name:     "products",
+		fields:   "productFields",
+		writable: []string{"name", "code", "unit", "tax", "active_flag", "selectable", "visible_to", "owner_id", "prices"},
+		refs:     map[string]string{"owner_id": "users"},
+	},
+	{
+		name:   "deals",
+		fields: "dealFields",
+		writable: []string{
+			"title", "value", "currency", "user_id", "person_id", "org_id", "pipeline_id", "stage_id",
+			"status", "probability", "lost_reason", "add_time", "won_time", "lost_time", "close_time",
+			"expected_close_date", "visible_to",
+		},
+		refs: map[string]string{
+			"user_id":     "users",
+			"person_id":   "persons",
+			"org_id":      "organizations",
+			"pipeline_id": "pipelines",
+			"stage_id":    "stages",
+		},
+	},
+	{
+		name: "activities",
+		writable: []string{
+			"subject", "type", "done", "due_date", "due_time", "duration", "user_id", "deal_id",
+			"person_id", "org_id", "note", "location", "public_description", "busy_flag",
+		},
+		refs: map[string]string{
+			"user_id":   "users",
+			"deal_id":   "deals",
+			"person_id": "persons",
+			"org_id":    "organizations",
+		},
+	},
+	{
+		name: "notes",
+		writable: []string{
+			"content", "deal_id", "person_id", "org_id", "add_time",
+			"pinned_to_deal_flag", "pinned_to_person_flag", "pinned_to_organization_flag",
+		},
+		refs: map[string]string{
+			"deal_id":   "deals",
+			"person_id": "persons",
+			"org_id":    "organizations",
+		},
+	},
+}
+
+// labelFieldKey is the key of the label field, whose options differ
+// between accounts like those of custom fields.
+const labelFieldKey = "label"
+
+// customFieldRefs are the custom field types holding the ID of a record
+// of another entity.
+var customFieldRefs = map[string]string{
+	"org":    "organizations",
+	"people": "persons",
+	"user":   "users",
+}
+
+// customFieldSuffixes are the subfields written along with custom fields
+// of some types, such as the currency of monetary fields.
+var customFieldSuffixes = []string{"_currency", "_until"}
+
+// Restorer replays a snapshot into an account, typically a sandbox. Users
+// cannot be created through the API, they are matched by email to the
+// users of the account. Pipelines, stages and custom fields are created
+// first, then the records of the other entities in dependency order.
+// References between records are translated through IDs.
+//
+// Files are not restored, as the snapshot holds their metadata only.
+type Restorer struct {
+	client *pipedrive.Client
+
+	// IDs links the IDs of the restored records, as Pipedrive IDs, to
+	// the IDs of the snapshot, as external IDs, by entity such as "deals"
+	// or "dealFields". Records already linked are not restored again, so
+	// a failed restore is resumed by running it again with the same
+	// store, such as an idmap.SQL. Users can be linked to map them when
+	// their emails differ.
+	IDs idmap.Store
+
+	// Progress is called after every record with the number of records
+	// of the entity restored so far.
+	Progress func(entity string, restored int)
+
+	fields map[string]*restoredFields
+}
+
+// restoredFields translates the custom fields of an entity.
+type restoredFields struct {
+	keys    map[string]string
+	types   map[string]string
+	options map[string]map[string]string
+}
+
+// snapshotField is a field definition as written in a snapshot.
+type snapshotField struct {
+	ID        int    `json:"id"`
+	Key       string `json:"key"`
+	Name      string `json:"name"`
+	FieldType string `json:"field_type"`
+	EditFlag  bool   `json:"edit_flag"`
+	Options   []struct {
+		ID    json.RawMessage `json:"id"`
+		Label string          `json:"label"`
+	} `json:"options"`
+}
+
+// NewRestorer returns a Restorer writing with the client.
+func NewRestorer(client *pipedrive.Client) *Restorer {
+	return &Restorer{client: client, IDs: idmap.NewMemory()}
+}
+
+// Restore creates the records of the snapshot read from source.
+func (r *Restorer) Restore(ctx context.Context, source Source) error {
+	if r.IDs == nil {
+		r.IDs = idmap.NewMemory()
+	}
+
+	if err := r.restoreUsers(ctx, source); err != nil {
+		return fmt.Errorf("restore users: %v", err)
+	}
+
+	r.fields = make(map[string]*restoredFields)
+
+	for _, entity := range restoreOrder {
+		if entity.fields == "" {
+			continue
+		}
+
+		if err := r.restoreFields(ctx, source, entity.fields); err != nil {
+			return fmt.Errorf("restore %v: %v", entity.fields, err)
+		}
+	}
+
+	for _, entity := range restoreOrder {
+		if err := r.restoreEntity(ctx, source, entity); err != nil {
+			return fmt.Errorf("restore %v: %v", entity.name, err)
+		}
+	}
+
+	return nil
+}
+
+// restoreUsers maps the users of the snapshot to the users of the account
+// with the same email.
+func (r *Restorer) restoreUsers(ctx context.Context, source Source) error {
+	type user struct {
+		ID    int    `json:"id"`
+		Email string `json:"email"`
+	}
+
+	byEmail := make(map[string]int)
+
+	err := r.list(ctx, "/users", func(record json.RawMessage) error {
+		var u user
+
+		if err := json.Unmarshal(record, &u); err != nil {
+			return err
+		}
+
+		byEmail[strings.ToLower(u.Email)] = u.ID
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return source.Read("users", func(record json.RawMessage) error {
+		var u user
+
+		if err := json.Unmarshal(record, &u); err != nil {
+			return err
+		}
+
+		if _, ok, err := r.restoredID("users", u.ID); ok || err != nil {
+			return err
+		}
+
+		if id, ok := byEmail[strings.ToLower(u.Email)]; ok {
+			return r.link("users", u.ID, id)
+		}
+
+		return nil
+	})
+}
+
+// restoreFields creates the custom fields of the snapshot that are not
+// in the account yet, and matches their options by label.
+func (r *Restorer) restoreFields(ctx context.Context, source Source, resource string) error {
+	existing := make(map[int]snapshotField)
+
+	err := r.list(ctx, "/"+resource, func(record json.RawMessage) error {
+		var field snapshotField
+
+		if err := json.Unmarshal(record, &field); err != nil {
+			return err
+		}
+
+		existing[field.ID] = field
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	fields := &restoredFields{
+		keys:    make(map[string]string),
+		types:   make(map[string]string),
+		options: make(map[string]map[string]string),
+	}
+
+	r.fields[resource] = fields
+	restored := 0
+
+	return source.Read(resource, func(record json.RawMessage) error {
+		var field snapshotField
+
+		if err := json.Unmarshal(record, &field); err != nil {
+			return err
+		}
+
+		var target snapshotField
+		var ok bool
+
+		switch {
+		case field.Key == labelFieldKey:
+			if target, ok = existingByKey(existing, labelFieldKey); !ok {
+				return nil
+			}
+		case !field.EditFlag:
+			return nil
+		default:
+			id, restored, err := r.restoredID(resource, field.ID)
+
+			if err != nil {
+				return err
+			}
+
+			if restored {
+				target, ok = existing[id]
+			}
+
+			if ok {
+				break
+			}
+
+			created, err := r.createField(ctx, resource, field)
+
+			if err != nil {
+				return fmt.Errorf("field %v: %v", field.Key, err)
+			}
+
+			if err := r.link(resource, field.ID, created.ID); err != nil {
+				return err
+			}
+
+			target = created
+		}
+
+		fields.keys[field.Key] = target.Key
+		fields.types[field.Key] = field.FieldType
+		options := make(map[string]string)
+
+		for _, option := range field.Options {
+			for _, targetOption := range target.Options {
+				if option.Label == targetOption.Label {
+					options[rawID(option.ID)] = rawID(targetOption.ID)
+					break
+				}
+			}
+		}
+
+		fields.options[field.Key] = options
+		restored++
+
+		if r.Progress != nil {
+			r.Progress(resource, restored)
+		}
+
+		return nil
+	})
+}
+
+func existingByKey(fields map[int]snapshotField, key string) (snapshotField, bool) {
+	for _, field := range fields {
+		if field.Key == key {
+			return field, true
+		}
+	}
+
+	return snapshotField{}, false
+}
+
+func (r *Restorer) createField(ctx context.Context, resource string, field snapshotField) (snapshotField, error) {
+	type option struct {
+		Label string `json:"label"`
+	}
+
+	body := struct {
+		Name      string   `json:"name"`
+		FieldType string   `json:"field_type"`
+		Options   []option `json:"options,omitempty"`
+	}{
+		Name:      field.Name,
+		FieldType: field.FieldType,
+	}
+
+	for _, o := range field.Options {
+		body.Options = append(body.Options, option{o.Label})
+	}
+
+	var created struct {
+		Data snapshotField `json:"data"`
+	}
+
+	if err := r.send(ctx, "/"+resource, body, &created); err != nil {
+		return snapshotField{}, err
+	}
+
+	return created.Data, nil
+}
+
+func (r *Restorer) restoreEntity(ctx context.Context, source Source, entity restoreEntity) error {
+	restored := 0
+
+	return source.Read(entity.name, func(record json.RawMessage) error {
+		var fields map[string]json.RawMessage
+
+		if err := json.Unmarshal(record, &fields); err != nil {
+			return err
+		}
+
+		id, _ := relatedID(fields["id"])
+
+		if _, ok, err := r.restoredID(entity.name, id); ok || err != nil {
+			return err
+		}
+
+		payload, err := r.payload(entity, fields)
+
+		if err != nil {
+			return fmt.Errorf("record %v: %v", id, err)
+		}
+
+		var created struct {
+			Data struct {
+				ID int `json:"id"`
+			} `json:"data"`
+		}
+
+		if err := r.send(ctx, "/"+entity.name, payload, &created); err != nil {
+			return fmt.Errorf("record %v: %v", id, err)
+		}
+
+		if err := r.link(entity.name, id, created.Data.ID); err != nil {
+			return err
+		}
+
+		restored++
+
+		if r.Progress != nil {
+			r.Progress(entity.name, restored)
+		}
+
+		return nil
+	})
+}
+
+// payload returns the writable fields of a record, with references and
+// custom fields translated. References to records that were not restored
+// are left out.
+func (r *Restorer) payload(entity restoreEntity, record map[string]json.RawMessage) (map[string]json.RawMessage, error) {
+	payload := make(map[string]json.RawMessage)
+
+	for _, key := range entity.writable {
+		value, ok := record[key]
+
+		if !ok || isNull(value) {
+			continue
+		}
+
+		if ref, ok := entity.refs[key]; ok {
+			var err error
+
+			if value, ok, err = r.translate(ref, value); err != nil {
+				return nil, err
+			}
+
+			if !ok {
+				continue
+			}
+		}
+
+		payload[key] = value
+	}
+
+	fields := r.fields[entity.fields]
+
+	if fields == nil {
+		return payload, nil
+	}
+
+	for key, targetKey := range fields.keys {
+		value, ok := record[key]
+
+		if !ok || isNull(value) {
+			continue
+		}
+
+		value, ok, err := r.translateCustom(fields, key, value)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			payload[targetKey] = value
+		}
+
+		for _, suffix := range customFieldSuffixes {
+			if value, ok := record[key+suffix]; ok && !isNull(value) {
+				payload[targetKey+suffix] = value
+			}
+		}
+	}
+
+	return payload, nil
+}
+
+// translate returns the restored ID of a reference to a record of the
+// entity, which may be embedded in an object.
+func (r *Restorer) translate(entity string, value json.RawMessage) (json.RawMessage, bool, error) {
+	id, ok := relatedID(value)
+
+	if !ok {
+		return nil, false, nil
+	}
+
+	restored, ok, err := r.restoredID(entity, id)
+
+	if !ok || err != nil {
+		return nil, false, err
+	}
+
+	return json.RawMessage(strconv.Itoa(restored)), true, nil
+}
+
+func (r *Restorer) translateCustom(fields *restoredFields, key string, value json.RawMessage) (json.RawMessage, bool, error) {
+	fieldType := fields.types[key]
+
+	if entity, ok := customFieldRefs[fieldType]; ok {
+		return r.translate(entity, value)
+	}
+
+	if fieldType != "enum" && fieldType != "set" {
+		return value, true, nil
+	}
+
+	var translated []string
+
+	for _, option := range strings.Split(rawID(value), ",") {
+		if target, ok := fields.options[key][strings.TrimSpace(option)]; ok {
+			translated = append(translated, target)
+		}
+	}
+
+	if len(translated) == 0 {
+		return nil, false, nil
+	}
+
+	data, err := json.Marshal(strings.Join(translated, ","))
+
+	return data, err == nil, nil
+}
+
+// restoredID returns the ID restored for the ID of an entity in the
+// snapshot.
+func (r *Restorer) restoredID(entity string, id int) (int, bool, error) {
+	restored, ok, err := r.IDs.PipedriveID(entity, strconv.Itoa(id))
+
+	if !ok || err != nil {
+		return 0, false, err
+	}
+
+	n, err := strconv.Atoi(restored)
+
+	if err != nil {
+		return 0, false, fmt.Errorf("restored ID of %v %v: %v", entity, id, err)
+	}
+
+	return n, true, nil
+}
+
+// link records the ID restored for the ID of an entity in the snapshot.
+func (r *Restorer) link(entity string, id, restored int) error {
+	return r.IDs.Link(entity, strconv.Itoa(restored), strconv.Itoa(id))
+}
+
+func (r *Restorer) send(ctx context.Context, path string, body interface{}, v interface{}) error {
+	req, err := r.client.NewRequest(http.MethodPost, path, nil, body)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.Do(ctx, req, v)
+
+	return err
+}
+
+// list calls fn with every record of a resource of the account.
+func (r *Restorer) list(ctx context.Context, path string, fn func(record json.RawMessage) error) error {
+	opt := &listOptions{Limit: pageLimit}
+	pager := r.client.NewPager(path, 0)
+
+	for {
+		opt.Start = pager.Start
+		req, err := r.client.NewRequest(http.MethodGet, path, opt, nil)
+
+		if err != nil {
+			return err
+		}
+
+		var page struct {
+			Data           []json.RawMessage        `json:"data"`
+			AdditionalData pipedrive.AdditionalData `json:"additional_data"`
+		}
+
+		if _, err := r.client.Do(ctx, req, &page); err != nil {
+			return err
+		}
+
+		for _, record := range page.Data {
+			if err := fn(record); err != nil {
+				return err
+			}
+		}
+
+		if more, err := pager.Next(page.AdditionalData.Pagination, len(page.Data)); !more {
+			return err
+		}
+	}
+}
+
+// relatedID returns the ID in a value that is either an ID or a related
+// object with the ID in value or id.
+func relatedID(value json.RawMessage) (int, bool) {
+	var id int
+
+	if json.Unmarshal(value, &id) == nil {
+		return id, id != 0
+	}
+
+	var object struct {
+		Value *int `json:"value"`
+		ID    *int `json:"id"`
+	}
+
+	if json.Unmarshal(value, &object) != nil {
+		return 0, false
+	}
+
+	if object.Value != nil {
+		return *object.Value, true
+	}
+
+	if object.ID != nil {
+		return *object.ID, true
+	}
+
+	return 0, false
+}
+
+// rawID returns an ID that is encoded as number or string as string.
+func rawID(value json.RawMessage) string {
+	var text string
+
+	if json.Unmarshal(value, &text) == nil {
+		return text
+	}
+
+	return string(bytes.TrimSpace(value))
+}
+
+func isNull(value json.RawMessage) bool {
+	return len(value) == 0 || string(bytes.TrimSpace(value)) == "null"
+}
@@ -0,0 +1,228 @@
+package export
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/genert/pipedrive-api/pipedrive"
+)
+
+// setup starts a test server answering the requests of the returned
+// client with mux.
+func setup() (client *pipedrive.Client, mux *http.ServeMux, teardown func()) {
+	mux = http.NewServeMux()
+	server := httptest.NewTLSServer(mux)
+
+	client = pipedrive.NewClient(&pipedrive.Config{APIKey: "token"})
+	client.BaseURL = &url.URL{Path: strings.TrimPrefix(server.URL, "https://") + "/"}
+	client.SetOptions(pipedrive.WithHTTPClient(&http.Client{
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+	}))
+
+	return client, mux, server.Close
+}
+
+// memorySource is a snapshot in memory, by entity.
+type memorySource map[string][]string
+
+func (s memorySource) Read(entity string, fn func(record json.RawMessage) error) error {
+	for _, record := range s[entity] {
+		if err := fn(json.RawMessage(record)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// account serves the records of a test account, by resource. Created
+// records get increasing IDs, created fields a key and option IDs too.
+type account struct {
+	mu      sync.Mutex
+	records map[string][]map[string]interface{}
+	created map[string][]map[string]interface{}
+	nextID  int
+}
+
+func newAccount() *account {
+	return &account{
+		records: make(map[string][]map[string]interface{}),
+		created: make(map[string][]map[string]interface{}),
+		nextID:  100,
+	}
+}
+
+func (a *account) register(mux *http.ServeMux) {
+	mux.HandleFunc("/v1/", func(w http.ResponseWriter, r *http.Request) {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+
+		resource := strings.TrimPrefix(r.URL.Path, "/v1/")
+
+		if r.Method == http.MethodGet {
+			writeData(w, a.records[resource])
+			return
+		}
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		a.created[resource] = append(a.created[resource], body)
+
+		a.nextID++
+		record := map[string]interface{}{"id": a.nextID}
+
+		for key, value := range body {
+			record[key] = value
+		}
+
+		if strings.HasSuffix(resource, "Fields") {
+			record["key"] = fmt.Sprintf("key%v", a.nextID)
+			options, _ := body["options"].([]interface{})
+			created := []interface{}{}
+
+			for _, option := range options {
+				a.nextID++
+				created = append(created, map[string]interface{}{"id": a.nextID, "label": option.(map[string]interface{})["label"]})
+			}
+
+			record["options"] = created
+		}
+
+		a.records[resource] = append(a.records[resource], record)
+		writeData(w, record)
+	})
+}
+
+func writeData(w http.ResponseWriter, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{"success": true, "data": data})
+}
+
+// restoredID returns the ID restored for an ID of the snapshot, empty for
+// none.
+func restoredID(r *Restorer, entity string, id int) string {
+	restored, _, _ := r.IDs.PipedriveID(entity, strconv.Itoa(id))
+	return restored
+}
+
+// snapshot has a deal referring to a user, a stage and an option of a
+// custom field.
+var snapshot = memorySource{
+	"users": {`{"id": 1, "email": "ann@example.com"}`},
+	"dealFields": {
+		`{"id": 1, "key": "label", "field_type": "enum", "edit_flag": false}`,
+		`{"id": 7, "key": "abc", "name": "Size", "field_type": "enum", "edit_flag": true, "options": [{"id": 1, "label": "Small"}, {"id": 2, "label": "Big"}]}`,
+	},
+	"pipelines": {`{"id": 5, "name": "Sales"}`},
+	"stages":    {`{"id": 6, "name": "Lead", "pipeline_id": 5}`},
+	"deals": {
+		`{"id": 9, "title": "Deal", "user_id": {"id": 1, "name": "Ann"}, "pipeline_id": 5, "stage_id": 6, "person_id": 3, "abc": "2", "update_time": "2019-06-01 10:00:00"}`,
+	},
+}
+
+func TestRestorer_Restore(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	target := newAccount()
+	target.records["users"] = []map[string]interface{}{{"id": 50, "email": "Ann@Example.com"}}
+	target.register(mux)
+
+	restorer := NewRestorer(client)
+
+	if err := restorer.Restore(context.Background(), snapshot); err != nil {
+		t.Fatalf("Restore returned error: %v", err)
+	}
+
+	if n := len(target.created["dealFields"]); n != 1 {
+		t.Fatalf("Restore created %v deal fields, want 1", n)
+	}
+
+	pipelineID := restoredID(restorer, "pipelines", 5)
+	stageID := restoredID(restorer, "stages", 6)
+	stage := target.created["stages"][0]
+
+	if fmt.Sprint(stage["pipeline_id"]) != pipelineID {
+		t.Errorf("Restore created stage %v, want pipeline_id %v", stage, pipelineID)
+	}
+
+	deals := target.created["deals"]
+
+	if len(deals) != 1 {
+		t.Fatalf("Restore created %v deals, want 1", len(deals))
+	}
+
+	field := target.records["dealFields"][0]
+	big := field["options"].([]interface{})[1].(map[string]interface{})["id"]
+
+	want := map[string]string{
+		"title":               "Deal",
+		"user_id":             "50",
+		"pipeline_id":         pipelineID,
+		"stage_id":            stageID,
+		field["key"].(string): fmt.Sprint(big),
+	}
+
+	for key, value := range want {
+		if got := fmt.Sprint(deals[0][key]); got != value {
+			t.Errorf("Restore sent deal %v = %v, want %v", key, got, value)
+		}
+	}
+
+	// The person was not restored, and update_time is not writable.
+	for _, key := range []string{"person_id", "update_time", "abc"} {
+		if _, ok := deals[0][key]; ok {
+			t.Errorf("Restore sent deal %v, want it left out", key)
+		}
+	}
+
+	if restoredID(restorer, "deals", 9) == "" {
+		t.Error("Restore did not link the deal")
+	}
+}
+
+func TestRestorer_Restore_resumes(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	target := newAccount()
+	target.register(mux)
+
+	restorer := NewRestorer(client)
+
+	if err := restorer.Restore(context.Background(), snapshot); err != nil {
+		t.Fatalf("Restore returned error: %v", err)
+	}
+
+	created := 0
+
+	for _, records := range target.created {
+		created += len(records)
+	}
+
+	// Running it again with the same IDs creates nothing.
+	if err := restorer.Restore(context.Background(), snapshot); err != nil {
+		t.Fatalf("Second Restore returned error: %v", err)
+	}
+
+	for resource, records := range target.created {
+		created -= len(records)
+
+		if len(records) != 1 {
+			t.Errorf("Restore created %v %v, want 1", len(records), resource)
+		}
+	}
+
+	if created != 0 {
+		t.Errorf("Second Restore created %v records, want 0", -created)
+	}
+}
@@ -0,0 +1,454 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	defaultPartSize = 8 << 20
+	minPartSize     = 5 << 20
+)
+
+// S3Config configures an S3Sink. Any storage with an S3-compatible API can
+// be used, such as Google Cloud Storage with HMAC keys or MinIO.
+type S3Config struct {
+	// Endpoint is the base URL of the storage, for example
+	// "https://s3.eu-west-1.amazonaws.com" or "https://storage.googleapis.com".
+	// Objects are addressed path-style, as Endpoint/Bucket/Key.
+	Endpoint string
+	Region   string
+	Bucket   string
+
+	// Prefix is prepended to the object keys, which are <entity>.jsonl.
+	Prefix string
+
+	AccessKeyID     string
+	SecretAccessKey string
+
+	// PartSize is the size of the uploaded parts in bytes, 8 MiB when zero.
+	// Sizes below the 5 MiB minimum of S3 are raised to it.
+	PartSize int
+
+	// HTTPClient sends the requests, http.DefaultClient when nil.
+	HTTPClient *http.Client
+}
+
+// S3Sink writes the records of each entity as JSON lines to an object in
+// S3-compatible storage. Records are streamed with multipart uploads, so
+// only one part per entity is buffered in memory.
+type S3Sink struct {
+	ctx     context.Context
+	config  S3Config
+	uploads map[string]*s3Upload
+}
+
+type s3Upload struct {
+	key      string
+	uploadID string
+	etags    []string
+	buf      bytes.Buffer
+}
+
+// NewS3Sink returns an S3Sink storing to the configured bucket. The
+// requests are bound to ctx.
+func NewS3Sink(ctx context.Context, config S3Config) *S3Sink {
+	if config.PartSize == 0 {
+		config.PartSize = defaultPartSize
+	}
+
+	if config.PartSize < minPartSize {
+		config.PartSize = minPartSize
+	}
+
+	if config.HTTPClient == nil {
+		config.HTTPClient = http.DefaultClient
+	}
+
+	config.Endpoint = strings.TrimSuffix(config.Endpoint, "/")
+
+	return &S3Sink{ctx: ctx, config: config, uploads: make(map[string]*s3Upload)}
+}
+
+// Write appends a record to the object of the entity, uploading a part
+// once enough records are buffered.
+func (s *S3Sink) Write(entity string, record json.RawMessage) error {
+	upload, ok := s.uploads[entity]
+
+	if !ok {
+		upload = &s3Upload{key: s.config.Prefix + entity + ".jsonl"}
+		s.uploads[entity] = upload
+	}
+
+	upload.buf.Write(record)
+	upload.buf.WriteByte('\n')
+
+	if upload.buf.Len() < s.config.PartSize {
+		return nil
+	}
+
+	if upload.uploadID == "" {
+		if err := s.createUpload(upload); err != nil {
+			return err
+		}
+	}
+
+	return s.uploadPart(upload)
+}
+
+// Close uploads the buffered records and completes the objects. Uploads
+// that cannot be completed are aborted.
+func (s *S3Sink) Close() error {
+	var err error
+
+	for _, upload := range s.uploads {
+		if closeErr := s.finish(upload); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}
+
+	s.uploads = make(map[string]*s3Upload)
+
+	return err
+}
+
+func (s *S3Sink) finish(upload *s3Upload) error {
+	if upload.uploadID == "" {
+		_, err := s.do(http.MethodPut, upload.key, nil, upload.buf.Bytes())
+
+		return err
+	}
+
+	err := s.uploadPart(upload)
+
+	if err == nil {
+		err = s.completeUpload(upload)
+	}
+
+	if err != nil {
+		s.abort(upload)
+	}
+
+	return err
+}
+
+// abort discards the parts of a multipart upload.
+func (s *S3Sink) abort(upload *s3Upload) {
+	if upload.uploadID != "" {
+		s.do(http.MethodDelete, upload.key, url.Values{"uploadId": {upload.uploadID}}, nil)
+	}
+}
+
+// WriteContent implements ContentSink, the content is stored in the object
+// Prefix+name. Contents larger than PartSize are uploaded in parts, so one
+// part is buffered in memory, and an upload that fails is aborted.
+func (s *S3Sink) WriteContent(name string, r io.Reader) error {
+	upload := &s3Upload{key: s.config.Prefix + name}
+	in := bufio.NewReader(r)
+
+	for {
+		if _, err := io.CopyN(&upload.buf, in, int64(s.config.PartSize)); err != nil && err != io.EOF {
+			s.abort(upload)
+			return err
+		}
+
+		// The last part is completed with the upload, so the end of
+		// the content is checked before a full part is sent.
+		if _, err := in.Peek(1); err == io.EOF {
+			return s.finish(upload)
+		} else if err != nil {
+			s.abort(upload)
+			return err
+		}
+
+		if upload.uploadID == "" {
+			if err := s.createUpload(upload); err != nil {
+				return err
+			}
+		}
+
+		if err := s.uploadPart(upload); err != nil {
+			s.abort(upload)
+			return err
+		}
+	}
+}
+
+func (s *S3Sink) createUpload(upload *s3Upload) error {
+	resp, err := s.do(http.MethodPost, upload.key, url.Values{"uploads": {""}}, nil)
+
+	if err != nil {
+		return err
+	}
+
+	var result struct {
+		UploadID string `xml:"UploadId"`
+	}
+
+	if err := xml.Unmarshal(resp, &result); err != nil {
+		return err
+	}
+
+	if result.UploadID == "" {
+		return fmt.Errorf("s3: no upload ID for %v", upload.key)
+	}
+
+	upload.uploadID = result.UploadID
+
+	return nil
+}
+
+func (s *S3Sink) uploadPart(upload *s3Upload) error {
+	partNumber := len(upload.etags) + 1
+
+	query := url.Values{
+		"partNumber": {strconv.Itoa(partNumber)},
+		"uploadId":   {upload.uploadID},
+	}
+
+	req, err := s.newRequest(http.MethodPut, upload.key, query, upload.buf.Bytes())
+
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.send(req)
+
+	if err != nil {
+		return err
+	}
+
+	upload.etags = append(upload.etags, resp.Header.Get("ETag"))
+	upload.buf.Reset()
+
+	return nil
+}
+
+func (s *S3Sink) completeUpload(upload *s3Upload) error {
+	type part struct {
+		PartNumber int
+		ETag       string
+	}
+
+	complete := struct {
+		XMLName xml.Name `xml:"CompleteMultipartUpload"`
+		Parts   []part   `xml:"Part"`
+	}{}
+
+	for i, etag := range upload.etags {
+		complete.Parts = append(complete.Parts, part{PartNumber: i + 1, ETag: etag})
+	}
+
+	body, err := xml.Marshal(complete)
+
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.do(http.MethodPost, upload.key, url.Values{"uploadId": {upload.uploadID}}, body)
+
+	if err != nil {
+		return err
+	}
+
+	// CompleteMultipartUpload can fail after the 200 status was sent, in
+	// which case the body holds an error.
+	var failure struct {
+		XMLName xml.Name
+		Code    string
+		Message string
+	}
+
+	if xml.Unmarshal(resp, &failure) == nil && failure.XMLName.Local == "Error" {
+		return fmt.Errorf("s3: complete %v: %v: %v", upload.key, failure.Code, failure.Message)
+	}
+
+	return nil
+}
+
+func (s *S3Sink) do(method, key string, query url.Values, body []byte) ([]byte, error) {
+	req, err := s.newRequest(method, key, query, body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.send(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ioutil.ReadAll(resp.Body)
+}
+
+func (s *S3Sink) send(req *http.Request) (*http.Response, error) {
+	resp, err := s.config.HTTPClient.Do(req.WithContext(s.ctx))
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The body is small for every request the sink sends, so it is read
+	// up front to let the connection be reused.
+	data, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var failure struct {
+			Code    string
+			Message string
+		}
+
+		xml.Unmarshal(data, &failure)
+
+		return nil, fmt.Errorf("s3: %v %v: %v %v: %v", req.Method, req.URL.Path, resp.StatusCode, failure.Code, failure.Message)
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(data))
+
+	return resp, nil
+}
+
+// newRequest returns a request signed with AWS Signature Version 4.
+func (s *S3Sink) newRequest(method, key string, query url.Values, body []byte) (*http.Request, error) {
+	path := "/" + s.config.Bucket + "/" + escapePath(key)
+	rawURL := s.config.Endpoint + path
+
+	if len(query) > 0 {
+		rawURL += "?" + canonicalQuery(query)
+	}
+
+	req, err := http.NewRequest(method, rawURL, bytes.NewReader(body))
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.sign(req, path, query, sha256Hex(body), time.Now().UTC())
+
+	return req, nil
+}
+
+// sign sets the date and Authorization headers of a request with the
+// escaped path, the query and the hash of the payload.
+func (s *S3Sink) sign(req *http.Request, path string, query url.Values, payloadHash string, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		canonicalQuery(query),
+		"host:" + req.URL.Host + "\n" +
+			"x-amz-content-sha256:" + payloadHash + "\n" +
+			"x-amz-date:" + amzDate + "\n",
+		"host;x-amz-content-sha256;x-amz-date",
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.config.Region + "/s3/aws4_request"
+
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.config.SecretAccessKey), date)
+	signingKey = hmacSHA256(signingKey, s.config.Region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%v/%v, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=%v",
+		s.config.AccessKeyID,
+		scope,
+		hex.EncodeToString(hmacSHA256(signingKey, stringToSign)),
+	))
+}
+
+// escapePath escapes every segment of an object key as required by the
+// canonical request of Signature Version 4.
+func escapePath(key string) string {
+	segments := strings.Split(key, "/")
+
+	for i, segment := range segments {
+		segments[i] = uriEscape(segment)
+	}
+
+	return strings.Join(segments, "/")
+}
+
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+
+	for key := range query {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+
+	for _, key := range keys {
+		for _, value := range query[key] {
+			pairs = append(pairs, uriEscape(key)+"="+uriEscape(value))
+		}
+	}
+
+	return strings.Join(pairs, "&")
+}
+
+// uriEscape escapes all but the unreserved characters of RFC 3986.
+func uriEscape(s string) string {
+	var buf bytes.Buffer
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '_' || c == '.' || c == '~' {
+			buf.WriteByte(c)
+		} else {
+			fmt.Fprintf(&buf, "%%%02X", c)
+		}
+	}
+
+	return buf.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	io.WriteString(mac, data)
+
+	return mac.Sum(nil)
+}
@@ -0,0 +1,107 @@
+// Package idmap records which Pipedrive record corresponds to which record
+// of an external system, per kind of entity. Imports and syncs consult it
+// to skip records that were already migrated and to translate references
+// between migrated records, so they can be run again safely.
+package idmap
+
+import "sync"
+
+// Store links Pipedrive IDs to external IDs per entity, such as "deal" or
+// "person". A Pipedrive ID is linked to one external ID at most, and the
+// other way around. Implementations must be safe for concurrent use.
+type Store interface {
+	// ExternalID returns the external ID linked to a Pipedrive ID.
+	ExternalID(entity, pipedriveID string) (string, bool, error)
+
+	// PipedriveID returns the Pipedrive ID linked to an external ID.
+	PipedriveID(entity, externalID string) (string, bool, error)
+
+	// Link links a Pipedrive ID to an external ID, replacing earlier
+	// links of either ID.
+	Link(entity, pipedriveID, externalID string) error
+}
+
+// EntityMap is a Store limited to one entity. It implements syncer.IDMap.
+type EntityMap struct {
+	store  Store
+	entity string
+}
+
+// For returns the links of the entity in the store.
+func For(store Store, entity string) *EntityMap {
+	return &EntityMap{store: store, entity: entity}
+}
+
+// ExternalID returns the external ID linked to a Pipedrive ID.
+func (m *EntityMap) ExternalID(pipedriveID string) (string, bool, error) {
+	return m.store.ExternalID(m.entity, pipedriveID)
+}
+
+// PipedriveID returns the Pipedrive ID linked to an external ID.
+func (m *EntityMap) PipedriveID(externalID string) (string, bool, error) {
+	return m.store.PipedriveID(m.entity, externalID)
+}
+
+// Link links a Pipedrive ID to an external ID.
+func (m *EntityMap) Link(pipedriveID, externalID string) error {
+	return m.store.Link(m.entity, pipedriveID, externalID)
+}
+
+// Memory is a Store held in memory.
+type Memory struct {
+	mu        sync.RWMutex
+	external  map[key]string
+	pipedrive map[key]string
+}
+
+type key struct {
+	entity string
+	id     string
+}
+
+// NewMemory returns an empty Memory.
+func NewMemory() *Memory {
+	return &Memory{
+		external:  make(map[key]string),
+		pipedrive: make(map[key]string),
+	}
+}
+
+// ExternalID implements Store.
+func (m *Memory) ExternalID(entity, pipedriveID string) (string, bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	id, ok := m.external[key{entity, pipedriveID}]
+
+	return id, ok, nil
+}
+
+// PipedriveID implements Store.
+func (m *Memory) PipedriveID(entity, externalID string) (string, bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	id, ok := m.pipedrive[key{entity, externalID}]
+
+	return id, ok, nil
+}
+
+// Link implements Store.
+func (m *Memory) Link(entity, pipedriveID, externalID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if previous, ok := m.external[key{entity, pipedriveID}]; ok {
+		delete(m.pipedrive, key{entity, previous})
+	}
+
+	if previous, ok := m.pipedrive[key{entity, externalID}]; ok {
+		delete(m.external, key{entity, previous})
+	}
+
+	m.external[key{entity, pipedriveID}] = externalID
+	m.pipedrive[key{entity, externalID}] = pipedriveID
+
+	return nil
+}
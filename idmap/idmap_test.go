@@ -0,0 +1,59 @@
+package idmap
+
+import "testing"
+
+// testStore links and looks up IDs in a store, and checks that linking an
+// ID again replaces its earlier link in both directions.
+func testStore(t *testing.T, store Store) {
+	steps := []struct {
+		link                  [2]string
+		pipedriveID, external string
+		wantExternal          string
+		wantPipedrive         string
+	}{
+		{link: [2]string{"1", "a"}, pipedriveID: "1", external: "a", wantExternal: "a", wantPipedrive: "1"},
+		{link: [2]string{"2", "b"}, pipedriveID: "2", external: "b", wantExternal: "b", wantPipedrive: "2"},
+		// Relinking 1 releases a.
+		{link: [2]string{"1", "c"}, pipedriveID: "1", external: "a", wantExternal: "c", wantPipedrive: ""},
+		// Linking b to 3 releases 2.
+		{link: [2]string{"3", "b"}, pipedriveID: "2", external: "b", wantExternal: "", wantPipedrive: "3"},
+	}
+
+	for _, step := range steps {
+		if err := store.Link("deal", step.link[0], step.link[1]); err != nil {
+			t.Fatalf("Link(%v) returned error: %v", step.link, err)
+		}
+
+		external, ok, err := store.ExternalID("deal", step.pipedriveID)
+
+		if err != nil || external != step.wantExternal || ok != (step.wantExternal != "") {
+			t.Errorf("After Link(%v), ExternalID(%v) returned %q, %v, %v, want %q", step.link, step.pipedriveID, external, ok, err, step.wantExternal)
+		}
+
+		pipedriveID, ok, err := store.PipedriveID("deal", step.external)
+
+		if err != nil || pipedriveID != step.wantPipedrive || ok != (step.wantPipedrive != "") {
+			t.Errorf("After Link(%v), PipedriveID(%v) returned %q, %v, %v, want %q", step.link, step.external, pipedriveID, ok, err, step.wantPipedrive)
+		}
+	}
+
+	// Entities are kept apart.
+	if _, ok, _ := store.PipedriveID("person", "c"); ok {
+		t.Error("PipedriveID of another entity found a link")
+	}
+
+	m := For(store, "person")
+	m.Link("1", "c")
+
+	if id, ok, _ := m.PipedriveID("c"); !ok || id != "1" {
+		t.Errorf("EntityMap.PipedriveID returned %q, %v, want 1", id, ok)
+	}
+
+	if id, _, _ := store.PipedriveID("deal", "c"); id != "1" {
+		t.Errorf("EntityMap.Link changed the deal links, PipedriveID returned %q", id)
+	}
+}
+
+func TestMemory(t *testing.T) {
+	testStore(t, NewMemory())
+}
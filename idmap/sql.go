@@ -0,0 +1,124 @@
+package idmap
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// Placeholder is the parameter syntax of a SQL driver.
+type Placeholder int
+
+// Placeholder constants.
+const (
+	// QuestionMark is the syntax of MySQL and SQLite, ?.
+	QuestionMark Placeholder = iota
+
+	// Dollar is the syntax of PostgreSQL, $1.
+	Dollar
+)
+
+// SQL is a Store in a table of a SQL database, with the columns entity,
+// pipedrive_id and external_id. Link replaces earlier links in a
+// transaction.
+type SQL struct {
+	db          *sql.DB
+	table       string
+	placeholder Placeholder
+}
+
+// NewSQL returns a Store in the table, which CreateTable creates. The
+// table name is used as is in the queries.
+func NewSQL(db *sql.DB, table string, placeholder Placeholder) *SQL {
+	return &SQL{db: db, table: table, placeholder: placeholder}
+}
+
+// CreateTable creates the table and its indexes unless they exist.
+func (s *SQL) CreateTable() error {
+	statements := []string{
+		`CREATE TABLE IF NOT EXISTS %[1]v (
+			entity VARCHAR(64) NOT NULL,
+			pipedrive_id VARCHAR(64) NOT NULL,
+			external_id VARCHAR(255) NOT NULL,
+			PRIMARY KEY (entity, pipedrive_id)
+		)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS %[1]v_external ON %[1]v (entity, external_id)`,
+	}
+
+	for _, statement := range statements {
+		if _, err := s.db.Exec(fmt.Sprintf(statement, s.table)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExternalID implements Store.
+func (s *SQL) ExternalID(entity, pipedriveID string) (string, bool, error) {
+	return s.lookup("external_id", "pipedrive_id", entity, pipedriveID)
+}
+
+// PipedriveID implements Store.
+func (s *SQL) PipedriveID(entity, externalID string) (string, bool, error) {
+	return s.lookup("pipedrive_id", "external_id", entity, externalID)
+}
+
+func (s *SQL) lookup(column, by, entity, id string) (string, bool, error) {
+	query := s.query("SELECT %v FROM %v WHERE entity = ? AND %v = ?", column, s.table, by)
+
+	var found string
+
+	err := s.db.QueryRow(query, entity, id).Scan(&found)
+
+	if err == sql.ErrNoRows {
+		return "", false, nil
+	}
+
+	if err != nil {
+		return "", false, err
+	}
+
+	return found, true, nil
+}
+
+// Link implements Store.
+func (s *SQL) Link(entity, pipedriveID, externalID string) error {
+	tx, err := s.db.Begin()
+
+	if err != nil {
+		return err
+	}
+
+	remove := s.query("DELETE FROM %v WHERE entity = ? AND (pipedrive_id = ? OR external_id = ?)", s.table)
+	insert := s.query("INSERT INTO %v (entity, pipedrive_id, external_id) VALUES (?, ?, ?)", s.table)
+
+	if _, err := tx.Exec(remove, entity, pipedriveID, externalID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err := tx.Exec(insert, entity, pipedriveID, externalID); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// query formats a query and rewrites its placeholders for the driver.
+func (s *SQL) query(format string, args ...interface{}) string {
+	query := fmt.Sprintf(format, args...)
+
+	if s.placeholder != Dollar {
+		return query
+	}
+
+	parts := strings.Split(query, "?")
+
+	for i := 1; i < len(parts); i++ {
+		parts[i] = fmt.Sprintf("$%v%v", i, parts[i])
+	}
+
+	return strings.Join(parts, "")
+}
@@ -0,0 +1,266 @@
+package idmap
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeDriver is a database/sql driver keeping the rows of the idmap table
+// in memory. It understands the statements of SQL only.
+type fakeDriver struct {
+	mu      sync.Mutex
+	rows    [][3]string
+	queries []string
+
+	// failInsert fails the inserts, to test rollbacks.
+	failInsert bool
+}
+
+var (
+	fakeDrivers   = make(map[string]*fakeDriver)
+	fakeDriversMu sync.Mutex
+)
+
+func init() {
+	sql.Register("idmapfake", fakeDriverOpener{})
+}
+
+type fakeDriverOpener struct{}
+
+func (fakeDriverOpener) Open(name string) (driver.Conn, error) {
+	fakeDriversMu.Lock()
+	defer fakeDriversMu.Unlock()
+
+	return &fakeConn{d: fakeDrivers[name]}, nil
+}
+
+// openFake returns a database of a new fakeDriver.
+func openFake(t *testing.T) (*sql.DB, *fakeDriver) {
+	fakeDriversMu.Lock()
+	name := fmt.Sprintf("db%v", len(fakeDrivers))
+	d := &fakeDriver{}
+	fakeDrivers[name] = d
+	fakeDriversMu.Unlock()
+
+	db, err := sql.Open("idmapfake", name)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return db, d
+}
+
+var (
+	fakePlaceholder = regexp.MustCompile(`\$\d+`)
+	fakeSelect      = regexp.MustCompile(`^SELECT (\w+) FROM ids WHERE entity = \? AND (\w+) = \?$`)
+	fakeDelete      = regexp.MustCompile(`^DELETE FROM ids WHERE entity = \? AND \(pipedrive_id = \? OR external_id = \?\)$`)
+	fakeInsert      = regexp.MustCompile(`^INSERT INTO ids \(entity, pipedrive_id, external_id\) VALUES \(\?, \?, \?\)$`)
+)
+
+var fakeColumns = map[string]int{"entity": 0, "pipedrive_id": 1, "external_id": 2}
+
+type fakeConn struct {
+	d       *fakeDriver
+	pending [][3]string
+	inTx    bool
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{c: c, query: query}, nil
+}
+
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	c.d.mu.Lock()
+	c.pending = append([][3]string(nil), c.d.rows...)
+	c.d.mu.Unlock()
+	c.inTx = true
+
+	return c, nil
+}
+
+func (c *fakeConn) Commit() error {
+	c.d.mu.Lock()
+	c.d.rows = c.pending
+	c.d.mu.Unlock()
+	c.inTx = false
+
+	return nil
+}
+
+func (c *fakeConn) Rollback() error {
+	c.inTx = false
+
+	return nil
+}
+
+type fakeStmt struct {
+	c     *fakeConn
+	query string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	d := s.c.d
+	query := s.normalize()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	rows := &d.rows
+
+	if s.c.inTx {
+		rows = &s.c.pending
+	}
+
+	switch {
+	case strings.HasPrefix(query, "CREATE "):
+		return driver.RowsAffected(0), nil
+	case fakeDelete.MatchString(query):
+		kept := (*rows)[:0]
+
+		for _, row := range *rows {
+			if row[0] != args[0] || (row[1] != args[1] && row[2] != args[2]) {
+				kept = append(kept, row)
+			}
+		}
+
+		*rows = kept
+
+		return driver.RowsAffected(0), nil
+	case fakeInsert.MatchString(query):
+		if d.failInsert {
+			return nil, errors.New("insert failed")
+		}
+
+		*rows = append(*rows, [3]string{args[0].(string), args[1].(string), args[2].(string)})
+
+		return driver.RowsAffected(1), nil
+	}
+
+	return nil, fmt.Errorf("unexpected statement %q", s.query)
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	d := s.c.d
+	match := fakeSelect.FindStringSubmatch(s.normalize())
+
+	if match == nil {
+		return nil, fmt.Errorf("unexpected query %q", s.query)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	result := &fakeRows{column: match[1]}
+
+	for _, row := range d.rows {
+		if row[0] == args[0] && row[fakeColumns[match[2]]] == args[1] {
+			result.values = append(result.values, row[fakeColumns[match[1]]])
+		}
+	}
+
+	return result, nil
+}
+
+// normalize records the query and returns it on one line with ?
+// placeholders.
+func (s *fakeStmt) normalize() string {
+	s.c.d.mu.Lock()
+	s.c.d.queries = append(s.c.d.queries, s.query)
+	s.c.d.mu.Unlock()
+
+	return fakePlaceholder.ReplaceAllString(strings.Join(strings.Fields(s.query), " "), "?")
+}
+
+type fakeRows struct {
+	column string
+	values []string
+}
+
+func (r *fakeRows) Columns() []string { return []string{r.column} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+
+	dest[0], r.values = r.values[0], r.values[1:]
+
+	return nil
+}
+
+func TestSQL(t *testing.T) {
+	for _, placeholder := range []Placeholder{QuestionMark, Dollar} {
+		db, d := openFake(t)
+		store := NewSQL(db, "ids", placeholder)
+
+		if err := store.CreateTable(); err != nil {
+			t.Fatalf("CreateTable returned error: %v", err)
+		}
+
+		testStore(t, store)
+
+		for _, query := range d.queries {
+			wrong := "$"
+
+			if placeholder == Dollar {
+				wrong = "?"
+			}
+
+			if strings.Contains(query, wrong) {
+				t.Errorf("Query %q has the wrong placeholders for %v", query, placeholder)
+			}
+		}
+
+		db.Close()
+	}
+}
+
+func TestSQL_Link_rollsBack(t *testing.T) {
+	db, d := openFake(t)
+	defer db.Close()
+
+	store := NewSQL(db, "ids", QuestionMark)
+	store.Link("deal", "1", "a")
+
+	d.failInsert = true
+
+	if err := store.Link("deal", "1", "b"); err == nil {
+		t.Fatal("Link returned no error for a failed insert")
+	}
+
+	if id, ok, err := store.ExternalID("deal", "1"); err != nil || !ok || id != "a" {
+		t.Errorf("ExternalID returned %q, %v, %v, want the link kept by the rollback", id, ok, err)
+	}
+}
+
+func TestSQL_query(t *testing.T) {
+	tests := []struct {
+		placeholder Placeholder
+		want        string
+	}{
+		{placeholder: QuestionMark, want: "SELECT a FROM ids WHERE b = ? AND c = ?"},
+		{placeholder: Dollar, want: "SELECT a FROM ids WHERE b = $1 AND c = $2"},
+	}
+
+	for _, tt := range tests {
+		store := NewSQL(nil, "ids", tt.placeholder)
+
+		if got := store.query("SELECT a FROM %v WHERE b = ? AND c = ?", "ids"); got != tt.want {
+			t.Errorf("query with %v returned %q, want %q", tt.placeholder, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,353 @@
+// Package importer creates persons, organizations or deals from CSV or
+// JSON lines. Columns are mapped to fields by key or by name, so custom
+// fields can be addressed without their hashed keys, and rows are checked
+// against the field definitions of the account before anything is sent.
+package importer
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/genert/pipedrive-api/idmap"
+	"github.com/genert/pipedrive-api/pipedrive"
+)
+
+const defaultBatchSize = 50
+
+// RowError represents a row that was not imported.
+type RowError struct {
+	Line int
+
+	// Column is the column whose value was rejected, empty when the row
+	// failed as a whole.
+	Column string
+	Err    error
+}
+
+func (e *RowError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("line %v: %v", e.Line, e.Err)
+	}
+
+	return fmt.Sprintf("line %v: %v: %v", e.Line, e.Column, e.Err)
+}
+
+// Report summarizes an import.
+type Report struct {
+	Created int
+	Failed  int
+
+	// Skipped counts the rows whose external ID was imported before.
+	Skipped int
+
+	// IDs maps the lines of the created rows to the IDs of the entities.
+	IDs    map[int]int
+	Errors []*RowError
+}
+
+// Importer imports rows as described by a mapping.
+type Importer struct {
+	client  *pipedrive.Client
+	mapping *Mapping
+	fields  Fields
+
+	// BatchSize is the number of rows read and validated before they are
+	// sent, 50 when zero.
+	BatchSize int
+
+	// Workers and Rate configure the queue creating the entities, see
+	// pipedrive.Queue.
+	Workers int
+	Rate    float64
+
+	// IDs, if set, links the created entities to the external IDs of
+	// their rows and resolves the columns referencing other entities.
+	IDs idmap.Store
+
+	// ErrorReport receives the rejected rows as CSV, with the columns line,
+	// column and error. Nothing is written when it is nil.
+	ErrorReport io.Writer
+}
+
+// NewImporter returns an Importer for the mapping. The field definitions
+// of the entity are loaded and every column of the mapping is checked to
+// map to a field.
+func NewImporter(ctx context.Context, client *pipedrive.Client, mapping *Mapping) (*Importer, error) {
+	fields, err := LoadFields(ctx, client.NewFieldRegistry(), mapping.Entity)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, column := range mapping.Columns {
+		if _, ok := fields.Lookup(column.Field); !ok {
+			return nil, fmt.Errorf("column %q: unknown %v field %q", column.Column, mapping.Entity, column.Field)
+		}
+	}
+
+	return &Importer{client: client, mapping: mapping, fields: fields}, nil
+}
+
+// Validate converts a row to the body of a create request. The error is a
+// *RowError.
+func (i *Importer) Validate(row Row) (map[string]interface{}, error) {
+	body := make(map[string]interface{})
+
+	for _, column := range i.mapping.Columns {
+		field, _ := i.fields.Lookup(column.Field)
+		value := row.Values[column.Column]
+
+		if value == "" {
+			if column.Required {
+				return nil, &RowError{Line: row.Line, Column: column.Column, Err: fmt.Errorf("is required")}
+			}
+
+			continue
+		}
+
+		if column.References != "" {
+			id, err := i.resolve(column.References, value)
+
+			if err != nil {
+				return nil, &RowError{Line: row.Line, Column: column.Column, Err: err}
+			}
+
+			value = id
+		}
+
+		converted, err := Convert(field, value)
+
+		if err != nil {
+			return nil, &RowError{Line: row.Line, Column: column.Column, Err: err}
+		}
+
+		body[field.Key] = converted
+	}
+
+	for key, field := range i.fields {
+		if _, ok := body[key]; field.MandatoryFlag && !ok {
+			return nil, &RowError{Line: row.Line, Err: fmt.Errorf("%v is required", field.Name)}
+		}
+	}
+
+	return body, nil
+}
+
+// Import reads all rows from r and creates an entity for each valid one.
+// Invalid rows and rows Pipedrive rejects are reported, the import goes on
+// with the next row. An error is only returned when reading fails or ctx
+// is done.
+func (i *Importer) Import(ctx context.Context, r Reader) (*Report, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	queue := i.client.NewQueue()
+	queue.Workers = i.Workers
+	queue.Rate = i.Rate
+
+	go queue.Run(ctx)
+
+	var report *csv.Writer
+
+	if i.ErrorReport != nil {
+		report = csv.NewWriter(i.ErrorReport)
+		report.Write([]string{"line", "column", "error"})
+	}
+
+	result := &Report{IDs: make(map[int]int)}
+
+	batchSize := i.BatchSize
+
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	for {
+		batch, err := i.readBatch(r, batchSize, result, report)
+
+		if err != nil && err != io.EOF {
+			return result, err
+		}
+
+		i.create(ctx, queue, batch, result, report)
+
+		if report != nil {
+			report.Flush()
+
+			if flushErr := report.Error(); flushErr != nil {
+				return result, flushErr
+			}
+		}
+
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return result, ctxErr
+		}
+
+		if err == io.EOF {
+			return result, nil
+		}
+	}
+}
+
+type validRow struct {
+	line       int
+	externalID string
+	body       map[string]interface{}
+}
+
+func (i *Importer) readBatch(r Reader, size int, result *Report, report *csv.Writer) ([]validRow, error) {
+	var batch []validRow
+
+	for len(batch) < size {
+		row, err := r.Read()
+
+		if err != nil {
+			return batch, err
+		}
+
+		externalID := row.Values[i.mapping.ExternalID]
+		imported, err := i.imported(externalID)
+
+		if err != nil {
+			i.fail(result, report, &RowError{Line: row.Line, Column: i.mapping.ExternalID, Err: err})
+			continue
+		}
+
+		if imported {
+			result.Skipped++
+			continue
+		}
+
+		body, err := i.Validate(row)
+
+		if err != nil {
+			i.fail(result, report, err.(*RowError))
+			continue
+		}
+
+		batch = append(batch, validRow{line: row.Line, externalID: externalID, body: body})
+	}
+
+	return batch, nil
+}
+
+// create creates the entities of a batch and waits until all are done.
+func (i *Importer) create(ctx context.Context, queue *pipedrive.Queue, batch []validRow, result *Report, report *csv.Writer) {
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+
+	for _, row := range batch {
+		row := row
+		var id int
+
+		done := queue.Enqueue(ctx, pipedrive.PriorityNormal, func(ctx context.Context, c *pipedrive.Client) error {
+			var err error
+
+			if id, err = i.post(ctx, c, row.body); err != nil {
+				return err
+			}
+
+			return i.link(id, row.externalID)
+		})
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			err := <-done
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				i.fail(result, report, &RowError{Line: row.line, Err: err})
+				return
+			}
+
+			result.Created++
+			result.IDs[row.line] = id
+		}()
+	}
+
+	wg.Wait()
+}
+
+func (i *Importer) post(ctx context.Context, c *pipedrive.Client, body map[string]interface{}) (int, error) {
+	req, err := c.NewRequest(http.MethodPost, "/"+i.mapping.Entity.resource(), nil, body)
+
+	if err != nil {
+		return 0, err
+	}
+
+	var record struct {
+		Data struct {
+			ID int `json:"id"`
+		} `json:"data"`
+	}
+
+	if _, err := c.Do(ctx, req, &record); err != nil {
+		return 0, err
+	}
+
+	return record.Data.ID, nil
+}
+
+// imported reports whether the row with the external ID was imported
+// before.
+func (i *Importer) imported(externalID string) (bool, error) {
+	if i.IDs == nil || externalID == "" {
+		return false, nil
+	}
+
+	_, ok, err := i.IDs.PipedriveID(string(i.mapping.Entity), externalID)
+
+	return ok, err
+}
+
+func (i *Importer) link(id int, externalID string) error {
+	if i.IDs == nil || externalID == "" {
+		return nil
+	}
+
+	if err := i.IDs.Link(string(i.mapping.Entity), strconv.Itoa(id), externalID); err != nil {
+		return fmt.Errorf("created %v %v but not linked: %v", i.mapping.Entity, id, err)
+	}
+
+	return nil
+}
+
+// resolve returns the Pipedrive ID of the entity with the external ID.
+func (i *Importer) resolve(entity Entity, externalID string) (string, error) {
+	if i.IDs == nil {
+		return "", fmt.Errorf("no ID map to resolve %v %q", entity, externalID)
+	}
+
+	id, ok, err := i.IDs.PipedriveID(string(entity), externalID)
+
+	if err != nil {
+		return "", err
+	}
+
+	if !ok {
+		return "", fmt.Errorf("%v %q was not imported", entity, externalID)
+	}
+
+	return id, nil
+}
+
+func (i *Importer) fail(result *Report, report *csv.Writer, err *RowError) {
+	result.Failed++
+	result.Errors = append(result.Errors, err)
+
+	if report != nil {
+		report.Write([]string{strconv.Itoa(err.Line), err.Column, err.Err.Error()})
+	}
+}
@@ -0,0 +1,216 @@
+package importer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/genert/pipedrive-api/idmap"
+	"github.com/genert/pipedrive-api/pipedrive"
+)
+
+// setup starts a test server answering the requests of the returned
+// client with mux.
+func setup() (client *pipedrive.Client, mux *http.ServeMux, teardown func()) {
+	mux = http.NewServeMux()
+	server := httptest.NewTLSServer(mux)
+
+	client = pipedrive.NewClient(&pipedrive.Config{APIKey: "token"})
+	client.BaseURL = &url.URL{Path: strings.TrimPrefix(server.URL, "https://") + "/"}
+	client.SetOptions(pipedrive.WithHTTPClient(&http.Client{
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+	}))
+
+	return client, mux, server.Close
+}
+
+// personAccount serves person fields and creates persons, rejecting those
+// named Reject.
+type personAccount struct {
+	mu      sync.Mutex
+	created []map[string]interface{}
+}
+
+func (a *personAccount) register(mux *http.ServeMux) {
+	mux.HandleFunc("/v1/personFields", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"success": true, "data": [
+			{"key": "name", "name": "Name", "field_type": "varchar", "mandatory_flag": true},
+			{"key": "email", "name": "Email", "field_type": "varchar"},
+			{"key": "org_id", "name": "Organization", "field_type": "org"},
+			{"key": "abc", "name": "Lead source", "field_type": "enum", "options": [{"id": 1, "label": "Web"}]}
+		]}`)
+	})
+
+	mux.HandleFunc("/v1/persons", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if body["name"] == "Reject" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"success": false, "error": "rejected"}`)
+			return
+		}
+
+		a.mu.Lock()
+		a.created = append(a.created, body)
+		id := 100 + len(a.created)
+		a.mu.Unlock()
+
+		fmt.Fprintf(w, `{"success": true, "data": {"id": %v}}`, id)
+	})
+}
+
+var personMapping = &Mapping{
+	Entity:     EntityPerson,
+	ExternalID: "ID",
+	Columns: []Column{
+		{Column: "Full name", Field: "name"},
+		{Column: "E-mail", Field: "Email", Required: true},
+		{Column: "Source", Field: "lead source"},
+		{Column: "Company", Field: "org_id", References: EntityOrganization},
+	},
+}
+
+func TestImporter_Validate(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	(&personAccount{}).register(mux)
+
+	importer, err := NewImporter(context.Background(), client, personMapping)
+
+	if err != nil {
+		t.Fatalf("NewImporter returned error: %v", err)
+	}
+
+	importer.IDs = idmap.NewMemory()
+	importer.IDs.Link(string(EntityOrganization), "7", "C1")
+
+	tests := []struct {
+		values     map[string]string
+		want       map[string]interface{}
+		wantColumn string
+		wantErr    bool
+	}{
+		{
+			values: map[string]string{"Full name": "Ann", "E-mail": "ann@example.com", "Source": "web", "Company": "C1"},
+			want:   map[string]interface{}{"name": "Ann", "email": "ann@example.com", "abc": 1, "org_id": 7},
+		},
+		{values: map[string]string{"Full name": "Ann"}, wantColumn: "E-mail", wantErr: true},
+		{values: map[string]string{"E-mail": "ann@example.com"}, wantColumn: "", wantErr: true},
+		{values: map[string]string{"Full name": "Ann", "E-mail": "a", "Source": "Radio"}, wantColumn: "Source", wantErr: true},
+		{values: map[string]string{"Full name": "Ann", "E-mail": "a", "Company": "C2"}, wantColumn: "Company", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		body, err := importer.Validate(Row{Line: 2, Values: tt.values})
+
+		if tt.wantErr {
+			rowErr, ok := err.(*RowError)
+
+			if !ok || rowErr.Line != 2 || rowErr.Column != tt.wantColumn {
+				t.Errorf("Validate(%v) returned error %#v, want a *RowError of column %q", tt.values, err, tt.wantColumn)
+			}
+
+			continue
+		}
+
+		if err != nil || fmt.Sprint(body) != fmt.Sprint(tt.want) {
+			t.Errorf("Validate(%v) returned %v, %v, want %v", tt.values, body, err, tt.want)
+		}
+	}
+}
+
+func TestNewImporter_unknownField(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	(&personAccount{}).register(mux)
+
+	mapping := &Mapping{Entity: EntityPerson, Columns: []Column{{Column: "Size", Field: "Size"}}}
+
+	if _, err := NewImporter(context.Background(), client, mapping); err == nil {
+		t.Error("NewImporter returned no error for a column of an unknown field")
+	}
+}
+
+func TestImporter_Import(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	account := &personAccount{}
+	account.register(mux)
+
+	importer, err := NewImporter(context.Background(), client, personMapping)
+
+	if err != nil {
+		t.Fatalf("NewImporter returned error: %v", err)
+	}
+
+	var rejected bytes.Buffer
+
+	importer.IDs = idmap.NewMemory()
+	importer.ErrorReport = &rejected
+	importer.BatchSize = 2
+
+	source := "ID,Full name,E-mail,Source\n" +
+		"P1,Ann,ann@example.com,Web\n" +
+		"P2,Bob,,Web\n" +
+		"P3,Reject,reject@example.com,\n" +
+		"P4,Cid,cid@example.com,Radio\n" +
+		"P5,Dan,dan@example.com,\n"
+
+	report, err := importer.Import(context.Background(), NewCSVReader(strings.NewReader(source)))
+
+	if err != nil {
+		t.Fatalf("Import returned error: %v", err)
+	}
+
+	if report.Created != 2 || report.Failed != 3 || report.Skipped != 0 {
+		t.Errorf("Import returned %+v, want 2 created and 3 failed", report)
+	}
+
+	if report.IDs[2] == 0 || report.IDs[6] == 0 {
+		t.Errorf("Import returned IDs %v, want the lines 2 and 6", report.IDs)
+	}
+
+	lines := strings.Split(strings.TrimSpace(rejected.String()), "\n")
+
+	if len(lines) != 4 || lines[0] != "line,column,error" {
+		t.Fatalf("Import reported %q, want a header and 3 errors", rejected.String())
+	}
+
+	for i, prefix := range []string{"3,E-mail,", "5,Source,", "4,,"} {
+		found := false
+
+		for _, line := range lines[1:] {
+			found = found || strings.HasPrefix(line, prefix)
+		}
+
+		if !found {
+			t.Errorf("Import reported %q, want error %v starting with %q", rejected.String(), i, prefix)
+		}
+	}
+
+	// Importing again skips the created rows.
+	report, err = importer.Import(context.Background(), NewCSVReader(strings.NewReader(source)))
+
+	if err != nil || report.Skipped != 2 || report.Created != 0 {
+		t.Errorf("Second Import returned %+v, %v, want 2 skipped", report, err)
+	}
+
+	if len(account.created) != 2 {
+		t.Errorf("Import created %v persons, want 2", len(account.created))
+	}
+}
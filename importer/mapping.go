@@ -0,0 +1,185 @@
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/genert/pipedrive-api/pipedrive"
+)
+
+// Entity represents the kind of entity rows are imported as.
+type Entity string
+
+// Entity constants.
+const (
+	EntityPerson       Entity = "person"
+	EntityOrganization Entity = "organization"
+	EntityDeal         Entity = "deal"
+)
+
+func (e Entity) resource() string {
+	return string(e) + "s"
+}
+
+// Column maps a column of the source to a Pipedrive field.
+type Column struct {
+	// Column is the name of the column in the source.
+	Column string `json:"column"`
+
+	// Field is the key or the name of the field, for example "name" or the
+	// name of a custom field.
+	Field string `json:"field"`
+
+	// Required rejects rows without a value in the column, in addition to
+	// the fields Pipedrive marks as mandatory.
+	Required bool `json:"required,omitempty"`
+
+	// References, if set, means the column holds the external ID of an
+	// imported entity, which is replaced by its Pipedrive ID through
+	// Importer.IDs.
+	References Entity `json:"references,omitempty"`
+}
+
+// Mapping describes how rows are imported.
+type Mapping struct {
+	Entity  Entity   `json:"entity"`
+	Columns []Column `json:"columns"`
+
+	// ExternalID is the column holding the ID of the row in the source.
+	// With Importer.IDs set, rows already imported are skipped and the
+	// created entities are linked to it.
+	ExternalID string `json:"external_id,omitempty"`
+}
+
+// ReadMapping decodes a mapping from its JSON configuration, for example:
+//
+//	{
+//	    "entity": "person",
+//	    "external_id": "Contact ID",
+//	    "columns": [
+//	        {"column": "Full name", "field": "name", "required": true},
+//	        {"column": "E-mail", "field": "email"},
+//	        {"column": "Source", "field": "Lead source"},
+//	        {"column": "Company ID", "field": "org_id", "references": "organization"}
+//	    ]
+//	}
+func ReadMapping(r io.Reader) (*Mapping, error) {
+	var m Mapping
+
+	if err := json.NewDecoder(r).Decode(&m); err != nil {
+		return nil, err
+	}
+
+	switch m.Entity {
+	case EntityPerson, EntityOrganization, EntityDeal:
+	default:
+		return nil, fmt.Errorf("unsupported entity %q", m.Entity)
+	}
+
+	return &m, nil
+}
+
+// Fields holds the fields of an entity by key.
+type Fields map[string]pipedrive.FieldDefinition
+
+// LoadFields returns the fields of an entity from the registry.
+func LoadFields(ctx context.Context, registry *pipedrive.FieldRegistry, entity Entity) (Fields, error) {
+	definitions, err := registry.Fields(ctx, pipedrive.FieldEntity(entity))
+
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(Fields, len(definitions))
+
+	for _, field := range definitions {
+		fields[field.Key] = field
+	}
+
+	return fields, nil
+}
+
+// Lookup returns the field with the given key or, failing that, the given
+// name. Names are compared case-insensitively.
+func (f Fields) Lookup(keyOrName string) (pipedrive.FieldDefinition, bool) {
+	if field, ok := f[keyOrName]; ok {
+		return field, true
+	}
+
+	for _, field := range f {
+		if strings.EqualFold(field.Name, keyOrName) {
+			return field, true
+		}
+	}
+
+	return pipedrive.FieldDefinition{}, false
+}
+
+// Convert returns the value to send for a field. Options of enum and set
+// fields can be given by label or ID, set options separated by commas.
+func Convert(f pipedrive.FieldDefinition, value string) (interface{}, error) {
+	switch f.FieldType {
+	case pipedrive.FieldTypeDouble, pipedrive.FieldTypeMonetary:
+		number, err := strconv.ParseFloat(value, 64)
+
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number", value)
+		}
+
+		return number, nil
+	case pipedrive.FieldTypeInt, pipedrive.FieldTypeUser, pipedrive.FieldTypeOrg,
+		pipedrive.FieldTypePeople, pipedrive.FieldTypeStage:
+		number, err := strconv.Atoi(value)
+
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an ID", value)
+		}
+
+		return number, nil
+	case pipedrive.FieldTypeDate:
+		if _, err := time.Parse("2006-01-02", value); err != nil {
+			return nil, fmt.Errorf("%q is not a date of the form YYYY-MM-DD", value)
+		}
+	case pipedrive.FieldTypeEnum:
+		return option(f, value)
+	case pipedrive.FieldTypeSet:
+		var ids []interface{}
+
+		for _, label := range strings.Split(value, ",") {
+			if label = strings.TrimSpace(label); label == "" {
+				continue
+			}
+
+			id, err := option(f, label)
+
+			if err != nil {
+				return nil, err
+			}
+
+			ids = append(ids, id)
+		}
+
+		return ids, nil
+	}
+
+	return value, nil
+}
+
+func option(f pipedrive.FieldDefinition, value string) (interface{}, error) {
+	option, ok := f.Option(value)
+
+	if !ok {
+		return nil, fmt.Errorf("%q is not an option", value)
+	}
+
+	if id, err := strconv.Atoi(option.ID); err == nil {
+		return id, nil
+	}
+
+	return option.ID, nil
+}
@@ -0,0 +1,103 @@
+package importer
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/genert/pipedrive-api/pipedrive"
+)
+
+func TestReadMapping(t *testing.T) {
+	tests := []struct {
+		config  string
+		want    *Mapping
+		wantErr bool
+	}{
+		{
+			config: `{"entity": "person", "external_id": "ID", "columns": [{"column": "Name", "field": "name", "required": true}]}`,
+			want:   &Mapping{Entity: EntityPerson, ExternalID: "ID", Columns: []Column{{Column: "Name", Field: "name", Required: true}}},
+		},
+		{config: `{"entity": "lead", "columns": []}`, wantErr: true},
+		{config: `{"entity": "deal", "columns": {}}`, wantErr: true},
+		{config: `{`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ReadMapping(strings.NewReader(tt.config))
+
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ReadMapping(%s) returned error %v, want error %v", tt.config, err, tt.wantErr)
+			continue
+		}
+
+		if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ReadMapping(%s) returned %+v, want %+v", tt.config, got, tt.want)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	enum := pipedrive.FieldDefinition{FieldType: pipedrive.FieldTypeEnum, Options: []pipedrive.FieldOption{{ID: "1", Label: "Web"}, {ID: "x", Label: "Fair"}}}
+	set := pipedrive.FieldDefinition{FieldType: pipedrive.FieldTypeSet, Options: enum.Options}
+
+	tests := []struct {
+		field   pipedrive.FieldDefinition
+		value   string
+		want    interface{}
+		wantErr bool
+	}{
+		{field: pipedrive.FieldDefinition{FieldType: pipedrive.FieldTypeVarchar}, value: "text", want: "text"},
+		{field: pipedrive.FieldDefinition{FieldType: pipedrive.FieldTypeMonetary}, value: "12.5", want: 12.5},
+		{field: pipedrive.FieldDefinition{FieldType: pipedrive.FieldTypeDouble}, value: "twelve", wantErr: true},
+		{field: pipedrive.FieldDefinition{FieldType: pipedrive.FieldTypeOrg}, value: "7", want: 7},
+		{field: pipedrive.FieldDefinition{FieldType: pipedrive.FieldTypeUser}, value: "ann", wantErr: true},
+		{field: pipedrive.FieldDefinition{FieldType: pipedrive.FieldTypeDate}, value: "2019-06-01", want: "2019-06-01"},
+		{field: pipedrive.FieldDefinition{FieldType: pipedrive.FieldTypeDate}, value: "06/01/2019", wantErr: true},
+		{field: enum, value: "web", want: 1},
+		{field: enum, value: "1", want: 1},
+		{field: enum, value: "Fair", want: "x"},
+		{field: enum, value: "Radio", wantErr: true},
+		{field: set, value: "Web, Fair,", want: []interface{}{1, "x"}},
+		{field: set, value: "Web, Radio", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := Convert(tt.field, tt.value)
+
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Convert(%v, %q) returned error %v, want error %v", tt.field.FieldType, tt.value, err, tt.wantErr)
+			continue
+		}
+
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Convert(%v, %q) returned %#v, want %#v", tt.field.FieldType, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestFields_Lookup(t *testing.T) {
+	fields := Fields{
+		"name": {Key: "name", Name: "Name"},
+		"abc":  {Key: "abc", Name: "Lead source"},
+	}
+
+	tests := []struct {
+		keyOrName string
+		want      string
+		wantOK    bool
+	}{
+		{keyOrName: "name", want: "name", wantOK: true},
+		{keyOrName: "abc", want: "abc", wantOK: true},
+		{keyOrName: "LEAD SOURCE", want: "abc", wantOK: true},
+		{keyOrName: "Source"},
+	}
+
+	for _, tt := range tests {
+		field, ok := fields.Lookup(tt.keyOrName)
+
+		if field.Key != tt.want || ok != tt.wantOK {
+			t.Errorf("Lookup(%v) returned %v, %v, want %v, %v", tt.keyOrName, field.Key, ok, tt.want, tt.wantOK)
+		}
+	}
+}
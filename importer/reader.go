@@ -0,0 +1,140 @@
+package importer
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Row represents one record of the source, with its values keyed by
+// column.
+type Row struct {
+	// Line is the position of the row in the source, counted from 1 and
+	// including the header of CSV.
+	Line   int
+	Values map[string]string
+}
+
+// Reader reads the rows of a source. Read returns io.EOF after the last
+// row.
+type Reader interface {
+	Read() (Row, error)
+}
+
+// CSVReader reads rows from CSV, whose first record holds the column
+// names.
+type CSVReader struct {
+	r       *csv.Reader
+	columns []string
+	line    int
+}
+
+// NewCSVReader returns a CSVReader reading from r.
+func NewCSVReader(r io.Reader) *CSVReader {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	return &CSVReader{r: reader}
+}
+
+// CSV returns the underlying CSV reader, to change the separator or the
+// quoting before the first Read.
+func (r *CSVReader) CSV() *csv.Reader {
+	return r.r
+}
+
+// Read returns the next row.
+func (r *CSVReader) Read() (Row, error) {
+	if r.columns == nil {
+		columns, err := r.r.Read()
+
+		if err != nil {
+			return Row{}, err
+		}
+
+		r.columns = columns
+		r.line++
+	}
+
+	record, err := r.r.Read()
+
+	if err != nil {
+		return Row{}, err
+	}
+
+	r.line++
+
+	if len(record) > len(r.columns) {
+		return Row{}, fmt.Errorf("line %v: %v values for %v columns", r.line, len(record), len(r.columns))
+	}
+
+	row := Row{Line: r.line, Values: make(map[string]string, len(record))}
+
+	for i, value := range record {
+		row.Values[r.columns[i]] = value
+	}
+
+	return row, nil
+}
+
+// JSONLReader reads rows from JSON lines, one object per line. Values
+// that are not strings are converted to their JSON text.
+type JSONLReader struct {
+	s    *bufio.Scanner
+	line int
+}
+
+// NewJSONLReader returns a JSONLReader reading from r.
+func NewJSONLReader(r io.Reader) *JSONLReader {
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 1<<20)
+
+	return &JSONLReader{s: s}
+}
+
+// Read returns the next row, skipping blank lines.
+func (r *JSONLReader) Read() (Row, error) {
+	for r.s.Scan() {
+		r.line++
+
+		if len(r.s.Bytes()) == 0 {
+			continue
+		}
+
+		var object map[string]json.RawMessage
+
+		if err := json.Unmarshal(r.s.Bytes(), &object); err != nil {
+			return Row{}, fmt.Errorf("line %v: %v", r.line, err)
+		}
+
+		row := Row{Line: r.line, Values: make(map[string]string, len(object))}
+
+		for column, raw := range object {
+			row.Values[column] = jsonText(raw)
+		}
+
+		return row, nil
+	}
+
+	if err := r.s.Err(); err != nil {
+		return Row{}, err
+	}
+
+	return Row{}, io.EOF
+}
+
+func jsonText(raw json.RawMessage) string {
+	var s string
+
+	if json.Unmarshal(raw, &s) == nil {
+		return s
+	}
+
+	if string(raw) == "null" {
+		return ""
+	}
+
+	return string(raw)
+}
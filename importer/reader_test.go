@@ -0,0 +1,82 @@
+package importer
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// readAll returns the rows of r up to the first error.
+func readAll(r Reader) ([]Row, error) {
+	var rows []Row
+
+	for {
+		row, err := r.Read()
+
+		if err != nil {
+			return rows, err
+		}
+
+		rows = append(rows, row)
+	}
+}
+
+func TestCSVReader_Read(t *testing.T) {
+	tests := []struct {
+		source  string
+		want    []Row
+		wantErr bool
+	}{
+		{
+			source: "Name,E-mail\nAnn,ann@example.com\n\"Smith, Bob\"\n",
+			want: []Row{
+				{Line: 2, Values: map[string]string{"Name": "Ann", "E-mail": "ann@example.com"}},
+				{Line: 3, Values: map[string]string{"Name": "Smith, Bob"}},
+			},
+		},
+		{source: "Name\n", want: nil},
+		{source: "Name\nAnn,extra\n", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		rows, err := readAll(NewCSVReader(strings.NewReader(tt.source)))
+
+		if (err != io.EOF) != tt.wantErr {
+			t.Errorf("Read of %q returned error %v, want error %v", tt.source, err, tt.wantErr)
+		}
+
+		if !reflect.DeepEqual(rows, tt.want) {
+			t.Errorf("Read of %q returned %+v, want %+v", tt.source, rows, tt.want)
+		}
+	}
+}
+
+func TestJSONLReader_Read(t *testing.T) {
+	tests := []struct {
+		source  string
+		want    []Row
+		wantErr bool
+	}{
+		{
+			source: `{"Name": "Ann", "Age": 30, "Tags": ["a"], "Org": null}` + "\n\n" + `{"Name": "Bob"}`,
+			want: []Row{
+				{Line: 1, Values: map[string]string{"Name": "Ann", "Age": "30", "Tags": `["a"]`, "Org": ""}},
+				{Line: 3, Values: map[string]string{"Name": "Bob"}},
+			},
+		},
+		{source: `{"Name": "Ann"}` + "\n" + `["Bob"]`, want: []Row{{Line: 1, Values: map[string]string{"Name": "Ann"}}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		rows, err := readAll(NewJSONLReader(strings.NewReader(tt.source)))
+
+		if (err != io.EOF) != tt.wantErr {
+			t.Errorf("Read of %q returned error %v, want error %v", tt.source, err, tt.wantErr)
+		}
+
+		if !reflect.DeepEqual(rows, tt.want) {
+			t.Errorf("Read of %q returned %+v, want %+v", tt.source, rows, tt.want)
+		}
+	}
+}
@@ -0,0 +1,240 @@
+package mapping
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/genert/pipedrive-api/pipedrive"
+)
+
+// timeLayout is the layout of the add and update times of Pipedrive
+// entities, which are in UTC.
+const timeLayout = "2006-01-02 15:04:05"
+
+// Contact represents an email address or phone number.
+type Contact struct {
+	Value   string
+	Label   string
+	Primary bool
+}
+
+// Deal represents a deal.
+type Deal struct {
+	SourceID       int
+	Title          string
+	Value          float64
+	Currency       string
+	Status         string
+	Pipeline       string
+	Stage          string
+	OwnerEmail     string
+	PersonID       int
+	OrganizationID int
+	LostReason     string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	ClosedAt       time.Time
+	CustomFields   map[string]interface{}
+}
+
+// Person represents a contact person.
+type Person struct {
+	SourceID       int
+	Name           string
+	FirstName      string
+	LastName       string
+	Emails         []Contact
+	Phones         []Contact
+	OrganizationID int
+	OwnerEmail     string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	CustomFields   map[string]interface{}
+}
+
+// Organization represents a company.
+type Organization struct {
+	SourceID     int
+	Name         string
+	Address      pipedrive.Address
+	OwnerEmail   string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	CustomFields map[string]interface{}
+}
+
+// Activity represents a call, meeting, task or other activity.
+type Activity struct {
+	SourceID       int
+	Type           string
+	Subject        string
+	Note           string
+	Done           bool
+	DueAt          time.Time
+	Duration       time.Duration
+	Location       string
+	OwnerEmail     string
+	DealID         int
+	PersonID       int
+	OrganizationID int
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DoneAt         time.Time
+}
+
+// Note represents a note attached to a deal, person or organization.
+type Note struct {
+	SourceID       int
+	Content        string
+	AuthorEmail    string
+	DealID         int
+	PersonID       int
+	OrganizationID int
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Deal converts a Pipedrive deal.
+func (r *Resolver) Deal(d *pipedrive.Deal) *Deal {
+	return &Deal{
+		SourceID:       d.ID,
+		Title:          d.Title,
+		Value:          d.Value,
+		Currency:       d.Currency,
+		Status:         string(d.Status),
+		Pipeline:       r.PipelineName(d.PipelineID),
+		Stage:          r.StageName(d.StageID),
+		OwnerEmail:     r.ownerEmail(d.UserID.ID, d.UserID.Email),
+		PersonID:       d.PersonID.Value,
+		OrganizationID: d.OrgID.Value,
+		LostReason:     d.LostReason,
+		CreatedAt:      parseTime(d.AddTime),
+		UpdatedAt:      parseTime(d.UpdateTime),
+		ClosedAt:       parseTime(d.CloseTime),
+		CustomFields:   r.CustomFields(d.CustomFields),
+	}
+}
+
+// Person converts a Pipedrive person.
+func (r *Resolver) Person(p *pipedrive.Person) *Person {
+	return &Person{
+		SourceID:       p.ID,
+		Name:           p.Name,
+		FirstName:      p.FirstName,
+		LastName:       p.LastName,
+		Emails:         contacts(p.Email),
+		Phones:         contacts(p.Phone),
+		OrganizationID: p.OrgID.Value,
+		OwnerEmail:     r.ownerEmail(p.OwnerID.ID, p.OwnerID.Email),
+		CreatedAt:      parseTime(p.AddTime),
+		UpdatedAt:      parseTime(p.UpdateTime),
+		CustomFields:   r.CustomFields(p.CustomFields),
+	}
+}
+
+// Organization converts a Pipedrive organization.
+func (r *Resolver) Organization(o *pipedrive.Organization) *Organization {
+	return &Organization{
+		SourceID:     o.ID,
+		Name:         o.Name,
+		Address:      o.Address,
+		OwnerEmail:   r.ownerEmail(o.OwnerID.ID, o.OwnerID.Email),
+		CreatedAt:    parseTime(o.AddTime),
+		UpdatedAt:    parseTime(o.UpdateTime),
+		CustomFields: r.CustomFields(o.CustomFields),
+	}
+}
+
+// Activity converts a Pipedrive activity. Activities without a due time
+// are due at midnight UTC.
+func (r *Resolver) Activity(a *pipedrive.Activity) *Activity {
+	activity := &Activity{
+		SourceID:       a.Id,
+		Type:           a.Type,
+		Subject:        a.Subject,
+		Note:           a.Note,
+		Done:           bool(a.Done),
+		Location:       a.Location.Formatted(),
+		OwnerEmail:     r.UserEmail(a.UserID),
+		DealID:         a.DealID,
+		PersonID:       a.PersonID,
+		OrganizationID: a.OrgID,
+		CreatedAt:      parseTime(a.AddTime),
+		UpdatedAt:      parseTime(a.UpdateTime),
+		DoneAt:         parseTime(a.MarkedAsDoneTime),
+	}
+
+	if due, err := a.DueDate.Time(); err == nil {
+		if clock, err := time.Parse("15:04", string(a.DueTime)); err == nil {
+			due = due.Add(time.Duration(clock.Hour())*time.Hour + time.Duration(clock.Minute())*time.Minute)
+		}
+
+		activity.DueAt = due
+	}
+
+	if duration, err := a.Duration.Duration(); err == nil {
+		activity.Duration = duration
+	}
+
+	return activity
+}
+
+// Note converts a Pipedrive note.
+func (r *Resolver) Note(n *pipedrive.Note) *Note {
+	return &Note{
+		SourceID:       n.ID,
+		Content:        n.Content,
+		AuthorEmail:    r.UserEmail(n.UserID),
+		DealID:         n.DealID,
+		PersonID:       n.PersonID,
+		OrganizationID: n.OrgID,
+		CreatedAt:      parseTime(n.AddTime),
+		UpdatedAt:      parseTime(n.UpdateTime),
+	}
+}
+
+// ownerEmail prefers the email of a known user over the one embedded in
+// the entity, which is missing in some responses.
+func (r *Resolver) ownerEmail(id int, email string) string {
+	if known := r.UserEmail(id); known != "" {
+		return known
+	}
+
+	return email
+}
+
+func contacts(values []pipedrive.ContactValue) []Contact {
+	var result []Contact
+
+	for _, v := range values {
+		if v.Value == "" {
+			continue
+		}
+
+		result = append(result, Contact{Value: v.Value, Label: v.Label, Primary: v.Primary})
+	}
+
+	return result
+}
+
+// parseTime returns the zero time for empty or malformed values.
+func parseTime(value string) time.Time {
+	t, err := time.Parse(timeLayout, value)
+
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t
+}
+
+// rawValue decodes the value of a custom field without a known type.
+func rawValue(fields pipedrive.CustomFields, key string) interface{} {
+	var value interface{}
+
+	if err := json.Unmarshal(fields[key], &value); err != nil {
+		return nil
+	}
+
+	return value
+}
@@ -0,0 +1,137 @@
+package mapping
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/genert/pipedrive-api/pipedrive"
+)
+
+var (
+	sizeKey   = strings.Repeat("a", 40)
+	tagsKey   = strings.Repeat("b", 40)
+	budgetKey = strings.Repeat("c", 40)
+	ownerKey  = strings.Repeat("d", 40)
+	otherKey  = strings.Repeat("e", 40)
+)
+
+func testResolver() *Resolver {
+	r := NewResolver()
+	r.AddUsers(pipedrive.User{ID: 1, Email: "ann@example.com"})
+	r.AddPipelines(pipedrive.Pipeline{ID: 2, Name: "Sales"})
+	r.AddStages(pipedrive.Stage{ID: 3, Name: "Lead"})
+	r.AddFields(
+		Field{Key: sizeKey, Name: "Size", Type: pipedrive.FieldTypeEnum, Options: map[int]string{1: "Small", 2: "Big"}},
+		Field{Key: tagsKey, Name: "Tags", Type: pipedrive.FieldTypeSet, Options: map[int]string{1: "Hot", 2: "New"}},
+		Field{Key: budgetKey, Name: "Budget", Type: pipedrive.FieldTypeMonetary},
+		Field{Key: ownerKey, Name: "Owner", Type: pipedrive.FieldTypeUser},
+	)
+
+	return r
+}
+
+func TestResolver_CustomFields(t *testing.T) {
+	r := testResolver()
+
+	tests := []struct {
+		name   string
+		fields map[string]string
+		want   map[string]interface{}
+	}{
+		{name: "none", want: nil},
+		{name: "enum", fields: map[string]string{sizeKey: `"2"`}, want: map[string]interface{}{"Size": "Big"}},
+		{name: "unknown option", fields: map[string]string{sizeKey: `9`}, want: map[string]interface{}{"Size": "9"}},
+		{name: "set", fields: map[string]string{tagsKey: `"1,2, 3"`}, want: map[string]interface{}{"Tags": []string{"Hot", "New", "3"}}},
+		{
+			name:   "monetary",
+			fields: map[string]string{budgetKey: `1500`, budgetKey + "_currency": `"EUR"`},
+			want:   map[string]interface{}{"Budget": pipedrive.Money{Value: 1500, Currency: "EUR"}},
+		},
+		{name: "user", fields: map[string]string{ownerKey: `1`}, want: map[string]interface{}{"Owner": "ann@example.com"}},
+		{name: "null", fields: map[string]string{sizeKey: `null`}, want: map[string]interface{}{"Size": nil}},
+		{name: "undefined", fields: map[string]string{otherKey: `{"a": 1}`}, want: map[string]interface{}{otherKey: map[string]interface{}{"a": 1.0}}},
+	}
+
+	for _, tt := range tests {
+		fields := pipedrive.CustomFields{}
+
+		for key, value := range tt.fields {
+			fields[key] = json.RawMessage(value)
+		}
+
+		if got := r.CustomFields(fields); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("CustomFields of %v returned %#v, want %#v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestResolver_Deal(t *testing.T) {
+	var deal pipedrive.Deal
+
+	err := json.Unmarshal([]byte(`{
+		"id": 9, "title": "Deal", "value": 100, "currency": "EUR", "status": "won",
+		"pipeline_id": 2, "stage_id": 3, "user_id": {"id": 1, "email": "old@example.com"},
+		"person_id": {"value": 4}, "org_id": {"value": 5},
+		"add_time": "2019-06-01 10:00:00", "update_time": "", "close_time": "bad",
+		"`+sizeKey+`": "1"
+	}`), &deal)
+
+	if err != nil {
+		t.Fatalf("Unmarshal returned error: %v", err)
+	}
+
+	got := testResolver().Deal(&deal)
+	want := &Deal{
+		SourceID:       9,
+		Title:          "Deal",
+		Value:          100,
+		Currency:       "EUR",
+		Status:         "won",
+		Pipeline:       "Sales",
+		Stage:          "Lead",
+		OwnerEmail:     "ann@example.com",
+		PersonID:       4,
+		OrganizationID: 5,
+		CreatedAt:      time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC),
+		CustomFields:   map[string]interface{}{"Size": "Small"},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Deal returned %+v, want %+v", got, want)
+	}
+}
+
+func TestResolver_Activity(t *testing.T) {
+	r := testResolver()
+
+	tests := []struct {
+		activity pipedrive.Activity
+		due      time.Time
+		duration time.Duration
+	}{
+		{
+			activity: pipedrive.Activity{DueDate: "2019-06-01", DueTime: "09:30", Duration: "01:15"},
+			due:      time.Date(2019, 6, 1, 9, 30, 0, 0, time.UTC),
+			duration: 75 * time.Minute,
+		},
+		{
+			activity: pipedrive.Activity{DueDate: "2019-06-01"},
+			due:      time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			activity: pipedrive.Activity{},
+		},
+	}
+
+	for _, tt := range tests {
+		got := r.Activity(&tt.activity)
+
+		if !got.DueAt.Equal(tt.due) || got.Duration != tt.duration {
+			t.Errorf("Activity of %v %v returned due %v for %v, want %v for %v",
+				tt.activity.DueDate, tt.activity.DueTime, got.DueAt, got.Duration, tt.due, tt.duration)
+		}
+	}
+}
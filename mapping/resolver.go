@@ -0,0 +1,272 @@
+// Package mapping converts Pipedrive entities into neutral records that
+// carry names instead of Pipedrive IDs and field keys, ready to be imported
+// into another CRM.
+package mapping
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/genert/pipedrive-api/pipedrive"
+)
+
+// Field describes a custom field as needed to resolve its values.
+type Field struct {
+	Key     string
+	Name    string
+	Type    pipedrive.FieldType
+	Options map[int]string
+}
+
+// Resolver resolves user, stage, pipeline and custom field references.
+// Unknown references resolve to empty names, custom fields without a
+// definition keep their key.
+type Resolver struct {
+	users     map[int]string
+	stages    map[int]pipedrive.Stage
+	pipelines map[int]string
+	fields    map[string]Field
+}
+
+// NewResolver returns an empty resolver, reference data is added with the
+// Add methods.
+func NewResolver() *Resolver {
+	return &Resolver{
+		users:     make(map[int]string),
+		stages:    make(map[int]pipedrive.Stage),
+		pipelines: make(map[int]string),
+		fields:    make(map[string]Field),
+	}
+}
+
+// Load returns a resolver holding the users, pipelines, stages and deal,
+// person and organization fields of the account.
+func Load(ctx context.Context, client *pipedrive.Client) (*Resolver, error) {
+	r := NewResolver()
+
+	users, _, err := client.Users.List(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r.AddUsers(users.Data...)
+
+	pipelines, _, err := client.PipelinesService.List(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r.AddPipelines(pipelines.Data...)
+
+	stages, _, err := client.Stages.List(ctx, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r.AddStages(stages.Data...)
+
+	dealFields, _, err := client.DealFields.List(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r.AddDealFields(dealFields.Data...)
+
+	personFields, _, err := client.PersonFields.List(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r.AddPersonFields(personFields.Data...)
+
+	organizationFields, _, err := client.OrganizationField.List(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	r.AddOrganizationFields(organizationFields.Data...)
+
+	return r, nil
+}
+
+// AddUsers adds users, whose emails identify owners.
+func (r *Resolver) AddUsers(users ...pipedrive.User) {
+	for _, user := range users {
+		r.users[user.ID] = user.Email
+	}
+}
+
+// AddPipelines adds pipelines.
+func (r *Resolver) AddPipelines(pipelines ...pipedrive.Pipeline) {
+	for _, pipeline := range pipelines {
+		r.pipelines[pipeline.ID] = pipeline.Name
+	}
+}
+
+// AddStages adds stages.
+func (r *Resolver) AddStages(stages ...pipedrive.Stage) {
+	for _, stage := range stages {
+		r.stages[stage.ID] = stage
+	}
+}
+
+// AddFields adds custom field definitions.
+func (r *Resolver) AddFields(fields ...Field) {
+	for _, field := range fields {
+		r.fields[field.Key] = field
+	}
+}
+
+// AddDealFields adds deal field definitions.
+func (r *Resolver) AddDealFields(fields ...pipedrive.DealField) {
+	for _, f := range fields {
+		field := Field{Key: f.Key, Name: f.Name, Type: f.FieldType}
+
+		for _, option := range f.Options {
+			field.addOption(option.ID, option.Label)
+		}
+
+		r.AddFields(field)
+	}
+}
+
+// AddPersonFields adds person field definitions.
+func (r *Resolver) AddPersonFields(fields ...pipedrive.PersonField) {
+	for _, f := range fields {
+		field := Field{Key: f.Key, Name: f.Name, Type: pipedrive.FieldType(f.FieldType)}
+
+		for _, option := range f.Options {
+			field.addOption(option.ID, option.Label)
+		}
+
+		r.AddFields(field)
+	}
+}
+
+// AddOrganizationFields adds organization field definitions.
+func (r *Resolver) AddOrganizationFields(fields ...pipedrive.OrganizationField) {
+	for _, f := range fields {
+		field := Field{Key: f.Key, Name: f.Name, Type: pipedrive.FieldType(f.FieldType)}
+
+		for _, option := range f.Options {
+			field.addOption(option.ID, option.Label)
+		}
+
+		r.AddFields(field)
+	}
+}
+
+func (f *Field) addOption(id int, label string) {
+	if f.Options == nil {
+		f.Options = make(map[int]string)
+	}
+
+	f.Options[id] = label
+}
+
+// UserEmail returns the email of a user.
+func (r *Resolver) UserEmail(id int) string {
+	return r.users[id]
+}
+
+// PipelineName returns the name of a pipeline.
+func (r *Resolver) PipelineName(id int) string {
+	return r.pipelines[id]
+}
+
+// StageName returns the name of a stage.
+func (r *Resolver) StageName(id int) string {
+	return r.stages[id].Name
+}
+
+// CustomFields returns custom field values by field name. Option IDs of
+// enum and set fields are replaced by their labels, monetary fields are
+// returned as pipedrive.Money.
+func (r *Resolver) CustomFields(fields pipedrive.CustomFields) map[string]interface{} {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	values := make(map[string]interface{}, len(fields))
+
+	for key := range fields {
+		field, ok := r.fields[key]
+
+		if !ok {
+			if _, ok := r.fields[baseKey(key)]; ok {
+				// Subfields, such as currencies, are part of their field.
+				continue
+			}
+
+			values[key] = rawValue(fields, key)
+			continue
+		}
+
+		name := field.Name
+
+		if name == "" {
+			name = key
+		}
+
+		values[name] = r.fieldValue(field, fields)
+	}
+
+	return values
+}
+
+func (r *Resolver) fieldValue(field Field, fields pipedrive.CustomFields) interface{} {
+	switch field.Type {
+	case pipedrive.FieldTypeEnum:
+		if id, ok := fields.Enum(field.Key); ok {
+			return field.label(strconv.Itoa(id))
+		}
+	case pipedrive.FieldTypeSet:
+		if ids, ok := fields.String(field.Key); ok {
+			var labels []string
+
+			for _, id := range strings.Split(ids, ",") {
+				if id = strings.TrimSpace(id); id != "" {
+					labels = append(labels, field.label(id))
+				}
+			}
+
+			return labels
+		}
+	case pipedrive.FieldTypeMonetary:
+		if money, ok := fields.Money(field.Key); ok {
+			return money
+		}
+	case pipedrive.FieldTypeUser:
+		if id, ok := fields.Int(field.Key); ok {
+			return r.UserEmail(id)
+		}
+	}
+
+	return rawValue(fields, field.Key)
+}
+
+func (f Field) label(id string) string {
+	if n, err := strconv.Atoi(id); err == nil {
+		if label, ok := f.Options[n]; ok {
+			return label
+		}
+	}
+
+	return id
+}
+
+// baseKey strips the suffix of a subfield key.
+func baseKey(key string) string {
+	if i := strings.IndexByte(key, '_'); i > 0 {
+		return key[:i]
+	}
+
+	return key
+}
@@ -0,0 +1,81 @@
+package mapping
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/genert/pipedrive-api/pipedrive"
+)
+
+// setup starts a test server answering the requests of the returned
+// client with mux.
+func setup() (client *pipedrive.Client, mux *http.ServeMux, teardown func()) {
+	mux = http.NewServeMux()
+	server := httptest.NewTLSServer(mux)
+
+	client = pipedrive.NewClient(&pipedrive.Config{APIKey: "token"})
+	client.BaseURL = &url.URL{Path: strings.TrimPrefix(server.URL, "https://") + "/"}
+	client.SetOptions(pipedrive.WithHTTPClient(&http.Client{
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+	}))
+
+	return client, mux, server.Close
+}
+
+func TestLoad(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	responses := map[string]string{
+		"/v1/users":              `[{"id": 1, "email": "ann@example.com"}]`,
+		"/v1/pipelines":          `[{"id": 2, "name": "Sales"}]`,
+		"/v1/stages":             `[{"id": 3, "name": "Lead"}]`,
+		"/v1/dealFields":         `[{"key": "` + sizeKey + `", "name": "Size", "field_type": "enum", "options": [{"id": 1, "label": "Small"}]}]`,
+		"/v1/personFields":       `[]`,
+		"/v1/organizationFields": `[]`,
+	}
+
+	for path, data := range responses {
+		data := data
+
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"success": true, "data": ` + data + `}`))
+		})
+	}
+
+	r, err := Load(context.Background(), client)
+
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+
+	if r.UserEmail(1) != "ann@example.com" || r.PipelineName(2) != "Sales" || r.StageName(3) != "Lead" {
+		t.Errorf("Load returned %+v, want the user, pipeline and stage", r)
+	}
+
+	values := r.CustomFields(pipedrive.CustomFields{sizeKey: json.RawMessage(`1`)})
+
+	if values["Size"] != "Small" {
+		t.Errorf("CustomFields returned %v, want the option label of the loaded field", values)
+	}
+}
+
+func TestLoad_error(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/users", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	if _, err := Load(context.Background(), client); err == nil {
+		t.Error("Load returned no error for a failing request")
+	}
+}
@@ -0,0 +1,170 @@
+package pipedrive
+
+import (
+	"context"
+	"sort"
+)
+
+// UserAccess represents what a user may see and do: the user's roles with
+// their visibility settings and the permissions of the user's permission
+// sets.
+type UserAccess struct {
+	User        User
+	Roles       []RoleAssignment
+	Settings    RoleSettings
+	Permissions UserPermissions
+}
+
+// DealAccess represents who has access to a deal. Besides the owner and
+// the followers, users can see the deal as visibility allows: everyone
+// for VisibleToEntireCompany, the visibility group of the owner for the
+// group settings.
+type DealAccess struct {
+	DealID    int
+	Title     string
+	VisibleTo VisibleTo
+	OwnerID   int
+	Followers []Follower
+}
+
+// UserIDs returns the owner followed by the users following the deal.
+func (a DealAccess) UserIDs() []int {
+	ids := []int{a.OwnerID}
+
+	for _, follower := range a.Followers {
+		if follower.UserID != a.OwnerID {
+			ids = append(ids, follower.UserID)
+		}
+	}
+
+	return ids
+}
+
+// AccessReport is the result of an access audit of deals. Users holds the
+// owners and followers of the deals by ID.
+type AccessReport struct {
+	Deals []DealAccess
+	Users map[int]*UserAccess
+}
+
+// Owner returns the access of the owner of a deal, nil when the owner is
+// not a user of the account.
+func (r *AccessReport) Owner(deal DealAccess) *UserAccess {
+	return r.Users[deal.OwnerID]
+}
+
+// Inactive returns the users with access to deals that are no longer
+// active, ordered by ID. Deals owned by inactive users usually need a new
+// owner after a migration.
+func (r *AccessReport) Inactive() []*UserAccess {
+	var ids []int
+
+	for id, access := range r.Users {
+		if !access.User.ActiveFlag {
+			ids = append(ids, id)
+		}
+	}
+
+	sort.Ints(ids)
+	inactive := make([]*UserAccess, len(ids))
+
+	for i, id := range ids {
+		inactive[i] = r.Users[id]
+	}
+
+	return inactive
+}
+
+// AuditAccess reports the visibility, owner and followers of the deals,
+// together with the roles, visibility settings and permissions of the
+// users involved, for access audits before and after migrations. Each
+// user is looked up once, however many deals they own or follow.
+func (s *DealService) AuditAccess(ctx context.Context, deals []Deal) (*AccessReport, error) {
+	users, _, err := s.client.Users.List(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int]User, len(users.Data))
+
+	for _, user := range users.Data {
+		byID[user.ID] = user
+	}
+
+	report := &AccessReport{Users: make(map[int]*UserAccess)}
+
+	for _, deal := range deals {
+		access := DealAccess{
+			DealID:    deal.ID,
+			Title:     deal.Title,
+			VisibleTo: deal.VisibleTo,
+			OwnerID:   deal.UserID.ID,
+		}
+
+		if access.OwnerID == 0 {
+			access.OwnerID = deal.UserID.Value
+		}
+
+		followers, _, err := s.client.Followers.List(ctx, FollowedDeal, deal.ID)
+
+		if err != nil {
+			return report, err
+		}
+
+		access.Followers = followers.Data
+
+		for _, id := range access.UserIDs() {
+			if _, ok := report.Users[id]; ok {
+				continue
+			}
+
+			user, ok := byID[id]
+
+			if !ok {
+				continue
+			}
+
+			userAccess, err := s.client.Users.access(ctx, user)
+
+			if err != nil {
+				return report, err
+			}
+
+			report.Users[id] = userAccess
+		}
+
+		report.Deals = append(report.Deals, access)
+	}
+
+	return report, nil
+}
+
+// access looks up the roles, role settings and permissions of a user.
+func (s *UsersService) access(ctx context.Context, user User) (*UserAccess, error) {
+	access := &UserAccess{User: user}
+
+	roles, _, err := s.ListRoleAssignments(ctx, user.ID, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	settings, _, err := s.ListUserRoleSettings(ctx, user.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, _, err := s.ListUserPermissions(ctx, user.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	access.Roles = roles.Data
+	access.Settings = settings.Data
+	access.Permissions = permissions.Data
+
+	return access, nil
+}
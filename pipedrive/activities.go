@@ -2,8 +2,13 @@ package pipedrive
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
 )
 
 // ActivitiesService handles activities related
@@ -13,56 +18,163 @@ import (
 type ActivitiesService service
 
 // Participants represents a Pipedrive participant.
+//
+// Deprecated: use ActivityParticipant instead.
 type Participants struct {
 	PersonID    int  `json:"person_id"`
 	PrimaryFlag bool `json:"primary_flag"`
 }
 
+// ActivityParticipant represents a person participating in an activity.
+type ActivityParticipant struct {
+	PersonID    int  `json:"person_id"`
+	PrimaryFlag bool `json:"primary_flag"`
+}
+
+// ActivityAttendee represents an attendee of a calendar activity. Attendees
+// are identified by email address and may be linked to a person or user.
+type ActivityAttendee struct {
+	EmailAddress string `json:"email_address"`
+	Name         string `json:"name,omitempty"`
+	Status       string `json:"status,omitempty"`
+	IsOrganizer  uint8  `json:"is_organizer,omitempty"`
+	PersonID     int    `json:"person_id,omitempty"`
+	UserID       int    `json:"user_id,omitempty"`
+}
+
+// ActivityDone represents whether an activity is done. It is encoded as
+// 1 or 0, as the API expects, and decodes from both booleans and numbers.
+type ActivityDone bool
+
+// ActivityDone constants.
+const (
+	ActivityMarkedDone ActivityDone = true
+	ActivityNotDone    ActivityDone = false
+)
+
+// MarshalJSON encodes the done flag as 1 or 0.
+func (d ActivityDone) MarshalJSON() ([]byte, error) {
+	if d {
+		return []byte("1"), nil
+	}
+
+	return []byte("0"), nil
+}
+
+// UnmarshalJSON decodes the done flag from true, false, 1 or 0.
+func (d *ActivityDone) UnmarshalJSON(data []byte) error {
+	switch strings.Trim(string(data), `"`) {
+	case "true", "1":
+		*d = true
+	case "false", "0", "null", "":
+		*d = false
+	default:
+		return fmt.Errorf("invalid done value %s", data)
+	}
+
+	return nil
+}
+
+// EncodeValues encodes the done flag as 1 or 0 in query parameters.
+func (d ActivityDone) EncodeValues(key string, v *url.Values) error {
+	if d {
+		v.Set(key, "1")
+	} else {
+		v.Set(key, "0")
+	}
+
+	return nil
+}
+
 // Activity represents a Pipedrive activity.
 type Activity struct {
-	Id                       int         `json:"id"`
-	Type                     string      `json:"type"`
-	Duration                 string      `json:"duration"`
-	Subject                  string      `json:"subject"`
-	Note                     string      `json:"note"`
-	CompanyID                int         `json:"company_id"`
-	UserID                   int         `json:"user_id"`
-	Done                     bool        `json:"done"`
-	DueDate                  string      `json:"due_date"`
-	DueTime                  string      `json:"due_time"`
-	AddTime                  string      `json:"add_time"`
-	MarkedAsDoneTime         string      `json:"marked_as_done_time"`
-	OrgID                    int         `json:"org_id"`
-	PersonID                 int         `json:"person_id"`
-	DealID                   int         `json:"deal_id"`
-	ActiveFlag               bool        `json:"active_flag"`
-	UpdateTime               string      `json:"update_time"`
-	ConferenceMeetingClient  interface{} `json:"conference_meeting_client"`
-	ConferenceMeetingURL     interface{} `json:"conference_meeting_url"`
-	ConferenceMeetingID      int         `json:"conference_meeting_id"`
-	BusyFlag                 bool        `json:"busy_flag"`
-	PublicDescription        string      `json:"public_description"`
-	Location                 string      `json:"location"`
-	UpdateUserID             int         `json:"update_user_id"`
-	SourceTimezone           string      `json:"source_timezone"`
-	LeadID                   int         `json:"lead_id"`
-	LocationSubpremise       interface{} `json:"location_subpremise"`
-	LocationStreetNumber     int         `json:"location_street_number"`
-	LocationRoute            string      `json:"location_route"`
-	LocationSublocality      string      `json:"location_sublocality"`
-	LocationLocality         string      `json:"location_locality"`
-	LocationAdminAreaLevel1  string      `json:"location_admin_area_level_1"`
-	LocationAdminAreaLevel2  string      `json:"location_admin_area_level_2"`
-	LocationCountry          string      `json:"location_country"`
-	LocationPostalCode       string      `json:"location_postal_code"`
-	LocationFormattedAddress string      `json:"location_formatted_address"`
-	ProjectID                int         `json:"project_id"`
+	Id                int          `json:"id"`
+	Type              string       `json:"type"`
+	Duration          DurationHM   `json:"duration"`
+	Subject           string       `json:"subject"`
+	Note              string       `json:"note"`
+	CompanyID         int          `json:"company_id"`
+	UserID            int          `json:"user_id"`
+	Done              ActivityDone `json:"done"`
+	DueDate           DueDate      `json:"due_date"`
+	DueTime           ClockTime    `json:"due_time"`
+	AddTime           string       `json:"add_time"`
+	MarkedAsDoneTime  string       `json:"marked_as_done_time"`
+	OrgID             int          `json:"org_id"`
+	PersonID          int          `json:"person_id"`
+	DealID            int          `json:"deal_id"`
+	ActiveFlag        bool         `json:"active_flag"`
+	UpdateTime        string       `json:"update_time"`
+	BusyFlag          bool         `json:"busy_flag"`
+	PublicDescription string       `json:"public_description"`
+	UpdateUserID      int          `json:"update_user_id"`
+	SourceTimezone    string       `json:"source_timezone"`
+	LeadID            int          `json:"lead_id"`
+	ProjectID         int          `json:"project_id"`
+
+	Participants []ActivityParticipant `json:"participants"`
+	Attendees    []ActivityAttendee    `json:"attendees"`
+
+	// Location holds the location and its geocoded components.
+	Location Address `json:"-"`
+
+	// ConferenceMeeting is the video call of the activity, nil when it
+	// has none.
+	ConferenceMeeting *ConferenceMeeting `json:"-"`
+
+	// Raw holds the JSON the activity was decoded from.
+	Raw json.RawMessage `json:"-"`
 }
 
 func (a Activity) String() string {
 	return Stringify(a)
 }
 
+// UnmarshalJSON decodes an activity and groups its location and its
+// conference meeting.
+func (a *Activity) UnmarshalJSON(data []byte) error {
+	return a.decode(Codec{}, data)
+}
+
+// decode implements modelDecoder.
+func (a *Activity) decode(codec Codec, data []byte) error {
+	type activity Activity
+
+	var v activity
+
+	if err := codec.unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	location, err := decodeAddress(data, "location")
+
+	if err != nil {
+		return err
+	}
+
+	var meeting ConferenceMeeting
+
+	if err := codec.unmarshal(data, &meeting); err != nil {
+		return err
+	}
+
+	*a = Activity(v)
+	a.Location = location
+
+	if meeting != (ConferenceMeeting{}) {
+		a.ConferenceMeeting = &meeting
+	}
+
+	a.Raw = copyRaw(data)
+
+	return nil
+}
+
+// knownFieldPrefixes implements fieldPrefixer.
+func (a *Activity) knownFieldPrefixes() []string {
+	return []string{"location", "conference_meeting_"}
+}
+
 // ActivityResponse represents single activity response.
 type ActivityResponse struct {
 	Success bool     `json:"success"`
@@ -95,39 +207,157 @@ func (s *ActivitiesService) Summary(ctx context.Context) (*Summary, *Response, e
 	return record, resp, nil
 }
 
+// ActivitiesListOptions specifices the optional parameters to the
+// ActivitiesService.List method.
+//
+// Type takes activity type key strings. StartDate and EndDate are
+// formatted as YYYY-MM-DD.
+type ActivitiesListOptions struct {
+	UserID    uint          `url:"user_id,omitempty"`
+	FilterID  uint          `url:"filter_id,omitempty"`
+	Type      []string      `url:"type,omitempty,comma"`
+	Start     uint          `url:"start,omitempty"`
+	Limit     uint          `url:"limit,omitempty"`
+	StartDate string        `url:"start_date,omitempty"`
+	EndDate   string        `url:"end_date,omitempty"`
+	Done      *ActivityDone `url:"done,omitempty"`
+}
+
 // List returns all activities assigned to a particular user
 //
 // https://developers.pipedrive.com/docs/api/v1/#!/Activities/get_activities
-func (s *ActivitiesService) List(ctx context.Context, opts PaginationParameters, urlParametrs map[string]string) (map[string]interface{}, *Response, error) {
-	var (
-		err error
-		req *http.Request
-		f   interface{}
-	)
-	switch {
-	case opts.Limit > 0 || len(opts.Cursor) != 0:
-		req, err = s.client.NewRequest(http.MethodGet, "/activities", &opts, nil)
-	default:
-		req, err = s.client.NewRequest(http.MethodGet, "/activities/collection", nil, nil)
+func (s *ActivitiesService) List(ctx context.Context, opt *ActivitiesListOptions) (*ActivitiesReponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "/activities", opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *ActivitiesReponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
 	}
+
+	return record, resp, nil
+}
+
+// ActivitiesCollectionOptions specifices the optional parameters to the
+// ActivitiesService.ListCollection method.
+//
+// Since and Until are UTC times formatted as YYYY-MM-DD HH:MM:SS and
+// filter activities by their due time.
+type ActivitiesCollectionOptions struct {
+	Cursor string   `url:"cursor,omitempty"`
+	Limit  uint     `url:"limit,omitempty"`
+	Since  string   `url:"since,omitempty"`
+	Until  string   `url:"until,omitempty"`
+	UserID uint     `url:"user_id,omitempty"`
+	Done   *bool    `url:"done,omitempty"`
+	Type   []string `url:"type,omitempty,comma"`
+}
+
+// ListCollection returns all activities of the company. Results are
+// paginated with a cursor, use AdditionalData.NextCursor to fetch the
+// next page. Only available for admin users.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Activities/get_activities_collection
+func (s *ActivitiesService) ListCollection(ctx context.Context, opt *ActivitiesCollectionOptions) (*ActivitiesReponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "/activities/collection", opt, nil)
+
 	if err != nil {
 		return nil, nil, err
 	}
 
-	if len(urlParametrs) != 0 {
-		q := req.URL.Query()
-		for key, value := range urlParametrs {
-			q.Add(key, value)
-		}
-		req.URL.RawQuery = q.Encode()
+	var record *ActivitiesReponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// ActivitiesListForOptions specifices the optional parameters to the
+// ActivitiesService.ListForDeal, ActivitiesService.ListForPerson and
+// ActivitiesService.ListForOrganization methods.
+//
+// Exclude takes the IDs of activities to leave out.
+type ActivitiesListForOptions struct {
+	Start   uint          `url:"start,omitempty"`
+	Limit   uint          `url:"limit,omitempty"`
+	Done    *ActivityDone `url:"done,omitempty"`
+	Exclude []int         `url:"exclude,omitempty,comma"`
+}
+
+// ListForDeal returns the activities of a specific deal.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Deals/get_deals_id_activities
+func (s *ActivitiesService) ListForDeal(ctx context.Context, id int, opt *ActivitiesListForOptions) (*ActivitiesReponse, *Response, error) {
+	uri := fmt.Sprintf("/deals/%v/activities", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, opt, nil)
+
+	if err != nil {
+		return nil, nil, err
 	}
 
-	resp, err := s.client.Do(ctx, req, &f)
+	var record *ActivitiesReponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
 	if err != nil {
 		return nil, resp, err
 	}
 
-	return f.(map[string]interface{}), resp, nil
+	return record, resp, nil
+}
+
+// ListForPerson returns the activities of a specific person.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Persons/get_persons_id_activities
+func (s *ActivitiesService) ListForPerson(ctx context.Context, id int, opt *ActivitiesListForOptions) (*ActivitiesReponse, *Response, error) {
+	uri := fmt.Sprintf("/persons/%v/activities", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *ActivitiesReponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// ListForOrganization returns the activities of a specific organization.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/get_organizations_id_activities
+func (s *ActivitiesService) ListForOrganization(ctx context.Context, id int, opt *ActivitiesListForOptions) (*ActivitiesReponse, *Response, error) {
+	uri := fmt.Sprintf("/organizations/%v/activities", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *ActivitiesReponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
 }
 
 // GetByID returns details of a specific activity.
@@ -176,25 +406,220 @@ func (s *ActivitiesService) Create(ctx context.Context, opt *ActivitiesCreateOpt
 // ActivitiesCreateOptions specifices the optional parameters to the
 // ActivitiesService.Update method.
 type ActivitiesCreateOptions struct {
-	Subject      string      `json:"subject,omitempty"`
-	Done         uint8       `json:"done,omitempty"`
-	Type         string      `json:"type,omitempty"`
-	DueDate      string      `json:"due_date,omitempty"`
-	DueTime      string      `json:"due_time,omitempty"`
-	Duration     string      `json:"duration,omitempty"`
-	UserID       uint        `json:"user_id,omitempty"`
-	DealID       uint        `json:"deal_id,omitempty"`
-	PersonID     uint        `json:"person_id,omitempty"`
-	Participants interface{} `json:"participants,omitempty"`
-	OrgID        uint        `json:"org_id,omitempty"`
+	Subject  string       `json:"subject,omitempty"`
+	Done     ActivityDone `json:"done,omitempty"`
+	Type     string       `json:"type,omitempty"`
+	DueDate  DueDate      `json:"due_date,omitempty"`
+	DueTime  ClockTime    `json:"due_time,omitempty"`
+	Duration DurationHM   `json:"duration,omitempty"`
+	UserID   uint         `json:"user_id,omitempty"`
+	DealID   uint         `json:"deal_id,omitempty"`
+	PersonID uint         `json:"person_id,omitempty"`
+	OrgID    uint         `json:"org_id,omitempty"`
+
+	// SourceTimezone is the IANA time zone the activity was scheduled in,
+	// see ActivitySchedule.
+	SourceTimezone string `json:"source_timezone,omitempty"`
+
+	// Participants are the persons taking part, one of them should have
+	// PrimaryFlag set. Attendees are invited to the calendar event.
+	Participants []ActivityParticipant `json:"participants,omitempty"`
+	Attendees    []ActivityAttendee    `json:"attendees,omitempty"`
+
+	// ConferenceMeetingClient is the marketplace client ID of the video
+	// conferencing integration the meeting URL belongs to. Set them
+	// together with SetConferenceMeeting.
+	ConferenceMeetingClient string `json:"conference_meeting_client,omitempty"`
+	ConferenceMeetingURL    string `json:"conference_meeting_url,omitempty"`
+	ConferenceMeetingID     string `json:"conference_meeting_id,omitempty"`
+}
+
+const (
+	defaultActivityBatchConcurrency = 5
+	defaultActivityBatchMaxRetries  = 3
+)
+
+// ActivityBatchOptions specifices the optional parameters to the
+// ActivitiesService.CreateBatch method.
+type ActivityBatchOptions struct {
+	// Concurrency is the number of activities created at the same time,
+	// 5 when zero.
+	Concurrency int
+
+	// MaxRetries is how often a rate limited create is retried, 3 when
+	// zero. Creates are not retried when it is negative.
+	MaxRetries int
+}
+
+// ActivityBatchResult represents the outcome of creating one activity
+// with ActivitiesService.CreateBatch.
+type ActivityBatchResult struct {
+	// Index of the create options the result belongs to.
+	Index    int
+	Activity *Activity
+	Response *Response
+
+	// Attempts counts the requests sent, more than one when the create
+	// was rate limited.
+	Attempts int
+	Err      error
+}
+
+// ActivityBatchResults are the results of ActivitiesService.CreateBatch.
+type ActivityBatchResults []ActivityBatchResult
+
+// Err returns a *BatchError listing the failed creates, nil when all
+// succeeded.
+func (r ActivityBatchResults) Err() error {
+	var failures []BatchFailure
+
+	for _, result := range r {
+		if result.Err != nil {
+			failures = append(failures, BatchFailure{Index: result.Index, Err: result.Err})
+		}
+	}
+
+	return batchError(len(r), failures)
+}
+
+// CreateBatch creates many activities concurrently. When the rate limit
+// is hit, creating waits for the limit to reset and is retried, up to
+// batchOpt.MaxRetries times. Results are returned in the order of opts,
+// failed creates have Err set to the error of their last attempt.
+func (s *ActivitiesService) CreateBatch(ctx context.Context, opts []ActivitiesCreateOptions, batchOpt *ActivityBatchOptions) ActivityBatchResults {
+	if batchOpt == nil {
+		batchOpt = &ActivityBatchOptions{}
+	}
+
+	concurrency := batchOpt.Concurrency
+
+	if concurrency < 1 {
+		concurrency = defaultActivityBatchConcurrency
+	}
+
+	maxRetries := batchOpt.MaxRetries
+
+	switch {
+	case maxRetries == 0:
+		maxRetries = defaultActivityBatchMaxRetries
+	case maxRetries < 0:
+		maxRetries = 0
+	}
+
+	results := make(ActivityBatchResults, len(opts))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for worker := 0; worker < concurrency; worker++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				results[i] = s.createWithRetry(ctx, i, &opts[i], maxRetries)
+			}
+		}()
+	}
+
+	for i := range opts {
+		indexes <- i
+	}
+
+	close(indexes)
+	wg.Wait()
+
+	return results
+}
+
+func (s *ActivitiesService) createWithRetry(ctx context.Context, index int, opt *ActivitiesCreateOptions, maxRetries int) ActivityBatchResult {
+	result := ActivityBatchResult{Index: index}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			result.Err = err
+			return result
+		}
+
+		result.Attempts++
+		record, resp, err := s.Create(ctx, opt)
+		result.Response, result.Err = resp, err
+
+		if err == nil {
+			if record != nil {
+				result.Activity = &record.Data
+			}
+
+			return result
+		}
+
+		if result.Attempts > maxRetries || !waitForRateLimit(ctx, err) {
+			return result
+		}
+	}
+}
+
+// ActivitiesUpdateOptions specifices the optional parameters to the
+// ActivitiesService.Update method. Only the fields that are set are sent.
+//
+// Set DealID, PersonID, OrgID or ProjectID to NoID() to unlink the activity
+// from them.
+type ActivitiesUpdateOptions struct {
+	Subject           *string       `json:"subject,omitempty"`
+	Done              *ActivityDone `json:"done,omitempty"`
+	Type              *string       `json:"type,omitempty"`
+	DueDate           *DueDate      `json:"due_date,omitempty"`
+	DueTime           *ClockTime    `json:"due_time,omitempty"`
+	Duration          *DurationHM   `json:"duration,omitempty"`
+	SourceTimezone    *string       `json:"source_timezone,omitempty"`
+	UserID            *uint         `json:"user_id,omitempty"`
+	DealID            *OptionalID   `json:"deal_id,omitempty"`
+	LeadID            *string       `json:"lead_id,omitempty"`
+	PersonID          *OptionalID   `json:"person_id,omitempty"`
+	OrgID             *OptionalID   `json:"org_id,omitempty"`
+	ProjectID         *OptionalID   `json:"project_id,omitempty"`
+	Note              *string       `json:"note,omitempty"`
+	Location          *string       `json:"location,omitempty"`
+	PublicDescription *string       `json:"public_description,omitempty"`
+	BusyFlag          *bool         `json:"busy_flag,omitempty"`
+
+	// MarkedAsDoneTime is the time the activity was done, in UTC and
+	// formatted as YYYY-MM-DD HH:MM:SS. See MarkDoneAt.
+	MarkedAsDoneTime *string `json:"marked_as_done_time,omitempty"`
+
+	Participants []ActivityParticipant `json:"participants,omitempty"`
+	Attendees    []ActivityAttendee    `json:"attendees,omitempty"`
+
+	// ConferenceMeetingClient, ConferenceMeetingURL and
+	// ConferenceMeetingID are set together with SetConferenceMeeting.
+	ConferenceMeetingClient *string `json:"conference_meeting_client,omitempty"`
+	ConferenceMeetingURL    *string `json:"conference_meeting_url,omitempty"`
+	ConferenceMeetingID     *string `json:"conference_meeting_id,omitempty"`
+
+	// Null holds the keys of fields to clear.
+	Null NullFields `json:"-"`
+}
+
+// MarshalJSON encodes the options together with the cleared fields.
+func (o ActivitiesUpdateOptions) MarshalJSON() ([]byte, error) {
+	type options ActivitiesUpdateOptions
+
+	data, err := json.Marshal(options(o))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return o.Null.apply(data)
 }
 
 // Update an activity
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Activities/put_activities_id
-func (s *ActivitiesService) Update(ctx context.Context, id int, opt *ActivitiesCreateOptions) (*ActivityResponse, *Response, error) {
+func (s *ActivitiesService) Update(ctx context.Context, id int, opt *ActivitiesUpdateOptions) (*ActivityResponse, *Response, error) {
 	uri := fmt.Sprintf("/activities/%v", id)
-	req, err := s.client.NewRequest(http.MethodPut, uri, opt, nil)
+	req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
 
 	if err != nil {
 		return nil, nil, err
@@ -211,12 +636,33 @@ func (s *ActivitiesService) Update(ctx context.Context, id int, opt *ActivitiesC
 	return record, resp, nil
 }
 
+// MarkDone marks a specific activity as done now.
+func (s *ActivitiesService) MarkDone(ctx context.Context, id int) (*ActivityResponse, *Response, error) {
+	return s.MarkDoneAt(ctx, id, time.Now())
+}
+
+// MarkDoneAt marks a specific activity as done at the given time, which is
+// recorded in MarkedAsDoneTime.
+func (s *ActivitiesService) MarkDoneAt(ctx context.Context, id int, at time.Time) (*ActivityResponse, *Response, error) {
+	done := ActivityMarkedDone
+	doneTime := at.UTC().Format("2006-01-02 15:04:05")
+
+	return s.Update(ctx, id, &ActivitiesUpdateOptions{Done: &done, MarkedAsDoneTime: &doneTime})
+}
+
+// MarkUndone marks a specific activity as not done.
+func (s *ActivitiesService) MarkUndone(ctx context.Context, id int) (*ActivityResponse, *Response, error) {
+	done := ActivityNotDone
+
+	return s.Update(ctx, id, &ActivitiesUpdateOptions{Done: &done})
+}
+
 // DeleteMultiple activities in bulk.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Activities/delete_activities
 func (s *ActivitiesService) DeleteMultiple(ctx context.Context, ids []int) (*Response, error) {
 	req, err := s.client.NewRequest(http.MethodDelete, "/activities", &DeleteMultipleOptions{
-		Ids: arrayToString(ids, ","),
+		Ids: ids,
 	}, nil)
 
 	if err != nil {
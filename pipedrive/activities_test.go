@@ -0,0 +1,103 @@
+package pipedrive
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestActivitiesService_MarkDoneAt(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/activities/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+
+		var body map[string]interface{}
+
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("Decode request body returned error: %v", err)
+		}
+
+		if body["done"] != float64(1) {
+			t.Errorf("Request body done: %v, want 1", body["done"])
+		}
+
+		if want := "2019-06-01 10:30:00"; body["marked_as_done_time"] != want {
+			t.Errorf("Request body marked_as_done_time: %v, want %v", body["marked_as_done_time"], want)
+		}
+
+		writeJSON(w, http.StatusOK, `{"success": true, "data": {"id": 1, "done": true, "marked_as_done_time": "2019-06-01 10:30:00"}}`)
+	})
+
+	at := time.Date(2019, 6, 1, 12, 30, 0, 0, time.FixedZone("CEST", 2*60*60))
+	activity, _, err := client.Activities.MarkDoneAt(context.Background(), 1, at)
+
+	if err != nil {
+		t.Fatalf("Activities.MarkDoneAt returned error: %v", err)
+	}
+
+	if activity.Data.MarkedAsDoneTime != "2019-06-01 10:30:00" {
+		t.Errorf("Activities.MarkDoneAt returned %+v", activity.Data)
+	}
+}
+
+func TestListOptions_commaSeparated(t *testing.T) {
+	client := NewClient(&Config{APIKey: "token"})
+
+	tests := []struct {
+		opt   interface{}
+		key   string
+		want  string
+		empty string
+	}{
+		{&ActivitiesListOptions{Type: []string{"call", "meeting"}}, "type", "call,meeting", "user_id"},
+		{&DealsSearchOptions{Term: "x", Fields: Fields{"title", "notes"}}, "fields", "title,notes", "include_fields"},
+	}
+
+	for _, test := range tests {
+		req, err := client.NewRequest(http.MethodGet, "/", test.opt, nil)
+
+		if err != nil {
+			t.Fatalf("NewRequest returned error: %v", err)
+		}
+
+		query := req.URL.Query()
+
+		if got := query.Get(test.key); got != test.want {
+			t.Errorf("Query parameter %v of %T: %q, want %q", test.key, test.opt, got, test.want)
+		}
+
+		if _, ok := query[test.empty]; ok {
+			t.Errorf("Query of %T has the empty parameter %v", test.opt, test.empty)
+		}
+	}
+}
+
+func TestActivitiesService_CreateBatch_negativeMaxRetries(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	requests := 0
+
+	mux.HandleFunc("/v1/activities", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		requests++
+		writeJSON(w, http.StatusTooManyRequests, `{"success": false, "error": "Too many requests"}`)
+	})
+
+	results := client.Activities.CreateBatch(context.Background(), []ActivitiesCreateOptions{{Subject: "Call"}}, &ActivityBatchOptions{
+		Concurrency: 1,
+		MaxRetries:  -1,
+	})
+
+	if _, ok := results[0].Err.(*RateLimitError); !ok {
+		t.Errorf("CreateBatch returned error %v, want a *RateLimitError", results[0].Err)
+	}
+
+	if results[0].Attempts != 1 || requests != 1 {
+		t.Errorf("CreateBatch made %v attempts with %v requests, want 1 without retries", results[0].Attempts, requests)
+	}
+}
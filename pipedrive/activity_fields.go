@@ -18,7 +18,7 @@ type ActivityField struct {
 	Name               string      `json:"name"`
 	OrderNr            int         `json:"order_nr"`
 	PicklistData       interface{} `json:"picklist_data,omitempty"`
-	FieldType          string      `json:"field_type"`
+	FieldType          FieldType   `json:"field_type"`
 	AddTime            string      `json:"add_time"`
 	UpdateTime         string      `json:"update_time"`
 	ActiveFlag         bool        `json:"active_flag"`
@@ -28,11 +28,15 @@ type ActivityField struct {
 	AddVisibleFlag     bool        `json:"add_visible_flag"`
 	ImportantFlag      bool        `json:"important_flag"`
 	BulkEditAllowed    bool        `json:"bulk_edit_allowed"`
+	SearchableFlag     bool        `json:"searchable_flag"`
+	FilteringAllowed   bool        `json:"filtering_allowed"`
+	SortableFlag       bool        `json:"sortable_flag"`
 	MandatoryFlag      bool        `json:"mandatory_flag"`
-	Options            []struct {
-		ID    int    `json:"id"`
-		Label string `json:"label"`
-	} `json:"options,omitempty"`
+	Options            []Option    `json:"options,omitempty"`
+}
+
+func (a ActivityField) String() string {
+	return Stringify(a)
 }
 
 // ActivityFieldsResponse represents multiple activity fields response.
@@ -62,3 +66,15 @@ func (s *ActivityFieldsService) List(ctx context.Context) (*ActivityFieldsRespon
 
 	return record, resp, nil
 }
+
+// ByKey returns activity fields keyed by their API key, which is the name
+// of the property on activities.
+func (r *ActivityFieldsResponse) ByKey() map[string]ActivityField {
+	fields := make(map[string]ActivityField, len(r.Data))
+
+	for _, field := range r.Data {
+		fields[field.Key] = field
+	}
+
+	return fields
+}
@@ -0,0 +1,115 @@
+package pipedrive
+
+import (
+	"context"
+	"time"
+)
+
+// ActivitySchedule holds when an activity takes place, in the form the API
+// expects: the due time is in UTC, and so is the due date of activities
+// with a due time, which therefore can differ from the local date. The due
+// date of all-day activities is the local date of the owner.
+type ActivitySchedule struct {
+	DueDate        DueDate
+	DueTime        ClockTime
+	Duration       DurationHM
+	SourceTimezone string
+}
+
+// ScheduleAt returns the schedule of an activity starting at start in the
+// time zone loc, typically that of the owner, see User.Location.
+func ScheduleAt(start time.Time, duration time.Duration, loc *time.Location) ActivitySchedule {
+	utc := start.UTC()
+	schedule := ActivitySchedule{
+		DueDate:        NewDueDate(utc),
+		DueTime:        NewClockTime(utc),
+		SourceTimezone: loc.String(),
+	}
+
+	if duration > 0 {
+		schedule.Duration = NewDurationHM(duration)
+	}
+
+	return schedule
+}
+
+// ScheduleOn returns the schedule of an all-day activity on the date day
+// has in the time zone loc.
+func ScheduleOn(day time.Time, loc *time.Location) ActivitySchedule {
+	return ActivitySchedule{
+		DueDate:        NewDueDate(day.In(loc)),
+		SourceTimezone: loc.String(),
+	}
+}
+
+// Start returns the start of the activity, in loc for all-day activities,
+// which start at midnight.
+func (s ActivitySchedule) Start(loc *time.Location) (time.Time, error) {
+	date, err := s.DueDate.Time()
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if s.DueTime == "" {
+		return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc), nil
+	}
+
+	clock, err := time.Parse("15:04", string(s.DueTime))
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return date.Add(time.Duration(clock.Hour())*time.Hour + time.Duration(clock.Minute())*time.Minute), nil
+}
+
+// Apply sets the schedule on the create options.
+func (s ActivitySchedule) Apply(opt *ActivitiesCreateOptions) {
+	opt.DueDate = s.DueDate
+	opt.DueTime = s.DueTime
+	opt.Duration = s.Duration
+	opt.SourceTimezone = s.SourceTimezone
+}
+
+// ApplyUpdate sets the schedule on the update options. The due time and
+// duration are cleared when the schedule has none.
+func (s ActivitySchedule) ApplyUpdate(opt *ActivitiesUpdateOptions) {
+	dueDate, timezone := s.DueDate, s.SourceTimezone
+	opt.DueDate = &dueDate
+	opt.SourceTimezone = &timezone
+
+	if s.DueTime == "" {
+		opt.DueTime = nil
+		opt.Null = append(opt.Null, "due_time")
+	} else {
+		dueTime := s.DueTime
+		opt.DueTime = &dueTime
+	}
+
+	if s.Duration == "" {
+		opt.Duration = nil
+		opt.Null = append(opt.Null, "duration")
+	} else {
+		duration := s.Duration
+		opt.Duration = &duration
+	}
+}
+
+// ScheduleFor returns the schedule of an activity of the user starting at
+// start, in the time zone of the user.
+func (s *ActivitiesService) ScheduleFor(ctx context.Context, userID int, start time.Time, duration time.Duration) (ActivitySchedule, *Response, error) {
+	user, resp, err := s.client.Users.GetByID(ctx, userID)
+
+	if err != nil {
+		return ActivitySchedule{}, resp, err
+	}
+
+	loc, err := user.Data.Location()
+
+	if err != nil {
+		return ActivitySchedule{}, resp, err
+	}
+
+	return ScheduleAt(start, duration, loc), resp, nil
+}
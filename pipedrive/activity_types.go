@@ -12,18 +12,64 @@ import (
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ActivityTypes
 type ActivityTypesService service
 
+// Activity type icons.
+type ActivityTypeIcon string
+
+const (
+	ActivityTypeIconTask         ActivityTypeIcon = "task"
+	ActivityTypeIconEmail        ActivityTypeIcon = "email"
+	ActivityTypeIconMeeting      ActivityTypeIcon = "meeting"
+	ActivityTypeIconDeadline     ActivityTypeIcon = "deadline"
+	ActivityTypeIconCall         ActivityTypeIcon = "call"
+	ActivityTypeIconLunch        ActivityTypeIcon = "lunch"
+	ActivityTypeIconCalendar     ActivityTypeIcon = "calendar"
+	ActivityTypeIconDownArrow    ActivityTypeIcon = "downarrow"
+	ActivityTypeIconDocument     ActivityTypeIcon = "document"
+	ActivityTypeIconSmartphone   ActivityTypeIcon = "smartphone"
+	ActivityTypeIconCamera       ActivityTypeIcon = "camera"
+	ActivityTypeIconScissors     ActivityTypeIcon = "scissors"
+	ActivityTypeIconCogs         ActivityTypeIcon = "cogs"
+	ActivityTypeIconBubble       ActivityTypeIcon = "bubble"
+	ActivityTypeIconUpArrow      ActivityTypeIcon = "uparrow"
+	ActivityTypeIconCheckbox     ActivityTypeIcon = "checkbox"
+	ActivityTypeIconSignpost     ActivityTypeIcon = "signpost"
+	ActivityTypeIconShuffle      ActivityTypeIcon = "shuffle"
+	ActivityTypeIconAddressBook  ActivityTypeIcon = "addressbook"
+	ActivityTypeIconLineGraph    ActivityTypeIcon = "linegraph"
+	ActivityTypeIconPicture      ActivityTypeIcon = "picture"
+	ActivityTypeIconCar          ActivityTypeIcon = "car"
+	ActivityTypeIconWorld        ActivityTypeIcon = "world"
+	ActivityTypeIconSearch       ActivityTypeIcon = "search"
+	ActivityTypeIconClip         ActivityTypeIcon = "clip"
+	ActivityTypeIconSound        ActivityTypeIcon = "sound"
+	ActivityTypeIconBrush        ActivityTypeIcon = "brush"
+	ActivityTypeIconKey          ActivityTypeIcon = "key"
+	ActivityTypeIconPadlock      ActivityTypeIcon = "padlock"
+	ActivityTypeIconPriceTag     ActivityTypeIcon = "pricetag"
+	ActivityTypeIconSuitcase     ActivityTypeIcon = "suitcase"
+	ActivityTypeIconFinish       ActivityTypeIcon = "finish"
+	ActivityTypeIconPlane        ActivityTypeIcon = "plane"
+	ActivityTypeIconLoop         ActivityTypeIcon = "loop"
+	ActivityTypeIconWifi         ActivityTypeIcon = "wifi"
+	ActivityTypeIconTruck        ActivityTypeIcon = "truck"
+	ActivityTypeIconCart         ActivityTypeIcon = "cart"
+	ActivityTypeIconBulb         ActivityTypeIcon = "bulb"
+	ActivityTypeIconBell         ActivityTypeIcon = "bell"
+	ActivityTypeIconPresentation ActivityTypeIcon = "presentation"
+)
+
 // ActivityType represents a Pipedrive activity type.
 type ActivityType struct {
-	ID           int         `json:"id"`
-	OrderNr      int         `json:"order_nr"`
-	Name         string      `json:"name"`
-	KeyString    string      `json:"key_string"`
-	IconKey      string      `json:"icon_key"`
-	ActiveFlag   bool        `json:"active_flag"`
-	Color        interface{} `json:"color"`
-	IsCustomFlag bool        `json:"is_custom_flag"`
-	AddTime      string      `json:"add_time"`
-	UpdateTime   interface{} `json:"update_time"`
+	ID           int              `json:"id"`
+	OrderNr      int              `json:"order_nr"`
+	Name         string           `json:"name"`
+	KeyString    string           `json:"key_string"`
+	IconKey      ActivityTypeIcon `json:"icon_key"`
+	ActiveFlag   bool             `json:"active_flag"`
+	Color        string           `json:"color"`
+	IsCustomFlag bool             `json:"is_custom_flag"`
+	AddTime      string           `json:"add_time"`
+	UpdateTime   string           `json:"update_time"`
 }
 
 func (at ActivityType) String() string {
@@ -66,10 +112,12 @@ func (s *ActivityTypesService) List(ctx context.Context) (*ActivityTypesResponse
 
 // ActivityTypesAddOptions specifices the optional parameters to the
 // ActivityTypesService.Create method.
+//
+// Color is a hexadecimal color code without the leading hash, e.g. "25D366".
 type ActivityTypesAddOptions struct {
-	Name    string `url:"name"`
-	IconKey string `url:"icon_key"`
-	Color   string `url:"color,omitempty"`
+	Name    string           `json:"name"`
+	IconKey ActivityTypeIcon `json:"icon_key"`
+	Color   string           `json:"color,omitempty"`
 }
 
 // Create a new activity type.
@@ -96,17 +144,17 @@ func (s *ActivityTypesService) Create(ctx context.Context, opt *ActivityTypesAdd
 // ActivityTypesEditOptions specifices the optional parameters to the
 // ActivityTypesService.Update method.
 type ActivityTypesEditOptions struct {
-	Name    string `url:"name,omitempty"`
-	IconKey string `url:"icon_key,omitempty"`
-	Color   string `url:"color,omitempty"`
-	OrderNr uint   `url:"order_nr,omitempty"`
+	Name    string           `json:"name,omitempty"`
+	IconKey ActivityTypeIcon `json:"icon_key,omitempty"`
+	Color   string           `json:"color,omitempty"`
+	OrderNr uint             `json:"order_nr,omitempty"`
 }
 
 // Update activity type.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ActivityTypes/put_activityTypes_id
 func (s *ActivityTypesService) Update(ctx context.Context, id int, opt *ActivityTypesEditOptions) (*ActivityTypeResponse, *Response, error) {
-	uri := fmt.Sprintf("/activityTpes/%v", id)
+	uri := fmt.Sprintf("/activityTypes/%v", id)
 	req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
 
 	if err != nil {
@@ -129,7 +177,7 @@ func (s *ActivityTypesService) Update(ctx context.Context, id int, opt *Activity
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ActivityTypes/delete_activityTypes
 func (s *ActivityTypesService) DeleteMultiple(ctx context.Context, ids []int) (*Response, error) {
 	req, err := s.client.NewRequest(http.MethodDelete, "/activityTypes", &DeleteMultipleOptions{
-		Ids: arrayToString(ids, ","),
+		Ids: ids,
 	}, nil)
 
 	if err != nil {
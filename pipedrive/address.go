@@ -0,0 +1,166 @@
+package pipedrive
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Address represents a location as entered in Pipedrive together with
+// the components it was geocoded into.
+type Address struct {
+	Value            string
+	Subpremise       string
+	StreetNumber     string
+	Route            string
+	Sublocality      string
+	Locality         string
+	AdminAreaLevel1  string
+	AdminAreaLevel2  string
+	Country          string
+	PostalCode       string
+	FormattedAddress string
+
+	// Lat and Lng hold the geocoded coordinates, they are nil when
+	// Pipedrive does not return them.
+	Lat *float64
+	Lng *float64
+}
+
+func (a Address) String() string {
+	return Stringify(a)
+}
+
+// IsZero reports whether no address is set.
+func (a Address) IsZero() bool {
+	return a == Address{}
+}
+
+// Coordinates returns the geocoded latitude and longitude, ok is false when
+// they are not known.
+func (a Address) Coordinates() (lat, lng float64, ok bool) {
+	if a.Lat == nil || a.Lng == nil {
+		return 0, 0, false
+	}
+
+	return *a.Lat, *a.Lng, true
+}
+
+// Formatted returns the geocoded address. When Pipedrive could not geocode
+// the address it is built from the components, falling back to the value
+// as entered.
+func (a Address) Formatted() string {
+	if a.FormattedAddress != "" {
+		return a.FormattedAddress
+	}
+
+	street := strings.TrimSpace(a.StreetNumber + " " + a.Route)
+	locality := strings.TrimSpace(a.PostalCode + " " + a.Locality)
+
+	var parts []string
+
+	for _, part := range []string{street, a.Subpremise, a.Sublocality, locality, a.AdminAreaLevel1, a.Country} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	if len(parts) == 0 {
+		return a.Value
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// decodeAddress groups the address stored under key and its key_* components
+// in a JSON object. Components may be strings, numbers or null.
+func decodeAddress(data []byte, key string) (Address, error) {
+	var object map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &object); err != nil {
+		return Address{}, err
+	}
+
+	lookup := func(name string) (json.RawMessage, bool) {
+		if name != "" {
+			name = key + "_" + name
+		} else {
+			name = key
+		}
+
+		raw, ok := object[name]
+
+		return raw, ok
+	}
+
+	component := func(name string) string {
+		raw, ok := lookup(name)
+
+		if !ok {
+			return ""
+		}
+
+		var s string
+
+		if err := json.Unmarshal(raw, &s); err == nil {
+			return s
+		}
+
+		var n json.Number
+
+		if err := json.Unmarshal(raw, &n); err == nil {
+			return n.String()
+		}
+
+		return ""
+	}
+
+	coordinate := func(name string) *float64 {
+		raw, ok := lookup(name)
+
+		if !ok {
+			return nil
+		}
+
+		var n json.Number
+
+		if err := json.Unmarshal(raw, &n); err != nil {
+			var s string
+
+			if err := json.Unmarshal(raw, &s); err != nil || s == "" {
+				return nil
+			}
+
+			n = json.Number(s)
+		}
+
+		f, err := n.Float64()
+
+		if err != nil {
+			return nil
+		}
+
+		return &f
+	}
+
+	lng := coordinate("long")
+
+	if lng == nil {
+		lng = coordinate("lng")
+	}
+
+	return Address{
+		Value:            component(""),
+		Subpremise:       component("subpremise"),
+		StreetNumber:     component("street_number"),
+		Route:            component("route"),
+		Sublocality:      component("sublocality"),
+		Locality:         component("locality"),
+		AdminAreaLevel1:  component("admin_area_level_1"),
+		AdminAreaLevel2:  component("admin_area_level_2"),
+		Country:          component("country"),
+		PostalCode:       component("postal_code"),
+		FormattedAddress: component("formatted_address"),
+		Lat:              coordinate("lat"),
+		Lng:              lng,
+	}, nil
+}
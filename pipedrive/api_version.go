@@ -0,0 +1,46 @@
+package pipedrive
+
+import "strings"
+
+// APIVersion represents a version of the Pipedrive API.
+type APIVersion int
+
+// APIVersion constants.
+const (
+	APIVersion1 APIVersion = 1
+	APIVersion2 APIVersion = 2
+)
+
+func (v APIVersion) path() string {
+	if v == APIVersion2 {
+		return apiVersion2
+	}
+
+	return apiVersion1
+}
+
+// WithAPIVersion returns an option for Client.SetOptions that routes the
+// requests for a resource, such as "deals", to the given API version.
+func WithAPIVersion(resource string, version APIVersion) func(*Client) error {
+	return func(c *Client) error {
+		if c.APIVersions == nil {
+			c.APIVersions = make(map[string]APIVersion)
+		}
+
+		c.APIVersions[strings.Trim(resource, "/")] = version
+
+		return nil
+	}
+}
+
+// versionPath returns the path prefix of the API version the request path
+// is routed to.
+func (c *Client) versionPath(path string) string {
+	resource := strings.TrimPrefix(path, "/")
+
+	if i := strings.IndexAny(resource, "/?"); i >= 0 {
+		resource = resource[:i]
+	}
+
+	return c.APIVersions[resource].path()
+}
@@ -0,0 +1,59 @@
+package pipedrive
+
+import (
+	"context"
+	"net/http"
+)
+
+// BillingService handles billing related
+// methods of the Pipedrive API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Billing
+type BillingService service
+
+// Addon represents a paid Pipedrive add-on enabled for the company.
+type Addon struct {
+	Code string `json:"code"`
+}
+
+func (a Addon) String() string {
+	return Stringify(a)
+}
+
+// AddonsResponse represents multiple add-ons response.
+type AddonsResponse struct {
+	Success bool    `json:"success"`
+	Data    []Addon `json:"data"`
+}
+
+// HasAddon reports whether the add-on with the given code is enabled.
+func (r *AddonsResponse) HasAddon(code string) bool {
+	for _, addon := range r.Data {
+		if addon.Code == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ListAddons returns the add-ons of the company subscription.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Billing/get_billing_subscriptions_addons
+func (s *BillingService) ListAddons(ctx context.Context) (*AddonsResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "/billing/subscriptions/addons", nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *AddonsResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
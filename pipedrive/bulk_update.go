@@ -0,0 +1,149 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+const (
+	defaultBulkConcurrency = 5
+	defaultBulkMaxRetries  = 3
+)
+
+// BulkUpdate represents the update of one entity by a BulkUpdater.
+type BulkUpdate struct {
+	ID int
+
+	// Options are the update options of the entity, for example
+	// *DealsUpdateOptions. They are validated like in the service methods.
+	Options interface{}
+}
+
+// BulkUpdateResult represents the outcome of one BulkUpdate.
+type BulkUpdateResult struct {
+	ID       int
+	Response *Response
+
+	// Attempts counts the requests sent, more than one when the update
+	// was rate limited.
+	Attempts int
+	Err      error
+}
+
+// BulkUpdateResults are the results of BulkUpdater.Run.
+type BulkUpdateResults []BulkUpdateResult
+
+// Err returns a *BatchError listing the failed updates, nil when all
+// succeeded.
+func (r BulkUpdateResults) Err() error {
+	var failures []BatchFailure
+
+	for i, result := range r {
+		if result.Err != nil {
+			failures = append(failures, BatchFailure{Index: i, ID: result.ID, Err: result.Err})
+		}
+	}
+
+	return batchError(len(r), failures)
+}
+
+// BulkUpdater updates many entities of one resource concurrently. When the
+// rate limit is hit, updates wait for the limit to reset and are retried.
+type BulkUpdater struct {
+	client   *Client
+	resource string
+
+	// Concurrency is the number of updates sent at the same time,
+	// 5 when zero.
+	Concurrency int
+
+	// MaxRetries is how often a rate limited update is retried, 3 when
+	// zero. Updates are not retried when it is negative.
+	MaxRetries int
+}
+
+// NewBulkUpdater returns a BulkUpdater for the resource at the given path,
+// for example "deals", "persons" or "activities".
+func (c *Client) NewBulkUpdater(resource string) *BulkUpdater {
+	return &BulkUpdater{
+		client:   c,
+		resource: strings.Trim(resource, "/"),
+	}
+}
+
+// Run performs the updates and returns their results in the same order.
+// Failed updates have Err set, the others are still performed.
+func (b *BulkUpdater) Run(ctx context.Context, updates []BulkUpdate) BulkUpdateResults {
+	concurrency := b.Concurrency
+
+	if concurrency < 1 {
+		concurrency = defaultBulkConcurrency
+	}
+
+	results := make(BulkUpdateResults, len(updates))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for worker := 0; worker < concurrency; worker++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				results[i] = b.updateWithRetry(ctx, updates[i])
+			}
+		}()
+	}
+
+	for i := range updates {
+		indexes <- i
+	}
+
+	close(indexes)
+	wg.Wait()
+
+	return results
+}
+
+func (b *BulkUpdater) updateWithRetry(ctx context.Context, update BulkUpdate) BulkUpdateResult {
+	maxRetries := b.MaxRetries
+
+	switch {
+	case maxRetries == 0:
+		maxRetries = defaultBulkMaxRetries
+	case maxRetries < 0:
+		maxRetries = 0
+	}
+
+	result := BulkUpdateResult{ID: update.ID}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			result.Err = err
+			return result
+		}
+
+		result.Attempts++
+		result.Response, result.Err = b.update(ctx, update)
+
+		if result.Err == nil || result.Attempts > maxRetries || !waitForRateLimit(ctx, result.Err) {
+			return result
+		}
+	}
+}
+
+func (b *BulkUpdater) update(ctx context.Context, update BulkUpdate) (*Response, error) {
+	uri := fmt.Sprintf("/%v/%v", b.resource, update.ID)
+	req, err := b.client.NewRequest(http.MethodPut, uri, nil, update.Options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return b.client.Do(ctx, req, nil)
+}
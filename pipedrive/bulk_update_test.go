@@ -0,0 +1,34 @@
+package pipedrive
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestBulkUpdater_Run_negativeMaxRetries(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	requests := 0
+
+	mux.HandleFunc("/v1/deals/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPut)
+		requests++
+		writeJSON(w, http.StatusTooManyRequests, `{"success": false, "error": "Too many requests"}`)
+	})
+
+	updater := client.NewBulkUpdater("deals")
+	updater.MaxRetries = -1
+
+	title := "Deal"
+	results := updater.Run(context.Background(), []BulkUpdate{{ID: 1, Options: &DealsUpdateOptions{Title: &title}}})
+
+	if _, ok := results[0].Err.(*RateLimitError); !ok {
+		t.Errorf("Run returned error %v, want a *RateLimitError", results[0].Err)
+	}
+
+	if results[0].Attempts != 1 || requests != 1 {
+		t.Errorf("Run made %v attempts with %v requests, want 1 without retries", results[0].Attempts, requests)
+	}
+}
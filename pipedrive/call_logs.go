@@ -0,0 +1,234 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+)
+
+// CallLogsService handles call logs related
+// methods of the Pipedrive API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/CallLogs
+type CallLogsService service
+
+// CallOutcome represents the outcome of a logged call.
+type CallOutcome string
+
+// CallOutcome constants.
+const (
+	CallOutcomeConnected     CallOutcome = "connected"
+	CallOutcomeNoAnswer      CallOutcome = "no_answer"
+	CallOutcomeLeftMessage   CallOutcome = "left_message"
+	CallOutcomeLeftVoicemail CallOutcome = "left_voicemail"
+	CallOutcomeWrongNumber   CallOutcome = "wrong_number"
+	CallOutcomeBusy          CallOutcome = "busy"
+)
+
+// CallLog represents a Pipedrive call log.
+type CallLog struct {
+	ID              string      `json:"id"`
+	ActivityID      int         `json:"activity_id"`
+	PersonID        int         `json:"person_id"`
+	OrgID           int         `json:"org_id"`
+	DealID          int         `json:"deal_id"`
+	LeadID          string      `json:"lead_id"`
+	Subject         string      `json:"subject"`
+	Duration        string      `json:"duration"`
+	Outcome         CallOutcome `json:"outcome"`
+	FromPhoneNumber string      `json:"from_phone_number"`
+	ToPhoneNumber   string      `json:"to_phone_number"`
+	HasRecording    bool        `json:"has_recording"`
+	StartTime       string      `json:"start_time"`
+	EndTime         string      `json:"end_time"`
+	UserID          int         `json:"user_id"`
+	CompanyID       int         `json:"company_id"`
+	Note            string      `json:"note"`
+}
+
+func (c CallLog) String() string {
+	return Stringify(c)
+}
+
+// CallLogsResponse represents multiple call logs response.
+type CallLogsResponse struct {
+	Success        bool           `json:"success"`
+	Data           []CallLog      `json:"data"`
+	AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// CallLogResponse represents single call log response.
+type CallLogResponse struct {
+	Success bool    `json:"success"`
+	Data    CallLog `json:"data"`
+}
+
+// CallLogsListOptions specifices the optional parameters to the
+// CallLogsService.List method.
+type CallLogsListOptions struct {
+	Start uint `url:"start,omitempty"`
+	Limit uint `url:"limit,omitempty"`
+}
+
+// List returns all call logs assigned to the authorized user.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/CallLogs/get_callLogs
+func (s *CallLogsService) List(ctx context.Context, opt *CallLogsListOptions) (*CallLogsResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "/callLogs", opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *CallLogsResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// GetByID returns details of a specific call log.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/CallLogs/get_callLogs_id
+func (s *CallLogsService) GetByID(ctx context.Context, id string) (*CallLogResponse, *Response, error) {
+	uri := fmt.Sprintf("/callLogs/%v", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *CallLogResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// CallLogCreateOptions specifices the parameters to the
+// CallLogsService.Create method.
+//
+// StartTime and EndTime are in UTC, formatted as YYYY-MM-DD HH:MM:SS.
+type CallLogCreateOptions struct {
+	UserID          uint        `json:"user_id,omitempty"`
+	ActivityID      uint        `json:"activity_id,omitempty"`
+	Subject         string      `json:"subject,omitempty"`
+	Duration        string      `json:"duration,omitempty"`
+	Outcome         CallOutcome `json:"outcome"`
+	FromPhoneNumber string      `json:"from_phone_number,omitempty"`
+	ToPhoneNumber   string      `json:"to_phone_number"`
+	StartTime       string      `json:"start_time"`
+	EndTime         string      `json:"end_time"`
+	PersonID        uint        `json:"person_id,omitempty"`
+	OrgID           uint        `json:"org_id,omitempty"`
+	DealID          uint        `json:"deal_id,omitempty"`
+	LeadID          string      `json:"lead_id,omitempty"`
+	Note            string      `json:"note,omitempty"`
+}
+
+// Validate checks the required fields and the outcome value.
+func (o CallLogCreateOptions) Validate() error {
+	switch o.Outcome {
+	case "":
+		return requiredError("outcome")
+	case CallOutcomeConnected, CallOutcomeNoAnswer, CallOutcomeLeftMessage,
+		CallOutcomeLeftVoicemail, CallOutcomeWrongNumber, CallOutcomeBusy:
+	default:
+		return enumError("outcome", o.Outcome)
+	}
+
+	if o.ToPhoneNumber == "" {
+		return requiredError("to_phone_number")
+	}
+
+	if o.StartTime == "" {
+		return requiredError("start_time")
+	}
+
+	if o.EndTime == "" {
+		return requiredError("end_time")
+	}
+
+	return nil
+}
+
+// Create adds a new call log.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/CallLogs/post_callLogs
+func (s *CallLogsService) Create(ctx context.Context, opt *CallLogCreateOptions) (*CallLogResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "/callLogs", nil, opt)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *CallLogResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// UploadRecording attaches an audio recording to a specific call log.
+// The content is read from r and sent as multipart/form-data.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/CallLogs/post_callLogs_id_recordings
+func (s *CallLogsService) UploadRecording(ctx context.Context, id string, r io.Reader, fileName string) (*Response, error) {
+	body, contentType, err := multipartBody(nil, fileName, r)
+
+	if err != nil {
+		return nil, err
+	}
+
+	uri := fmt.Sprintf("/callLogs/%v/recordings", id)
+	req, err := s.client.NewUploadRequest(http.MethodPost, uri, nil, body, contentType)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// UploadRecordingFile attaches an audio recording from the local file system
+// to a specific call log.
+func (s *CallLogsService) UploadRecordingFile(ctx context.Context, id string, filePath string) (*Response, error) {
+	file, err := os.Open(filePath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	return s.UploadRecording(ctx, id, file, filePath)
+}
+
+// Delete removes a call log. Attached recordings are deleted as well,
+// while activities and notes are kept.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/CallLogs/delete_callLogs_id
+func (s *CallLogsService) Delete(ctx context.Context, id string) (*Response, error) {
+	uri := fmt.Sprintf("/callLogs/%v", id)
+	req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,300 @@
+package pipedrive
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultChangeFeedPollInterval = time.Minute
+
+// ChangeSource tells how a change of a ChangeFeed was received.
+type ChangeSource string
+
+// ChangeSource constants.
+const (
+	ChangeFromWebhook ChangeSource = "webhook"
+	ChangeFromPoll    ChangeSource = "poll"
+)
+
+// Change is a change returned by ChangeFeed.Next. The embedded event is
+// the webhook event, or an event synthesized from /recents.
+type Change struct {
+	Event
+
+	Source ChangeSource
+
+	// Cursor is the position of the feed once the change is processed.
+	Cursor time.Time
+}
+
+// ChangeCursorStore persists the position of a ChangeFeed.
+type ChangeCursorStore interface {
+	// Load returns the saved cursor, the zero time when there is none.
+	Load() (time.Time, error)
+
+	// Save replaces the saved cursor.
+	Save(cursor time.Time) error
+}
+
+// ChangeFeedOptions specifices the optional parameters to the
+// Client.ChangeFeed method.
+type ChangeFeedOptions struct {
+	// Webhooks, if set, is the handler receiving the webhooks of the
+	// account. Its events are returned as they arrive, while polling only
+	// fills in the changes that were missed.
+	Webhooks *WebhookHandler
+
+	// Objects limits the changes to these objects, all objects when
+	// empty.
+	Objects []EventObject
+
+	// PollInterval is the time between polls of /recents, 1 minute when
+	// zero.
+	PollInterval time.Duration
+
+	// Cursor, if set, persists the position of the feed, so it resumes
+	// where it stopped after a restart.
+	Cursor ChangeCursorStore
+
+	// Since is where a feed without saved cursor starts, now when zero.
+	Since time.Time
+}
+
+// ChangeFeed is a single stream of the changes of an account, for
+// consumers that may or may not be able to receive webhooks. Changes are
+// read one at a time with Next. Calling Next again acknowledges the
+// previous change and saves the cursor, so after a crash changes are
+// returned again rather than lost. Changes found by one poll are returned
+// in the order they happened.
+type ChangeFeed struct {
+	reconciler *RecentsReconciler
+	store      ChangeCursorStore
+	interval   time.Duration
+	webhooks   <-chan Event
+
+	mu       sync.Mutex
+	pending  []Change
+	last     *Change
+	saved    time.Time
+	nextPoll time.Time
+}
+
+// ChangeFeed returns a feed of the changes after the saved cursor. When
+// opt.Webhooks is set, the feed subscribes to it until ctx is done.
+func (c *Client) ChangeFeed(ctx context.Context, opt *ChangeFeedOptions) (*ChangeFeed, error) {
+	if opt == nil {
+		opt = &ChangeFeedOptions{}
+	}
+
+	since := opt.Since
+
+	if opt.Cursor != nil {
+		cursor, err := opt.Cursor.Load()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !cursor.IsZero() {
+			since = cursor
+		}
+	}
+
+	if since.IsZero() {
+		since = time.Now()
+	}
+
+	f := &ChangeFeed{
+		reconciler: c.NewRecentsReconciler(since),
+		store:      opt.Cursor,
+		interval:   opt.PollInterval,
+		saved:      since,
+	}
+
+	f.reconciler.Objects = opt.Objects
+
+	if f.interval <= 0 {
+		f.interval = defaultChangeFeedPollInterval
+	}
+
+	if opt.Webhooks != nil {
+		var filters []EventFilter
+
+		if len(opt.Objects) > 0 {
+			filters = append(filters, FilterObjects(opt.Objects...))
+		}
+
+		events, err := opt.Webhooks.Subscribe(ctx, filters...)
+
+		if err != nil {
+			return nil, err
+		}
+
+		f.webhooks = events
+	}
+
+	return f, nil
+}
+
+// Next acknowledges the change returned before and waits for the next
+// one, until ctx is done. Polls that fail are returned as error and
+// repeated by the next call.
+func (f *ChangeFeed) Next(ctx context.Context) (Change, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.last != nil {
+		if err := f.save(f.last.Cursor); err != nil {
+			return Change{}, err
+		}
+
+		f.last = nil
+	}
+
+	for len(f.pending) == 0 {
+		timer := time.NewTimer(f.nextPoll.Sub(time.Now()))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return Change{}, ctx.Err()
+		case event, ok := <-f.webhooks:
+			timer.Stop()
+
+			if !ok {
+				f.webhooks = nil
+				continue
+			}
+
+			f.reconciler.Seen(&event)
+			f.last = &Change{Event: event, Source: ChangeFromWebhook, Cursor: f.reconciler.Checkpoint()}
+
+			return *f.last, nil
+		case <-timer.C:
+		}
+
+		if err := f.poll(ctx); err != nil {
+			return Change{}, err
+		}
+	}
+
+	change := f.pending[0]
+	f.pending = f.pending[1:]
+	f.last = &change
+
+	return change, nil
+}
+
+// poll reconciles /recents and queues the missed changes. The changes but
+// the last keep the cursor from before the poll, so the new cursor is only
+// saved once all of them were processed.
+func (f *ChangeFeed) poll(ctx context.Context) error {
+	previous := f.reconciler.Checkpoint()
+	events, _, err := f.reconciler.Reconcile(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	f.nextPoll = time.Now().Add(f.interval)
+	cursor := f.reconciler.Checkpoint()
+
+	if len(events) == 0 {
+		return f.save(cursor)
+	}
+
+	sort.Stable(byEventTime(events))
+
+	for i, event := range events {
+		change := Change{Event: event, Source: ChangeFromPoll, Cursor: previous}
+
+		if i == len(events)-1 {
+			change.Cursor = cursor
+		}
+
+		f.pending = append(f.pending, change)
+	}
+
+	return nil
+}
+
+func (f *ChangeFeed) save(cursor time.Time) error {
+	if f.store == nil || !cursor.After(f.saved) {
+		return nil
+	}
+
+	if err := f.store.Save(cursor); err != nil {
+		return err
+	}
+
+	f.saved = cursor
+
+	return nil
+}
+
+type byEventTime []Event
+
+func (e byEventTime) Len() int           { return len(e) }
+func (e byEventTime) Swap(i, j int)      { e[i], e[j] = e[j], e[i] }
+func (e byEventTime) Less(i, j int) bool { return e[i].Meta.Time().Before(e[j].Meta.Time()) }
+
+// MemoryCursorStore is a ChangeCursorStore in memory, for feeds that
+// restart from Since.
+type MemoryCursorStore struct {
+	mu     sync.Mutex
+	cursor time.Time
+}
+
+// Load implements ChangeCursorStore.
+func (s *MemoryCursorStore) Load() (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cursor, nil
+}
+
+// Save implements ChangeCursorStore.
+func (s *MemoryCursorStore) Save(cursor time.Time) error {
+	s.mu.Lock()
+	s.cursor = cursor
+	s.mu.Unlock()
+
+	return nil
+}
+
+// FileCursorStore is a ChangeCursorStore in a file, which is replaced
+// atomically on every save.
+type FileCursorStore struct {
+	Path string
+}
+
+// Load implements ChangeCursorStore.
+func (s FileCursorStore) Load() (time.Time, error) {
+	data, err := ioutil.ReadFile(s.Path)
+
+	if os.IsNotExist(err) {
+		return time.Time{}, nil
+	}
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Parse(time.RFC3339Nano, strings.TrimSpace(string(data)))
+}
+
+// Save implements ChangeCursorStore.
+func (s FileCursorStore) Save(cursor time.Time) error {
+	tmp := s.Path + ".tmp"
+
+	if err := ioutil.WriteFile(tmp, []byte(cursor.UTC().Format(time.RFC3339Nano)+"\n"), 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, s.Path)
+}
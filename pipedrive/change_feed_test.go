@@ -0,0 +1,258 @@
+package pipedrive
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestChangeFeed_Next_poll(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/recents", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("since_timestamp"); got != "2020-01-01 09:00:00" {
+			t.Errorf("Request since_timestamp is %v, want the saved cursor", got)
+		}
+
+		writeJSON(w, http.StatusOK, `{"success": true, "data": [
+			{"item": "deal", "id": 2, "data": {"id": 2, "update_time": "2020-01-01 11:00:00"}},
+			{"item": "deal", "id": 1, "data": {"id": 1, "update_time": "2020-01-01 10:00:00"}}
+		], "additional_data": {"pagination": {"more_items_in_collection": false}}}`)
+	})
+
+	store := &MemoryCursorStore{}
+	store.Save(testReconcileTime("2020-01-01 09:00:00"))
+
+	feed, err := client.ChangeFeed(context.Background(), &ChangeFeedOptions{Cursor: store, PollInterval: time.Hour})
+
+	if err != nil {
+		t.Fatalf("ChangeFeed returned error: %v", err)
+	}
+
+	for _, want := range []int{1, 2} {
+		change, err := feed.Next(context.Background())
+
+		if err != nil {
+			t.Fatalf("Next returned error: %v", err)
+		}
+
+		if change.Meta.ID != want || change.Source != ChangeFromPoll {
+			t.Errorf("Next returned %v from %v, want %v from poll", change.Meta.ID, change.Source, want)
+		}
+
+		if cursor, _ := store.Load(); !cursor.Equal(testReconcileTime("2020-01-01 09:00:00")) {
+			t.Errorf("Cursor saved as %v before the poll was processed", cursor)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := feed.Next(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Next returned %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	if cursor, _ := store.Load(); !cursor.Equal(testReconcileTime("2020-01-01 11:00:00")) {
+		t.Errorf("Cursor saved as %v, want the last change", cursor)
+	}
+}
+
+func TestChangeFeed_Next_pollError(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	failing := true
+
+	mux.HandleFunc("/v1/recents", func(w http.ResponseWriter, r *http.Request) {
+		if failing {
+			writeJSON(w, http.StatusInternalServerError, `{"success": false, "error": "unavailable"}`)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, `{"success": true, "data": [
+			{"item": "deal", "id": 1, "data": {"id": 1, "update_time": "2020-01-01 10:00:00"}}
+		], "additional_data": {"pagination": {"more_items_in_collection": false}}}`)
+	})
+
+	store := &MemoryCursorStore{}
+	since := testReconcileTime("2020-01-01 09:00:00")
+
+	feed, _ := client.ChangeFeed(context.Background(), &ChangeFeedOptions{Cursor: store, Since: since})
+
+	if _, err := feed.Next(context.Background()); err == nil {
+		t.Fatal("Next expected error on a failed poll")
+	}
+
+	if cursor, _ := store.Load(); !cursor.IsZero() {
+		t.Errorf("Cursor saved as %v on a failed poll", cursor)
+	}
+
+	failing = false
+
+	change, err := feed.Next(context.Background())
+
+	if err != nil {
+		t.Fatalf("Next returned error: %v", err)
+	}
+
+	if change.Meta.ID != 1 {
+		t.Errorf("Next returned %v, want the change of the repeated poll", change.Meta.ID)
+	}
+}
+
+func TestChangeFeed_Next_webhooks(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/recents", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"success": true, "data": [
+			{"item": "deal", "id": 1, "data": {"id": 1, "update_time": "2020-01-01 10:00:00"}}
+		], "additional_data": {"pagination": {"more_items_in_collection": false}}}`)
+	})
+
+	handler := NewWebhookHandler()
+	store := &MemoryCursorStore{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	feed, err := client.ChangeFeed(ctx, &ChangeFeedOptions{
+		Webhooks: handler,
+		Objects:  []EventObject{OBJECT_DEAL},
+		Cursor:   store,
+		Since:    testReconcileTime("2020-01-01 09:00:00"),
+	})
+
+	if err != nil {
+		t.Fatalf("ChangeFeed returned error: %v", err)
+	}
+
+	// Keep the feed from polling until the webhooks were returned.
+	feed.nextPoll = time.Now().Add(time.Hour)
+
+	seen := testReconcileTime("2020-01-01 10:00:00").Add(500 * time.Millisecond)
+	handler.Publish(context.Background(), &Event{Meta: EventMeta{Object: OBJECT_PERSON, ID: 7}})
+	handler.Publish(context.Background(), &Event{Meta: EventMeta{Object: OBJECT_DEAL, ID: 1, TimestampMicro: seen.UnixNano() / int64(time.Microsecond)}})
+
+	change, err := feed.Next(context.Background())
+
+	if err != nil {
+		t.Fatalf("Next returned error: %v", err)
+	}
+
+	if change.Meta.ID != 1 || change.Source != ChangeFromWebhook {
+		t.Errorf("Next returned %v from %v, want deal 1 from webhook", change.Meta.ID, change.Source)
+	}
+
+	feed.nextPoll = time.Time{}
+
+	timeout, cancelTimeout := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancelTimeout()
+
+	if change, err := feed.Next(timeout); err != context.DeadlineExceeded {
+		t.Errorf("Next returned %+v, %v, want the seen change skipped", change, err)
+	}
+
+	if cursor, _ := store.Load(); !cursor.Equal(testReconcileTime("2020-01-01 10:00:00")) {
+		t.Errorf("Cursor saved as %v, want the polled change", cursor)
+	}
+}
+
+func TestChangeFeed_Next_concurrent(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	handler := NewWebhookHandler()
+	handler.BufferSize = 1
+
+	feed, _ := client.ChangeFeed(context.Background(), &ChangeFeedOptions{Webhooks: handler})
+	feed.nextPoll = time.Now().Add(time.Hour)
+
+	const published = 20
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < published; i++ {
+		wg.Add(1)
+
+		go func(id int) {
+			defer wg.Done()
+
+			if err := handler.Publish(context.Background(), &Event{Meta: EventMeta{Object: OBJECT_DEAL, ID: id}}); err != nil {
+				t.Errorf("Publish returned error: %v", err)
+			}
+		}(i + 1)
+	}
+
+	var (
+		mu       sync.Mutex
+		received = make(map[int]bool)
+		readers  sync.WaitGroup
+	)
+
+	for i := 0; i < 4; i++ {
+		readers.Add(1)
+
+		go func() {
+			defer readers.Done()
+
+			for j := 0; j < published/4; j++ {
+				ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+				change, err := feed.Next(ctx)
+				cancel()
+
+				if err != nil {
+					t.Errorf("Next returned error: %v", err)
+					return
+				}
+
+				mu.Lock()
+				received[change.Meta.ID] = true
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+	readers.Wait()
+
+	if len(received) != published {
+		t.Errorf("Feed returned %v distinct changes, want %v", len(received), published)
+	}
+}
+
+func TestFileCursorStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pipedrive")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	store := FileCursorStore{Path: filepath.Join(dir, "cursor")}
+
+	if cursor, err := store.Load(); err != nil || !cursor.IsZero() {
+		t.Errorf("Load without file returned %v, %v, want the zero time", cursor, err)
+	}
+
+	want := time.Date(2020, 1, 1, 10, 0, 0, 500, time.UTC)
+
+	if err := store.Save(want); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	if cursor, err := store.Load(); err != nil || !cursor.Equal(want) {
+		t.Errorf("Load returned %v, %v, want %v", cursor, err, want)
+	}
+
+	if _, err := os.Stat(store.Path + ".tmp"); !os.IsNotExist(err) {
+		t.Errorf("Save left the temporary file behind: %v", err)
+	}
+}
@@ -0,0 +1,175 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ChannelsService handles messaging channels related
+// methods of the Pipedrive API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Channels
+type ChannelsService service
+
+// ChannelProviderType represents the messaging provider of a channel.
+type ChannelProviderType string
+
+// ChannelProviderType constants.
+const (
+	ChannelProviderFacebook ChannelProviderType = "facebook"
+	ChannelProviderWhatsApp ChannelProviderType = "whatsapp"
+	ChannelProviderOther    ChannelProviderType = "other"
+)
+
+// ChannelMessageStatus represents the delivery status of a message.
+type ChannelMessageStatus string
+
+// ChannelMessageStatus constants.
+const (
+	ChannelMessageSent      ChannelMessageStatus = "sent"
+	ChannelMessageDelivered ChannelMessageStatus = "delivered"
+	ChannelMessageRead      ChannelMessageStatus = "read"
+	ChannelMessageFailed    ChannelMessageStatus = "failed"
+)
+
+// Channel represents a Pipedrive messaging channel.
+type Channel struct {
+	ID                  string              `json:"id"`
+	Name                string              `json:"name"`
+	AvatarURL           string              `json:"avatar_url"`
+	ProviderChannelID   string              `json:"provider_channel_id"`
+	MarketplaceClientID string              `json:"marketplace_client_id"`
+	PdCompanyID         int                 `json:"pd_company_id"`
+	PdUserID            int                 `json:"pd_user_id"`
+	CreatedAt           string              `json:"created_at"`
+	ProviderType        ChannelProviderType `json:"provider_type"`
+	TemplateSupport     bool                `json:"template_support"`
+}
+
+func (c Channel) String() string {
+	return Stringify(c)
+}
+
+// ChannelMessageAttachment represents a file attached to a channel message.
+type ChannelMessageAttachment struct {
+	ID          string `json:"id"`
+	Type        string `json:"type"`
+	Name        string `json:"name,omitempty"`
+	Size        int    `json:"size,omitempty"`
+	URL         string `json:"url"`
+	PreviewURL  string `json:"preview_url,omitempty"`
+	LinkExpires bool   `json:"link_expires,omitempty"`
+}
+
+// ChannelMessage represents a message received through a channel.
+type ChannelMessage struct {
+	ID               string                     `json:"id"`
+	ChannelID        string                     `json:"channel_id"`
+	SenderID         string                     `json:"sender_id"`
+	ConversationID   string                     `json:"conversation_id"`
+	Message          string                     `json:"message"`
+	Status           ChannelMessageStatus       `json:"status"`
+	CreatedAt        string                     `json:"created_at"`
+	ReplyBy          string                     `json:"reply_by,omitempty"`
+	ConversationLink string                     `json:"conversation_link,omitempty"`
+	Attachments      []ChannelMessageAttachment `json:"attachments,omitempty"`
+}
+
+func (m ChannelMessage) String() string {
+	return Stringify(m)
+}
+
+// ChannelResponse represents single channel response.
+type ChannelResponse struct {
+	Success bool    `json:"success"`
+	Data    Channel `json:"data"`
+}
+
+// ChannelMessageResponse represents single channel message response.
+type ChannelMessageResponse struct {
+	Success bool           `json:"success"`
+	Data    ChannelMessage `json:"data"`
+}
+
+// ChannelCreateOptions specifices the parameters to the
+// ChannelsService.Create method.
+type ChannelCreateOptions struct {
+	Name              string              `json:"name"`
+	ProviderChannelID string              `json:"provider_channel_id"`
+	AvatarURL         string              `json:"avatar_url,omitempty"`
+	TemplateSupport   bool                `json:"template_support,omitempty"`
+	ProviderType      ChannelProviderType `json:"provider_type,omitempty"`
+}
+
+// Create registers a new messaging channel.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Channels/post_channels
+func (s *ChannelsService) Create(ctx context.Context, opt *ChannelCreateOptions) (*ChannelResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "/channels", nil, opt)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *ChannelResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// Delete removes a messaging channel along with its conversations.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Channels/delete_channels_id
+func (s *ChannelsService) Delete(ctx context.Context, id string) (*Response, error) {
+	uri := fmt.Sprintf("/channels/%v", id)
+	req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ReceiveMessage pushes a message received from the provider into the
+// conversation it belongs to.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Channels/post_channels_messages_receive
+func (s *ChannelsService) ReceiveMessage(ctx context.Context, message *ChannelMessage) (*ChannelMessageResponse, *Response, error) {
+	uri := fmt.Sprintf("/channels/%v/messages/receive", message.ChannelID)
+	req, err := s.client.NewRequest(http.MethodPost, uri, nil, message)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *ChannelMessageResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// DeleteConversation removes a conversation from a messaging channel.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Channels/delete_channels_channel_id_conversations_conversation_id
+func (s *ChannelsService) DeleteConversation(ctx context.Context, id string, conversationID string) (*Response, error) {
+	uri := fmt.Sprintf("/channels/%v/conversations/%v", id, conversationID)
+	req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,364 @@
+package pipedrive
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const defaultPoolRate = 20
+
+// ErrPoolStopped is returned for requests that are sent after, or still
+// waiting when, the pool stopped running.
+var ErrPoolStopped = errors.New("client pool stopped")
+
+// ErrTenantRemoved is returned for requests still waiting when their
+// tenant was removed from the pool.
+var ErrTenantRemoved = errors.New("client pool tenant removed")
+
+// ClientPool holds a client per tenant, such as the companies that
+// installed an app, and schedules their requests under a shared rate
+// budget. Tenants with waiting requests take turns, so a tenant with a
+// large sync gets its share of the throughput without starving the
+// others. Requests are only sent while Run is running.
+type ClientPool struct {
+	// New returns the client of a tenant, for example with the tenant's
+	// OAuth token. It is called outside of the lock of the pool, so it
+	// may be slow, and can be called more than once for a tenant when
+	// Get is called concurrently, in which case one client is kept.
+	New func(tenant string) (*Client, error)
+
+	// Rate is the number of requests sent per second by all tenants
+	// together, 20 when zero.
+	Rate float64
+
+	// TenantRate is the number of requests sent per second by a tenant at
+	// most, even when the others are idle. Zero leaves tenants limited by
+	// Rate only.
+	TenantRate float64
+
+	mu      sync.Mutex
+	clients map[string]*Client
+	tenants map[string]*poolTenant
+
+	// turns holds the tenants with waiting requests in the order they
+	// take turns.
+	turns   []string
+	stopped bool
+	ready   chan struct{}
+}
+
+type poolTenant struct {
+	waiting []chan error
+	next    time.Time
+}
+
+// NewClientPool returns a ClientPool creating the clients with newClient.
+func NewClientPool(newClient func(tenant string) (*Client, error)) *ClientPool {
+	return &ClientPool{
+		New:     newClient,
+		clients: make(map[string]*Client),
+		tenants: make(map[string]*poolTenant),
+		ready:   make(chan struct{}, 1),
+	}
+}
+
+// Get returns the client of the tenant, creating it on first use. The
+// requests of the client wait for their turn in the pool.
+func (p *ClientPool) Get(tenant string) (*Client, error) {
+	p.mu.Lock()
+	c, ok := p.clients[tenant]
+	p.mu.Unlock()
+
+	if ok {
+		return c, nil
+	}
+
+	c, err := p.New(tenant)
+
+	if err != nil {
+		return nil, err
+	}
+
+	base := http.DefaultTransport
+	httpClient := &http.Client{}
+
+	if c.client != nil {
+		*httpClient = *c.client
+
+		if c.client.Transport != nil {
+			base = c.client.Transport
+		}
+	}
+
+	// New may return a client it made for an earlier call, such as one
+	// of a removed tenant, whose transport is wrapped already.
+	if t, ok := base.(*poolTransport); ok && t.pool == p {
+		base = t.base
+	}
+
+	httpClient.Transport = &poolTransport{pool: p, tenant: tenant, base: base}
+	c.client = httpClient
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// Another call may have created the client meanwhile.
+	if existing, ok := p.clients[tenant]; ok {
+		return existing, nil
+	}
+
+	p.clients[tenant] = c
+
+	return c, nil
+}
+
+// Remove forgets the client of the tenant, for example when the app was
+// uninstalled. Its waiting requests fail with ErrTenantRemoved.
+func (p *ClientPool) Remove(tenant string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.clients, tenant)
+
+	t, ok := p.tenants[tenant]
+
+	if !ok {
+		return
+	}
+
+	for _, done := range t.waiting {
+		done <- ErrTenantRemoved
+	}
+
+	delete(p.tenants, tenant)
+	p.removeTurn(tenant)
+}
+
+// Waiting returns the number of requests of the tenant waiting for their
+// turn.
+func (p *ClientPool) Waiting(tenant string) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if t, ok := p.tenants[tenant]; ok {
+		return len(t.waiting)
+	}
+
+	return 0
+}
+
+// Run sends the waiting requests until ctx is done, then fails the
+// requests still waiting with ErrPoolStopped and returns ctx.Err().
+// Requests sent until Run is called again fail with ErrPoolStopped too.
+func (p *ClientPool) Run(ctx context.Context) error {
+	p.mu.Lock()
+	p.stopped = false
+	p.mu.Unlock()
+
+	rate := p.Rate
+
+	if rate <= 0 {
+		rate = defaultPoolRate
+	}
+
+	err := p.dispatch(ctx, time.Duration(float64(time.Second)/rate))
+
+	p.mu.Lock()
+	p.stopped = true
+
+	for _, t := range p.tenants {
+		for _, done := range t.waiting {
+			done <- ErrPoolStopped
+		}
+	}
+
+	p.tenants = make(map[string]*poolTenant)
+	p.turns = nil
+	p.mu.Unlock()
+
+	return err
+}
+
+// dispatch lets one request go at most once per interval, taking the
+// tenants in turns and skipping tenants over their own rate.
+func (p *ClientPool) dispatch(ctx context.Context, interval time.Duration) error {
+	var next time.Time
+
+	for {
+		now := time.Now()
+		wait := next.Sub(now)
+
+		p.mu.Lock()
+
+		if wait <= 0 {
+			var granted bool
+
+			if granted, wait = p.grant(now); granted {
+				next = now.Add(interval)
+				p.mu.Unlock()
+				continue
+			}
+		}
+
+		p.mu.Unlock()
+
+		if wait > 0 {
+			timer := time.NewTimer(wait)
+
+			select {
+			case <-timer.C:
+			case <-p.ready:
+				timer.Stop()
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			}
+
+			continue
+		}
+
+		select {
+		case <-p.ready:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// grant lets the first request of the next tenant in turn go. Otherwise it
+// returns how long until a tenant over its rate may go again, zero when no
+// request is waiting.
+func (p *ClientPool) grant(now time.Time) (bool, time.Duration) {
+	var wait time.Duration
+
+	for i, tenant := range p.turns {
+		t := p.tenants[tenant]
+
+		if until := t.next.Sub(now); until > 0 {
+			if wait == 0 || until < wait {
+				wait = until
+			}
+
+			continue
+		}
+
+		t.waiting[0] <- nil
+		t.waiting = t.waiting[1:]
+
+		if p.TenantRate > 0 {
+			t.next = now.Add(time.Duration(float64(time.Second) / p.TenantRate))
+		}
+
+		// The tenant moves to the end of the turns, or leaves them
+		// when it has no more waiting requests.
+		p.turns = append(p.turns[:i:i], p.turns[i+1:]...)
+
+		if len(t.waiting) > 0 {
+			p.turns = append(p.turns, tenant)
+		} else if t.next.Before(now) {
+			delete(p.tenants, tenant)
+		}
+
+		return true, 0
+	}
+
+	return false, wait
+}
+
+// acquire waits until the pool lets a request of the tenant go.
+func (p *ClientPool) acquire(ctx context.Context, tenant string) error {
+	done := make(chan error, 1)
+
+	p.mu.Lock()
+
+	if p.stopped {
+		p.mu.Unlock()
+		return ErrPoolStopped
+	}
+
+	t, ok := p.tenants[tenant]
+
+	if !ok {
+		t = &poolTenant{}
+		p.tenants[tenant] = t
+	}
+
+	if len(t.waiting) == 0 {
+		p.turns = append(p.turns, tenant)
+	}
+
+	t.waiting = append(t.waiting, done)
+	p.mu.Unlock()
+
+	select {
+	case p.ready <- struct{}{}:
+	default:
+	}
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		p.cancel(tenant, done)
+		return ctx.Err()
+	}
+}
+
+// cancel removes a request that stopped waiting.
+func (p *ClientPool) cancel(tenant string, done chan error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	t, ok := p.tenants[tenant]
+
+	if !ok {
+		return
+	}
+
+	for i, waiting := range t.waiting {
+		if waiting != done {
+			continue
+		}
+
+		t.waiting = append(t.waiting[:i:i], t.waiting[i+1:]...)
+
+		if len(t.waiting) == 0 {
+			p.removeTurn(tenant)
+		}
+
+		return
+	}
+}
+
+func (p *ClientPool) removeTurn(tenant string) {
+	for i, turn := range p.turns {
+		if turn == tenant {
+			p.turns = append(p.turns[:i:i], p.turns[i+1:]...)
+			return
+		}
+	}
+}
+
+// poolTransport sends the requests of a tenant when it is their turn.
+type poolTransport struct {
+	pool   *ClientPool
+	tenant string
+	base   http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper. Like other round trippers, it
+// closes the request body when the request is not sent.
+func (t *poolTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.pool.acquire(req.Context(), t.tenant); err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+
+		return nil, err
+	}
+
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,285 @@
+package pipedrive
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// setupPool returns a pool of clients sending their requests to mux, with
+// the tenant as API token.
+func setupPool() (pool *ClientPool, mux *http.ServeMux, teardown func()) {
+	client, mux, teardown := setup()
+
+	pool = NewClientPool(func(tenant string) (*Client, error) {
+		c := NewClient(&Config{APIKey: tenant})
+		c.BaseURL = client.BaseURL
+		c.client = client.client
+
+		return c, nil
+	})
+
+	return pool, mux, teardown
+}
+
+// waitFor fails t when cond does not hold within a second.
+func waitFor(t *testing.T, cond func() bool) {
+	deadline := time.Now().Add(time.Second)
+
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met within a second")
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// sendPoolRequests sends n requests of the tenant in the background and
+// returns their errors once they are done.
+func sendPoolRequests(t *testing.T, pool *ClientPool, tenant string, n int) func() []error {
+	client, err := pool.Get(tenant)
+
+	if err != nil {
+		t.Fatalf("ClientPool.Get returned error: %v", err)
+	}
+
+	var wg sync.WaitGroup
+
+	errs := make([]error, n)
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			_, _, errs[i] = client.Recents.List(context.Background(), &RecentsListOptions{SinceTimestamp: "2020-01-01 00:00:00"})
+		}(i)
+	}
+
+	return func() []error {
+		wg.Wait()
+		return errs
+	}
+}
+
+func TestClientPool_Run_tenantsTakeTurns(t *testing.T) {
+	pool, mux, teardown := setupPool()
+	defer teardown()
+
+	var (
+		mu      sync.Mutex
+		tenants []string
+	)
+
+	mux.HandleFunc("/v1/recents", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		tenants = append(tenants, r.Header.Get("x-api-token"))
+		mu.Unlock()
+
+		writeJSON(w, http.StatusOK, `{"success": true, "data": []}`)
+	})
+
+	pool.Rate = 100
+
+	// The large sync of a waits before the requests of b.
+	waitA := sendPoolRequests(t, pool, "a", 6)
+	waitFor(t, func() bool { return pool.Waiting("a") == 6 })
+
+	waitB := sendPoolRequests(t, pool, "b", 2)
+	waitFor(t, func() bool { return pool.Waiting("b") == 2 })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go pool.Run(ctx)
+
+	for _, errs := range [][]error{waitA(), waitB()} {
+		for _, err := range errs {
+			if err != nil {
+				t.Fatalf("Request returned error: %v", err)
+			}
+		}
+	}
+
+	// Every request is granted before the next is, so the server sees
+	// them in the order of the turns.
+	want := []string{"a", "b", "a", "b", "a", "a", "a", "a"}
+
+	if len(tenants) != len(want) {
+		t.Fatalf("Pool sent the requests of %v, want %v", tenants, want)
+	}
+
+	for i := range want {
+		if tenants[i] != want[i] {
+			t.Errorf("Pool sent the requests of %v, want %v", tenants, want)
+			break
+		}
+	}
+}
+
+func TestClientPool_Remove_failsWaitingRequests(t *testing.T) {
+	pool, _, teardown := setupPool()
+	defer teardown()
+
+	wait := sendPoolRequests(t, pool, "a", 2)
+	waitFor(t, func() bool { return pool.Waiting("a") == 2 })
+
+	pool.Remove("a")
+
+	for _, err := range wait() {
+		if !isURLError(err, ErrTenantRemoved) {
+			t.Errorf("Request of a removed tenant returned %v, want %v", err, ErrTenantRemoved)
+		}
+	}
+
+	if n := pool.Waiting("a"); n != 0 {
+		t.Errorf("Waiting returned %v after Remove, want 0", n)
+	}
+}
+
+func TestClientPool_Run_again(t *testing.T) {
+	pool, mux, teardown := setupPool()
+	defer teardown()
+
+	mux.HandleFunc("/v1/recents", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"success": true, "data": []}`)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+
+	go func() {
+		pool.Run(ctx)
+		close(stopped)
+	}()
+
+	cancel()
+	<-stopped
+
+	for _, err := range sendPoolRequests(t, pool, "a", 1)() {
+		if !isURLError(err, ErrPoolStopped) {
+			t.Errorf("Request of a stopped pool returned %v, want %v", err, ErrPoolStopped)
+		}
+	}
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+
+	go pool.Run(ctx)
+
+	// Requests sent before Run resets the pool still fail.
+	waitFor(t, func() bool {
+		return sendPoolRequests(t, pool, "a", 1)()[0] == nil
+	})
+}
+
+func TestClientPool_Get_newOutsideOfLock(t *testing.T) {
+	pool, _, teardown := setupPool()
+	defer teardown()
+
+	newClient := pool.New
+	pool.New = func(tenant string) (*Client, error) {
+		// The pool is not locked while the client is created.
+		pool.Waiting(tenant)
+
+		return newClient(tenant)
+	}
+
+	done := make(chan struct{})
+
+	go func() {
+		pool.Get("a")
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("ClientPool.Get did not return, New was called with the pool locked")
+	}
+
+	first, _ := pool.Get("a")
+	second, _ := pool.Get("a")
+
+	if first != second {
+		t.Error("ClientPool.Get returned different clients for a tenant")
+	}
+}
+
+func TestClientPool_Get_wrapsTransportOnce(t *testing.T) {
+	pool, _, teardown := setupPool()
+	defer teardown()
+
+	newClient := pool.New
+	clients := make(map[string]*Client)
+
+	// New returns the clients it made before, as a cache would.
+	pool.New = func(tenant string) (*Client, error) {
+		if c, ok := clients[tenant]; ok {
+			return c, nil
+		}
+
+		c, err := newClient(tenant)
+		clients[tenant] = c
+
+		return c, err
+	}
+
+	pool.Get("a")
+	pool.Remove("a")
+	c, _ := pool.Get("a")
+
+	transport := c.client.Transport.(*poolTransport)
+
+	if _, ok := transport.base.(*poolTransport); ok {
+		t.Error("ClientPool.Get wrapped the transport of the client twice")
+	}
+}
+
+// closeRecorder is a request body recording whether it was closed.
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (r *closeRecorder) Close() error {
+	r.closed = true
+	return nil
+}
+
+func TestPoolTransport_RoundTrip_closesBody(t *testing.T) {
+	pool, _, teardown := setupPool()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	pool.Run(ctx)
+
+	body := &closeRecorder{Reader: strings.NewReader("{}")}
+	req, _ := http.NewRequest(http.MethodPost, "https://example.com/v1/deals", body)
+	transport := &poolTransport{pool: pool, tenant: "a", base: http.DefaultTransport}
+
+	if _, err := transport.RoundTrip(req); err != ErrPoolStopped {
+		t.Fatalf("RoundTrip returned %v, want %v", err, ErrPoolStopped)
+	}
+
+	if !body.closed {
+		t.Error("RoundTrip did not close the body of the request it did not send")
+	}
+}
+
+// isURLError reports whether err is want, as returned by http.Client.
+func isURLError(err, want error) bool {
+	if urlErr, ok := err.(*url.Error); ok {
+		err = urlErr.Err
+	}
+
+	return err == want
+}
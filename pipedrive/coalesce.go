@@ -0,0 +1,118 @@
+package pipedrive
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+// flightGroup tracks the GET calls in flight, so identical requests can
+// share them.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall
+}
+
+type flightCall struct {
+	done     chan struct{}
+	response *Response
+	data     []byte
+	err      error
+
+	// canceled is set when the call failed because the ctx of the request
+	// that sent it was done.
+	canceled bool
+}
+
+// doCoalesced sends a GET request or waits for an identical one in flight,
+// then decodes the shared body into v. When the call waited for is
+// canceled, the request is sent again.
+func (c *Client) doCoalesced(ctx context.Context, request *http.Request, v interface{}) (*Response, error) {
+	key := request.URL.String() + "\n" + request.Header.Get(headerAPIToken) + "\n" + request.Header.Get("Authorization")
+
+	call := c.flights.join(ctx, key, func() (*Response, []byte, error) {
+		return c.sendAndRead(ctx, request)
+	})
+
+	if call == nil {
+		return nil, ctx.Err()
+	}
+
+	if call.err != nil {
+		return call.response, call.err
+	}
+
+	response := call.response.withBody(call.data)
+
+	return response, c.decode(response.Body, v)
+}
+
+// join returns the call in flight for key, or makes one with send when
+// there is none. It returns nil when ctx is done first.
+func (g *flightGroup) join(ctx context.Context, key string, send func() (*Response, []byte, error)) *flightCall {
+	for {
+		g.mu.Lock()
+
+		if g.calls == nil {
+			g.calls = make(map[string]*flightCall)
+		}
+
+		call, ok := g.calls[key]
+
+		if !ok {
+			call = &flightCall{done: make(chan struct{})}
+			g.calls[key] = call
+		}
+
+		g.mu.Unlock()
+
+		if !ok {
+			call.response, call.data, call.err = send()
+			call.canceled = call.err != nil && ctx.Err() != nil
+
+			g.mu.Lock()
+			delete(g.calls, key)
+			g.mu.Unlock()
+
+			close(call.done)
+
+			return call
+		}
+
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return nil
+		}
+
+		if !call.canceled {
+			return call
+		}
+	}
+}
+
+func (c *Client) sendAndRead(ctx context.Context, request *http.Request) (*Response, []byte, error) {
+	response, err := c.send(ctx, request)
+
+	if err != nil {
+		return response, nil, err
+	}
+
+	defer response.Body.Close()
+
+	data, err := ioutil.ReadAll(response.Body)
+
+	return response, data, err
+}
+
+// withBody returns a copy of the response with the body replaced.
+func (r *Response) withBody(data []byte) *Response {
+	response := *r
+	httpResponse := *r.Response
+	httpResponse.Body = ioutil.NopCloser(bytes.NewReader(data))
+	response.Response = &httpResponse
+
+	return &response
+}
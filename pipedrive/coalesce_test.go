@@ -0,0 +1,198 @@
+package pipedrive
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+// coalesceTimeout is how long the tests give waiting requests to join the
+// call in flight.
+const coalesceTimeout = 50 * time.Millisecond
+
+func TestClient_Do_coalescesGETs(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	client.CoalesceGETs = true
+
+	var (
+		mu       sync.Mutex
+		requests int
+	)
+
+	received := make(chan struct{}, 1)
+	release := make(chan struct{})
+
+	mux.HandleFunc("/v1/deals", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+
+		received <- struct{}{}
+		<-release
+
+		writeJSON(w, http.StatusOK, `{"success": true, "data": [{"id": 1}]}`)
+	})
+
+	var wg sync.WaitGroup
+
+	deals := make([]*DealsResponse, 3)
+	errs := make([]error, 3)
+
+	for i := range deals {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			deals[i], _, errs[i] = client.Deals.List(context.Background(), nil)
+		}(i)
+
+		if i == 0 {
+			<-received
+		}
+	}
+
+	time.Sleep(coalesceTimeout)
+	close(release)
+	wg.Wait()
+
+	if requests != 1 {
+		t.Errorf("Deals.List sent %v requests, want 1", requests)
+	}
+
+	for i := range deals {
+		if errs[i] != nil || len(deals[i].Data) != 1 {
+			t.Errorf("Deals.List %v returned %+v, %v", i, deals[i], errs[i])
+		}
+	}
+}
+
+func TestClient_Do_coalescedLeaderCanceled(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	client.CoalesceGETs = true
+
+	var (
+		mu       sync.Mutex
+		requests int
+	)
+
+	received := make(chan struct{}, 2)
+
+	mux.HandleFunc("/v1/deals", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		first := requests == 1
+		mu.Unlock()
+
+		received <- struct{}{}
+
+		// The first request is held until its sender gives up.
+		if first {
+			<-r.Context().Done()
+			return
+		}
+
+		writeJSON(w, http.StatusOK, `{"success": true, "data": [{"id": 1}]}`)
+	})
+
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+
+	go func() {
+		_, _, err := client.Deals.List(leaderCtx, nil)
+		leaderErr <- err
+	}()
+
+	<-received
+
+	var (
+		deals *DealsResponse
+		err   error
+	)
+
+	followerDone := make(chan struct{})
+
+	go func() {
+		deals, _, err = client.Deals.List(context.Background(), nil)
+		close(followerDone)
+	}()
+
+	time.Sleep(coalesceTimeout)
+	cancel()
+
+	if err := <-leaderErr; err != context.Canceled {
+		t.Errorf("Deals.List of the canceled request returned %v, want %v", err, context.Canceled)
+	}
+
+	select {
+	case <-followerDone:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Deals.List of the waiting request did not return")
+	}
+
+	if err != nil || len(deals.Data) != 1 {
+		t.Errorf("Deals.List of the waiting request returned %+v, %v, want the deals", deals, err)
+	}
+
+	if requests != 2 {
+		t.Errorf("Deals.List sent %v requests, want 2", requests)
+	}
+}
+
+func TestClient_Do_downloadsNotCoalesced(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	client.CoalesceGETs = true
+
+	received := make(chan struct{}, 2)
+	release := make(chan struct{})
+
+	mux.HandleFunc("/v1/files/1/download", func(w http.ResponseWriter, r *http.Request) {
+		received <- struct{}{}
+		<-release
+
+		w.Write([]byte("content"))
+	})
+
+	var wg sync.WaitGroup
+
+	contents := make([]bytes.Buffer, 2)
+	errs := make([]error, 2)
+
+	for i := range contents {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			_, errs[i] = client.Files.Download(context.Background(), 1, &contents[i])
+		}(i)
+	}
+
+	// Both downloads reach the server, none waits for the other.
+	for i := 0; i < 2; i++ {
+		select {
+		case <-received:
+		case <-time.After(5 * time.Second):
+			close(release)
+			t.Fatalf("Files.Download sent %v requests, want 2", i)
+		}
+	}
+
+	close(release)
+	wg.Wait()
+
+	for i := range contents {
+		if errs[i] != nil || contents[i].String() != "content" {
+			t.Errorf("Files.Download %v wrote %q, %v", i, contents[i].String(), errs[i])
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package pipedrive
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Codec holds the functions the client encodes request bodies and decodes
+// response bodies with, for example to use a faster JSON implementation.
+// Unset functions default to those of encoding/json.
+//
+// Responses are decoded with Unmarshal, including the models in their data
+// such as deals and persons. Models decoded outside of the client, such as
+// the ones in webhook events, are decoded with encoding/json.
+type Codec struct {
+	Marshal   func(v interface{}) ([]byte, error)
+	Unmarshal func(data []byte, v interface{}) error
+}
+
+func (c Codec) marshal(v interface{}) ([]byte, error) {
+	if c.Marshal != nil {
+		return c.Marshal(v)
+	}
+
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (c Codec) unmarshal(data []byte, v interface{}) error {
+	if c.Unmarshal != nil {
+		return c.Unmarshal(data, v)
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// UnmarshalUseNumber decodes like json.Unmarshal, except that numbers in
+// interface{} values are decoded as json.Number instead of float64, which
+// keeps large IDs exact. It can be used as Codec.Unmarshal.
+func UnmarshalUseNumber(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	return dec.Decode(v)
+}
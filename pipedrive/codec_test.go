@@ -0,0 +1,57 @@
+package pipedrive
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestCodec_UnmarshalUseNumber_deal(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	client.Codec.Unmarshal = UnmarshalUseNumber
+
+	// 2^53 + 1 is rounded to 2^53 when decoded into a float64.
+	mux.HandleFunc("/v1/deals/1/duplicate", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		writeJSON(w, http.StatusOK, `{"success": true, "data": {"id": 2, "next_activity_id": 9007199254740993}}`)
+	})
+
+	deal, _, err := client.Deals.Duplicate(context.Background(), 1)
+
+	if err != nil {
+		t.Fatalf("Deals.Duplicate returned error: %v", err)
+	}
+
+	if want := json.Number("9007199254740993"); deal.Data.NextActivityID != want {
+		t.Errorf("Deals.Duplicate returned NextActivityID %#v, want %#v", deal.Data.NextActivityID, want)
+	}
+}
+
+func TestCodec_UnmarshalUseNumber_deals(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	client.Codec.Unmarshal = UnmarshalUseNumber
+
+	mux.HandleFunc("/v1/deals", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		writeJSON(w, http.StatusOK, `{"success": true, "data": [{"id": 1, "next_activity_id": 9007199254740993}, {"id": 2}]}`)
+	})
+
+	deals, _, err := client.Deals.List(context.Background(), nil)
+
+	if err != nil {
+		t.Fatalf("Deals.List returned error: %v", err)
+	}
+
+	if len(deals.Data) != 2 {
+		t.Fatalf("Deals.List returned %v deals, want 2", len(deals.Data))
+	}
+
+	if want := json.Number("9007199254740993"); deals.Data[0].NextActivityID != want {
+		t.Errorf("Deals.List returned NextActivityID %#v, want %#v", deals.Data[0].NextActivityID, want)
+	}
+}
@@ -1,17 +1,33 @@
 package pipedrive
 
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
 const (
 	VisibleToOwnersAndFollowers = 1
 	VisibleToWholeCompany       = 3
 )
 
+// listAllPageLimit is the page size of the ListAll methods, the largest
+// the API allows.
+const listAllPageLimit = 500
+
+// Pagination represents the pagination of a list response. Offset paged
+// lists set NextStart, cursor paged lists set NextCursor.
 type Pagination struct {
-	Start                 int  `json:"start"`
-	Limit                 int  `json:"limit"`
-	MoreItemsInCollection bool `json:"more_items_in_collection"`
-	NextStart             int  `json:"next_start,omitempty"`
+	Start                 int    `json:"start"`
+	Limit                 int    `json:"limit"`
+	MoreItemsInCollection bool   `json:"more_items_in_collection"`
+	NextStart             int    `json:"next_start,omitempty"`
+	NextCursor            string `json:"next_cursor,omitempty"`
 }
 
+// AdditionalData represents the additional data of a response. Which
+// fields are set depends on the endpoint.
 type AdditionalData struct {
 	User struct {
 		Profile struct {
@@ -40,10 +56,14 @@ type AdditionalData struct {
 	LastTimestampOnPage string     `json:"last_timestamp_on_page"`
 	Pagination          Pagination `json:"pagination"`
 	NextCursor          string     `json:"next_cursor"`
+
+	// RelatedObjects holds the related_objects of the response, which
+	// Pipedrive sends next to the additional data.
+	RelatedObjects RelatedObjects `json:"-"`
 }
 
 type DeleteMultipleOptions struct {
-	Ids string `url:"ids,omitempty"`
+	Ids []int `url:"ids,omitempty,comma"`
 }
 
 type ErrorFields struct {
@@ -51,34 +71,6 @@ type ErrorFields struct {
 	ErrorInfo string `json:"error_info"`
 }
 
-// Type of actions.
-type EventAction string
-
-const (
-	ACTION_ADDED   EventAction = "added"
-	ACTION_UPDATED EventAction = "updated"
-	ACTION_MERGED  EventAction = "merged"
-	ACTION_DELETED EventAction = "deleted"
-	ACTION_ALL     EventAction = "all"
-)
-
-// Type of objects.
-type EventObject string
-
-const (
-	OBJECT_ACTIVITY      EventObject = "activity"
-	OBJECT_ACTIVTIY_TYPE EventObject = "activity_type"
-	OBJECT_DEAL          EventObject = "deal"
-	OBJECT_NOTE          EventObject = "note"
-	OBJECT_ORGANIZATION  EventObject = "organization"
-	OBJECT_PERSON        EventObject = "person"
-	OBJECT_PIPELINE      EventObject = "pipeline"
-	OBJECT_PRODUCT       EventObject = "product"
-	OBJECT_STAGE         EventObject = "stage"
-	OBJECT_USER          EventObject = "user"
-	OBJECT_ALL_          EventObject = "*"
-)
-
 // Active flags
 type ActiveFlag uint8
 
@@ -106,16 +98,52 @@ const (
 	FieldTypeTime        FieldType = "time"
 	FieldTypeTimerange   FieldType = "timerange"
 	FieldTypeDaterange   FieldType = "daterange"
+	FieldTypeInt         FieldType = "int"
+	FieldTypeAddress     FieldType = "address"
+	FieldTypeVisibleTo   FieldType = "visible_to"
+	FieldTypeStatus      FieldType = "status"
+	FieldTypeStage       FieldType = "stage"
+	FieldTypePicture     FieldType = "picture"
 )
 
+// HasOptions reports whether values of the field type are option IDs.
+func (t FieldType) HasOptions() bool {
+	return t == FieldTypeEnum || t == FieldTypeSet
+}
+
 // Visiblity
 type VisibleTo uint8
 
 const (
 	VisibleToOwnersFollowers VisibleTo = 1
 	VisibleToEntireCompany   VisibleTo = 3
+
+	// Visibility groups are only available on some plans.
+	VisibleToOwnersVisibilityGroup             VisibleTo = 5
+	VisibleToOwnersVisibilityGroupAndSubgroups VisibleTo = 7
 )
 
+// UnmarshalJSON decodes visibility given either as a number or, as most
+// endpoints return it, as a string.
+func (v *VisibleTo) UnmarshalJSON(data []byte) error {
+	value := strings.Trim(string(data), `"`)
+
+	if value == "" || value == "null" {
+		*v = 0
+		return nil
+	}
+
+	number, err := strconv.ParseUint(value, 10, 8)
+
+	if err != nil {
+		return fmt.Errorf("invalid visible_to value %s", data)
+	}
+
+	*v = VisibleTo(number)
+
+	return nil
+}
+
 // Deal probability
 type DealProbability uint8
 
@@ -129,6 +157,59 @@ type SearchOptions struct {
 	Term string `url:"term,omitempty"`
 }
 
+// SortDirection represents the order values of a field are sorted in.
+type SortDirection string
+
+// SortDirection constants.
+const (
+	SortAscending  SortDirection = "ASC"
+	SortDescending SortDirection = "DESC"
+)
+
+// SortKey represents a field and the direction it is sorted in.
+type SortKey struct {
+	Field     string
+	Direction SortDirection
+}
+
+// Sort represents the sort order of a list, earlier keys take precedence.
+// It is encoded in the sort query parameter as "add_time DESC, title ASC".
+type Sort []SortKey
+
+// SortBy returns a sort order on a single field, chain Then to add more.
+func SortBy(field string, direction SortDirection) Sort {
+	return Sort{{Field: field, Direction: direction}}
+}
+
+// Then returns the sort order with another field appended.
+func (s Sort) Then(field string, direction SortDirection) Sort {
+	return append(s[:len(s):len(s)], SortKey{Field: field, Direction: direction})
+}
+
+func (s Sort) String() string {
+	keys := make([]string, len(s))
+
+	for i, key := range s {
+		keys[i] = strings.TrimSpace(key.Field + " " + string(key.Direction))
+	}
+
+	return strings.Join(keys, ", ")
+}
+
+// EncodeValues implements query.Encoder, empty sort orders are omitted.
+func (s Sort) EncodeValues(key string, v *url.Values) error {
+	if len(s) > 0 {
+		v.Set(key, s.String())
+	}
+
+	return nil
+}
+
+// Fields represents a selection of field keys for the fields and
+// include_fields query parameters, tagged comma to be encoded as a comma
+// separated list like the other lists of options.
+type Fields []string
+
 type Summary struct {
 	Success bool  `json:"success"`
 	Data    Total `json:"data"`
@@ -0,0 +1,54 @@
+package pipedrive
+
+import "errors"
+
+// ConferenceMeeting represents the video call of an activity, added by a
+// video calling app of the Marketplace.
+type ConferenceMeeting struct {
+	// Client is the client ID of the Marketplace app hosting the call.
+	Client string `json:"conference_meeting_client"`
+
+	// URL is the link participants join the call with.
+	URL string `json:"conference_meeting_url"`
+
+	// MeetingID is the ID of the call in the app.
+	MeetingID string `json:"conference_meeting_id"`
+}
+
+// Validate checks that the client and the URL are set, Pipedrive shows the
+// call only with both.
+func (m ConferenceMeeting) Validate() error {
+	if m.Client == "" {
+		return errors.New("the conference meeting client must not be empty")
+	}
+
+	if m.URL == "" {
+		return errors.New("the conference meeting URL must not be empty")
+	}
+
+	return nil
+}
+
+// SetConferenceMeeting sets the fields of the video call.
+func (o *ActivitiesCreateOptions) SetConferenceMeeting(m ConferenceMeeting) {
+	o.ConferenceMeetingClient = m.Client
+	o.ConferenceMeetingURL = m.URL
+	o.ConferenceMeetingID = m.MeetingID
+}
+
+// SetConferenceMeeting sets the fields of the video call, replacing the
+// one the activity had.
+func (o *ActivitiesUpdateOptions) SetConferenceMeeting(m ConferenceMeeting) {
+	o.ConferenceMeetingClient = String(m.Client)
+	o.ConferenceMeetingURL = String(m.URL)
+	o.ConferenceMeetingID = String(m.MeetingID)
+}
+
+// RemoveConferenceMeeting clears the fields of the video call, for example
+// when the call was deleted in the app.
+func (o *ActivitiesUpdateOptions) RemoveConferenceMeeting() {
+	o.ConferenceMeetingClient = nil
+	o.ConferenceMeetingURL = nil
+	o.ConferenceMeetingID = nil
+	o.Null = append(o.Null, "conference_meeting_client", "conference_meeting_url", "conference_meeting_id")
+}
@@ -0,0 +1,107 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// CurrencyConverter converts monetary values between currencies using
+// exchange rates relative to a base currency. The Pipedrive API does not
+// expose the exchange rates of an account, so they have to be provided.
+type CurrencyConverter struct {
+	base     string
+	rates    map[string]float64
+	decimals map[string]int
+}
+
+// NewCurrencyConverter returns a converter for the given rates. Each rate is
+// the amount of the currency that one unit of the base currency buys, the
+// rate of the base currency itself is always 1.
+func NewCurrencyConverter(base string, rates map[string]float64) *CurrencyConverter {
+	c := &CurrencyConverter{
+		base:     strings.ToUpper(base),
+		rates:    make(map[string]float64, len(rates)+1),
+		decimals: make(map[string]int),
+	}
+
+	for code, rate := range rates {
+		c.rates[strings.ToUpper(code)] = rate
+	}
+
+	c.rates[c.base] = 1
+
+	return c
+}
+
+// NewConverter returns a converter for the given rates which rounds
+// converted values to the decimal points of the currencies of the account.
+// Rates of currencies the account does not support are rejected.
+func (s *CurrenciesService) NewConverter(ctx context.Context, base string, rates map[string]float64) (*CurrencyConverter, *Response, error) {
+	currencies, resp, err := s.List(ctx, nil)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	c := NewCurrencyConverter(base, rates)
+
+	for _, currency := range currencies.Data {
+		c.decimals[strings.ToUpper(currency.Code)] = currency.DecimalPoints
+	}
+
+	for code := range c.rates {
+		if _, ok := c.decimals[code]; !ok {
+			return nil, resp, fmt.Errorf("currency %v is not supported by the account", code)
+		}
+	}
+
+	return c, resp, nil
+}
+
+// Base returns the base currency of the rates.
+func (c *CurrencyConverter) Base() string {
+	return c.base
+}
+
+// Convert converts amount from one currency to another.
+func (c *CurrencyConverter) Convert(amount float64, from, to string) (float64, error) {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+
+	fromRate, err := c.rate(from)
+
+	if err != nil {
+		return 0, err
+	}
+
+	toRate, err := c.rate(to)
+
+	if err != nil {
+		return 0, err
+	}
+
+	value := amount / fromRate * toRate
+
+	if decimals, ok := c.decimals[to]; ok {
+		scale := math.Pow(10, float64(decimals))
+		value = math.Floor(value*scale+0.5) / scale
+	}
+
+	return value, nil
+}
+
+func (c *CurrencyConverter) rate(code string) (float64, error) {
+	rate, ok := c.rates[code]
+
+	if !ok || rate <= 0 {
+		return 0, fmt.Errorf("no exchange rate for currency %q", code)
+	}
+
+	return rate, nil
+}
+
+// ValueIn returns the value of the deal converted to currency.
+func (d Deal) ValueIn(c *CurrencyConverter, currency string) (float64, error) {
+	return c.Convert(d.Value, d.Currency, currency)
+}
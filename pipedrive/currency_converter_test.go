@@ -0,0 +1,63 @@
+package pipedrive
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestCurrencyConverter_Convert(t *testing.T) {
+	c := NewCurrencyConverter("eur", map[string]float64{"USD": 1.25, "jpy": 130, "XXX": 0})
+	c.decimals = map[string]int{"EUR": 2, "USD": 2, "JPY": 0}
+
+	tests := []struct {
+		amount   float64
+		from, to string
+		want     float64
+		wantErr  bool
+	}{
+		{amount: 100, from: "EUR", to: "USD", want: 125},
+		{amount: 125, from: "usd", to: "eur", want: 100},
+		{amount: 10, from: "USD", to: "JPY", want: 1040},
+		{amount: 1, from: "JPY", to: "USD", want: 0.01},
+		{amount: 1.006, from: "EUR", to: "EUR", want: 1.01},
+		{amount: 1, from: "EUR", to: "GBP", wantErr: true},
+		{amount: 1, from: "XXX", to: "EUR", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := c.Convert(tt.amount, tt.from, tt.to)
+
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Convert(%v, %v, %v) returned error %v, want error %v", tt.amount, tt.from, tt.to, err, tt.wantErr)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf("Convert(%v, %v, %v) returned %v, want %v", tt.amount, tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestCurrenciesService_NewConverter(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/currencies", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"success": true, "data": [{"code": "EUR", "decimal_points": 2}, {"code": "JPY", "decimal_points": 0}]}`)
+	})
+
+	c, _, err := client.Currencies.NewConverter(context.Background(), "EUR", map[string]float64{"JPY": 130.4})
+
+	if err != nil {
+		t.Fatalf("NewConverter returned error: %v", err)
+	}
+
+	if got, _ := c.Convert(1, "EUR", "JPY"); got != 130 {
+		t.Errorf("Convert returned %v, want it rounded to 130", got)
+	}
+
+	if _, _, err := client.Currencies.NewConverter(context.Background(), "EUR", map[string]float64{"USD": 1.25}); err == nil {
+		t.Error("NewConverter returned no error for a currency the account does not support")
+	}
+}
@@ -0,0 +1,208 @@
+package pipedrive
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// customFieldKeyLength is the length of the hash Pipedrive uses as the key
+// of custom fields. Subfields, such as the currency of a monetary field,
+// append a suffix to it.
+const customFieldKeyLength = 40
+
+// CustomFields holds the raw values of custom fields keyed by field key.
+// Field keys can be looked up with the fields services, for example
+// DealFieldsService.List.
+type CustomFields map[string]json.RawMessage
+
+// Money represents the value of a monetary custom field.
+type Money struct {
+	Value    float64
+	Currency string
+}
+
+// String returns the value of a text custom field.
+func (c CustomFields) String(key string) (string, bool) {
+	var value string
+
+	if !c.decode(key, &value) {
+		return "", false
+	}
+
+	return value, true
+}
+
+// Int returns the value of a numeric custom field.
+func (c CustomFields) Int(key string) (int, bool) {
+	var value json.Number
+
+	if !c.decode(key, &value) {
+		return 0, false
+	}
+
+	number, err := strconv.ParseFloat(value.String(), 64)
+
+	if err != nil {
+		return 0, false
+	}
+
+	return int(number), true
+}
+
+// Money returns the value and currency of a monetary custom field.
+func (c CustomFields) Money(key string) (Money, bool) {
+	var money Money
+
+	if !c.decode(key, &money.Value) {
+		return Money{}, false
+	}
+
+	c.decode(key+"_currency", &money.Currency)
+
+	return money, true
+}
+
+// Enum returns the selected option ID of a single option custom field.
+func (c CustomFields) Enum(key string) (int, bool) {
+	return c.Int(key)
+}
+
+// Date returns the value of a date custom field.
+func (c CustomFields) Date(key string) (time.Time, bool) {
+	value, ok := c.String(key)
+
+	if !ok {
+		return time.Time{}, false
+	}
+
+	date, err := time.Parse("2006-01-02", value)
+
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return date, true
+}
+
+// Set sets the value of a custom field, value is encoded as JSON.
+func (c *CustomFields) Set(key string, value interface{}) error {
+	data, err := json.Marshal(value)
+
+	if err != nil {
+		return err
+	}
+
+	if *c == nil {
+		*c = make(CustomFields)
+	}
+
+	(*c)[key] = data
+
+	return nil
+}
+
+// SetString sets the value of a text custom field.
+func (c *CustomFields) SetString(key string, value string) {
+	c.Set(key, value)
+}
+
+// SetInt sets the value of a numeric custom field.
+func (c *CustomFields) SetInt(key string, value int) {
+	c.Set(key, value)
+}
+
+// SetMoney sets the value and currency of a monetary custom field.
+func (c *CustomFields) SetMoney(key string, value Money) {
+	c.Set(key, value.Value)
+	c.Set(key+"_currency", value.Currency)
+}
+
+// SetEnum sets the selected option ID of a single option custom field.
+func (c *CustomFields) SetEnum(key string, optionID int) {
+	c.Set(key, optionID)
+}
+
+// SetDate sets the value of a date custom field.
+func (c *CustomFields) SetDate(key string, value time.Time) {
+	c.Set(key, value.Format("2006-01-02"))
+}
+
+// decode decodes the value of a custom field into v. It returns false
+// when the field is missing, empty or can not be decoded.
+func (c CustomFields) decode(key string, v interface{}) bool {
+	value, ok := c[key]
+
+	if !ok || string(value) == "null" {
+		return false
+	}
+
+	return json.Unmarshal(value, v) == nil
+}
+
+// isCustomFieldKey reports whether key is a custom field key, or a subfield
+// key of a custom field.
+func isCustomFieldKey(key string) bool {
+	if len(key) < customFieldKeyLength {
+		return false
+	}
+
+	if len(key) > customFieldKeyLength && key[customFieldKeyLength] != '_' {
+		return false
+	}
+
+	for _, r := range key[:customFieldKeyLength] {
+		if !('0' <= r && r <= '9' || 'a' <= r && r <= 'f') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// decodeCustomFields collects the custom fields of a JSON object.
+func decodeCustomFields(data []byte) (CustomFields, error) {
+	var object map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+
+	var fields CustomFields
+
+	for key, value := range object {
+		if !isCustomFieldKey(key) {
+			continue
+		}
+
+		if fields == nil {
+			fields = make(CustomFields)
+		}
+
+		fields[key] = value
+	}
+
+	return fields, nil
+}
+
+// withCustomFields encodes v as a JSON object and adds the custom fields
+// to it.
+func withCustomFields(v interface{}, fields CustomFields) (json.RawMessage, error) {
+	data, err := json.Marshal(v)
+
+	if err != nil || len(fields) == 0 {
+		return data, err
+	}
+
+	var object map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+
+	for key, value := range fields {
+		object[key] = value
+	}
+
+	return json.Marshal(object)
+}
@@ -12,34 +12,31 @@ import (
 // Pipedrive API dcos: https://developers.pipedrive.com/docs/api/v1/#!/DealFields
 type DealFieldsService service
 
-// DealField represents a Pipedrive deal.
+// DealField represents a Pipedrive deal field.
 type DealField struct {
-	ID                 int         `json:"id"`
-	Key                string      `json:"key"`
-	Name               string      `json:"name"`
-	OrderNr            int         `json:"order_nr,omitempty"`
-	PicklistData       interface{} `json:"picklist_data,omitempty"`
-	FieldType          string      `json:"field_type"`
-	AddTime            string      `json:"add_time,omitempty"`
-	UpdateTime         string      `json:"update_time,omitempty"`
-	ActiveFlag         bool        `json:"active_flag"`
-	EditFlag           bool        `json:"edit_flag"`
-	IndexVisibleFlag   bool        `json:"index_visible_flag,omitempty"`
-	DetailsVisibleFlag bool        `json:"details_visible_flag,omitempty"`
-	AddVisibleFlag     bool        `json:"add_visible_flag,omitempty"`
-	ImportantFlag      bool        `json:"important_flag,omitempty"`
-	BulkEditAllowed    bool        `json:"bulk_edit_allowed,omitempty"`
-	SearchableFlag     bool        `json:"searchable_flag,omitempty"`
-	FilteringAllowed   bool        `json:"filtering_allowed,omitempty"`
-	SortableFlag       bool        `json:"sortable_flag,omitempty"`
-	UseField           string      `json:"use_field,omitempty"`
-	Link               string      `json:"link,omitempty"`
-	MandatoryFlag      bool        `json:"mandatory_flag"`
-	IsSubfield         bool        `json:"is_subfield,omitempty"`
-	Options            []struct {
-		ID    int    `json:"id"`
-		Label string `json:"label"`
-	} `json:"options,omitempty"`
+	ID                        int         `json:"id"`
+	Key                       string      `json:"key"`
+	Name                      string      `json:"name"`
+	OrderNr                   int         `json:"order_nr,omitempty"`
+	PicklistData              interface{} `json:"picklist_data,omitempty"`
+	FieldType                 FieldType   `json:"field_type"`
+	AddTime                   string      `json:"add_time,omitempty"`
+	UpdateTime                string      `json:"update_time,omitempty"`
+	ActiveFlag                bool        `json:"active_flag"`
+	EditFlag                  bool        `json:"edit_flag"`
+	IndexVisibleFlag          bool        `json:"index_visible_flag,omitempty"`
+	DetailsVisibleFlag        bool        `json:"details_visible_flag,omitempty"`
+	AddVisibleFlag            bool        `json:"add_visible_flag,omitempty"`
+	ImportantFlag             bool        `json:"important_flag,omitempty"`
+	BulkEditAllowed           bool        `json:"bulk_edit_allowed,omitempty"`
+	SearchableFlag            bool        `json:"searchable_flag,omitempty"`
+	FilteringAllowed          bool        `json:"filtering_allowed,omitempty"`
+	SortableFlag              bool        `json:"sortable_flag,omitempty"`
+	UseField                  string      `json:"use_field,omitempty"`
+	Link                      string      `json:"link,omitempty"`
+	MandatoryFlag             bool        `json:"mandatory_flag"`
+	IsSubfield                bool        `json:"is_subfield,omitempty"`
+	Options                   []Option    `json:"options,omitempty"`
 	BulkEditAllowedConditions struct {
 		Status string `json:"status"`
 	} `json:"bulk_edit_allowed_conditions,omitempty"`
@@ -108,10 +105,19 @@ func (s *DealFieldsService) GetByID(ctx context.Context, id int) (*DealFieldResp
 
 // DealFieldCreateOptions specifices the optional parameters to the
 // DealFieldsService.Create method.
+//
+// Options are required for enum and set fields, only labels need to be given.
 type DealFieldCreateOptions struct {
-	Name      string    `url:"name,omitempty"`
-	FieldType FieldType `url:"field_type,omitempty"`
-	Options   string    `url:"options,omitempty"`
+	Name           string    `json:"name"`
+	FieldType      FieldType `json:"field_type"`
+	Options        []Option  `json:"options,omitempty"`
+	AddVisibleFlag *bool     `json:"add_visible_flag,omitempty"`
+}
+
+// Validate checks the required name and field type, and that options
+// are given for enum and set fields.
+func (o DealFieldCreateOptions) Validate() error {
+	return validateFieldCreate(o.Name, o.FieldType, o.Options)
 }
 
 // Create a new deal field.
@@ -137,15 +143,20 @@ func (s *DealFieldsService) Create(ctx context.Context, opt *DealFieldCreateOpti
 
 // DealFieldUpdateOptions specifices the optional parameters to the
 // DealFieldsService.Update method.
+//
+// When Options are given, they replace the existing option list. Existing
+// options must be given with their ID, options without ID are added and
+// options left out are removed.
 type DealFieldUpdateOptions struct {
-	Name    string `url:"name,omitempty"`
-	Options string `url:"options,omitempty"`
+	Name           string   `json:"name,omitempty"`
+	Options        []Option `json:"options,omitempty"`
+	AddVisibleFlag *bool    `json:"add_visible_flag,omitempty"`
 }
 
 // Update a deal field.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/DealFields/put_dealFields_id
-func (s *DealFieldsService) Update(ctx context.Context, id int, opt *DealFieldUpdateOptions) (*ProductFieldResponse, *Response, error) {
+func (s *DealFieldsService) Update(ctx context.Context, id int, opt *DealFieldUpdateOptions) (*DealFieldResponse, *Response, error) {
 	uri := fmt.Sprintf("/dealFields/%v", id)
 	req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
 
@@ -153,7 +164,7 @@ func (s *DealFieldsService) Update(ctx context.Context, id int, opt *DealFieldUp
 		return nil, nil, err
 	}
 
-	var record *ProductFieldResponse
+	var record *DealFieldResponse
 
 	resp, err := s.client.Do(ctx, req, &record)
 
@@ -169,7 +180,7 @@ func (s *DealFieldsService) Update(ctx context.Context, id int, opt *DealFieldUp
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/DealFields/delete_dealFields
 func (s *DealFieldsService) DeleteMultiple(ctx context.Context, ids []int) (*Response, error) {
 	req, err := s.client.NewRequest(http.MethodDelete, "/dealFields", &DeleteMultipleOptions{
-		Ids: arrayToString(ids, ","),
+		Ids: ids,
 	}, nil)
 
 	if err != nil {
@@ -182,7 +193,7 @@ func (s *DealFieldsService) DeleteMultiple(ctx context.Context, ids []int) (*Res
 // Delete a deal field.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/DealFields/delete_dealFields_id
-func (s *DealFieldsService) Delete(ctx context.Context, id uint) (*Response, error) {
+func (s *DealFieldsService) Delete(ctx context.Context, id int) (*Response, error) {
 	uri := fmt.Sprintf("/dealFields/%v", id)
 	req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
 
@@ -192,3 +203,15 @@ func (s *DealFieldsService) Delete(ctx context.Context, id uint) (*Response, err
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// ByKey returns deal fields keyed by their API key, which is the name
+// of the property on deals.
+func (r *DealFieldsResponse) ByKey() map[string]DealField {
+	fields := make(map[string]DealField, len(r.Data))
+
+	for _, field := range r.Data {
+		fields[field.Key] = field
+	}
+
+	return fields
+}
@@ -0,0 +1,221 @@
+package pipedrive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const dealFlowTimeLayout = "2006-01-02 15:04:05"
+
+// DealFlowItem represents an entry of the updates of a deal, such as a
+// field change, an activity or a note. Data holds the JSON of the entry.
+type DealFlowItem struct {
+	Object    string          `json:"object"`
+	Timestamp string          `json:"timestamp"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// Change decodes the entry as a field change. It reports false for other
+// entries.
+func (i DealFlowItem) Change() (DealChange, bool) {
+	var change DealChange
+
+	if i.Object != "dealChange" || json.Unmarshal(i.Data, &change) != nil {
+		return DealChange{}, false
+	}
+
+	return change, true
+}
+
+// DealChange represents a change of a deal field.
+type DealChange struct {
+	ID           int             `json:"id"`
+	ItemID       int             `json:"item_id"`
+	UserID       int             `json:"user_id"`
+	FieldKey     string          `json:"field_key"`
+	OldValue     json.RawMessage `json:"old_value"`
+	NewValue     json.RawMessage `json:"new_value"`
+	IsBulkUpdate bool            `json:"is_bulk_update"`
+	LogTime      string          `json:"log_time"`
+	ChangeSource string          `json:"change_source"`
+}
+
+// Time returns the time of the change.
+func (c DealChange) Time() time.Time {
+	t, _ := time.Parse(dealFlowTimeLayout, c.LogTime)
+
+	return t
+}
+
+// DealFlowResponse represents the updates of a deal.
+type DealFlowResponse struct {
+	Success        bool           `json:"success"`
+	Data           []DealFlowItem `json:"data"`
+	AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// DealsFlowOptions specifices the optional parameters to the
+// DealService.Flow method.
+//
+// Items limits the entries, for example "dealChange".
+type DealsFlowOptions struct {
+	AllChanges string `url:"all_changes,omitempty"`
+	Items      string `url:"items,omitempty"`
+	Start      uint   `url:"start,omitempty"`
+	Limit      uint   `url:"limit,omitempty"`
+}
+
+// Flow lists the updates of a deal, newest first.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Deals/get_deals_id_flow
+func (s *DealService) Flow(ctx context.Context, id int, opt *DealsFlowOptions) (*DealFlowResponse, *Response, error) {
+	uri := fmt.Sprintf("/deals/%v/flow", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *DealFlowResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// Changes returns the field changes of a deal, fetching all pages of
+// Flow.
+func (s *DealService) Changes(ctx context.Context, id int) ([]DealChange, *Response, error) {
+	opt := &DealsFlowOptions{Items: "dealChange", Limit: listAllPageLimit}
+	pager := s.client.NewPager(fmt.Sprintf("/deals/%v/flow", id), 0)
+
+	var changes []DealChange
+
+	for {
+		opt.Start = pager.Start
+		page, resp, err := s.Flow(ctx, id, opt)
+
+		if err != nil {
+			return changes, resp, err
+		}
+
+		for _, item := range page.Data {
+			if change, ok := item.Change(); ok {
+				changes = append(changes, change)
+			}
+		}
+
+		if more, err := pager.Next(page.AdditionalData.Pagination, len(page.Data)); !more {
+			return changes, resp, err
+		}
+	}
+}
+
+// StageStay represents a period a deal spent in a stage.
+type StageStay struct {
+	StageID int
+	Entered time.Time
+
+	// Exited is zero while the deal is still open in the stage.
+	Exited time.Time
+}
+
+// Duration returns the time spent in the stage, up to now when the deal
+// is still in it.
+func (s StageStay) Duration(now time.Time) time.Duration {
+	if s.Exited.IsZero() {
+		return now.Sub(s.Entered)
+	}
+
+	return s.Exited.Sub(s.Entered)
+}
+
+// StageHistory returns the stages of the deal with the times it entered
+// and left them, fetching its changes.
+func (s *DealService) StageHistory(ctx context.Context, deal *Deal) ([]StageStay, *Response, error) {
+	changes, resp, err := s.Changes(ctx, deal.ID)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return StageHistory(deal, changes), resp, nil
+}
+
+// StageHistory computes the stages a deal passed through, oldest first,
+// from its changes as returned by Changes. The deal entered its first
+// stage when it was added, and a won or lost deal left its last stage when
+// it was closed. A deal that returns to a stage has a stay for every visit.
+func StageHistory(deal *Deal, changes []DealChange) []StageStay {
+	var moves []DealChange
+
+	// Changes are listed newest first, reversing keeps changes logged in
+	// the same second in order.
+	for i := len(changes) - 1; i >= 0; i-- {
+		if changes[i].FieldKey == "stage_id" {
+			moves = append(moves, changes[i])
+		}
+	}
+
+	sort.Stable(byChangeTime(moves))
+
+	added, _ := time.Parse(dealFlowTimeLayout, deal.AddTime)
+	first := deal.StageID
+
+	if len(moves) > 0 {
+		if id, ok := changeStageID(moves[0].OldValue); ok {
+			first = id
+		}
+	}
+
+	stays := []StageStay{{StageID: first, Entered: added}}
+
+	for _, move := range moves {
+		stageID, ok := changeStageID(move.NewValue)
+
+		if !ok {
+			continue
+		}
+
+		at := move.Time()
+		stays[len(stays)-1].Exited = at
+		stays = append(stays, StageStay{StageID: stageID, Entered: at})
+	}
+
+	if deal.Status == DealStatusWon || deal.Status == DealStatusLost {
+		if closed, err := time.Parse(dealFlowTimeLayout, deal.CloseTime); err == nil {
+			stays[len(stays)-1].Exited = closed
+		}
+	}
+
+	return stays
+}
+
+// changeStageID returns the stage ID of an old or new value, which is sent
+// as number or string.
+func changeStageID(value json.RawMessage) (int, bool) {
+	text, ok := rawScalar(value)
+
+	if !ok {
+		return 0, false
+	}
+
+	id, err := strconv.Atoi(text)
+
+	return id, err == nil
+}
+
+type byChangeTime []DealChange
+
+func (s byChangeTime) Len() int           { return len(s) }
+func (s byChangeTime) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byChangeTime) Less(i, j int) bool { return s[i].LogTime < s[j].LogTime }
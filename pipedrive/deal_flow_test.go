@@ -0,0 +1,114 @@
+package pipedrive
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func flowTime(value string) time.Time {
+	t, _ := time.Parse(dealFlowTimeLayout, value)
+
+	return t
+}
+
+func stageChange(logTime string, old, new string) DealChange {
+	return DealChange{FieldKey: "stage_id", LogTime: logTime, OldValue: json.RawMessage(old), NewValue: json.RawMessage(new)}
+}
+
+func TestStageHistory(t *testing.T) {
+	tests := []struct {
+		name    string
+		deal    Deal
+		changes []DealChange
+		want    []StageStay
+	}{
+		{
+			name: "never moved",
+			deal: Deal{StageID: 1, AddTime: "2019-06-01 10:00:00"},
+			want: []StageStay{{StageID: 1, Entered: flowTime("2019-06-01 10:00:00")}},
+		},
+		{
+			name: "moved twice, newest first",
+			deal: Deal{StageID: 3, AddTime: "2019-06-01 10:00:00"},
+			changes: []DealChange{
+				stageChange("2019-06-03 10:00:00", `"2"`, `"3"`),
+				{FieldKey: "title", LogTime: "2019-06-02 12:00:00"},
+				stageChange("2019-06-02 10:00:00", `1`, `2`),
+			},
+			want: []StageStay{
+				{StageID: 1, Entered: flowTime("2019-06-01 10:00:00"), Exited: flowTime("2019-06-02 10:00:00")},
+				{StageID: 2, Entered: flowTime("2019-06-02 10:00:00"), Exited: flowTime("2019-06-03 10:00:00")},
+				{StageID: 3, Entered: flowTime("2019-06-03 10:00:00")},
+			},
+		},
+		{
+			name: "moved back within a second",
+			deal: Deal{StageID: 1, AddTime: "2019-06-01 10:00:00"},
+			changes: []DealChange{
+				stageChange("2019-06-02 10:00:00", `2`, `1`),
+				stageChange("2019-06-02 10:00:00", `1`, `2`),
+			},
+			want: []StageStay{
+				{StageID: 1, Entered: flowTime("2019-06-01 10:00:00"), Exited: flowTime("2019-06-02 10:00:00")},
+				{StageID: 2, Entered: flowTime("2019-06-02 10:00:00"), Exited: flowTime("2019-06-02 10:00:00")},
+				{StageID: 1, Entered: flowTime("2019-06-02 10:00:00")},
+			},
+		},
+		{
+			name:    "won",
+			deal:    Deal{StageID: 2, AddTime: "2019-06-01 10:00:00", Status: DealStatusWon, CloseTime: "2019-06-05 10:00:00"},
+			changes: []DealChange{stageChange("2019-06-02 10:00:00", `1`, `2`)},
+			want: []StageStay{
+				{StageID: 1, Entered: flowTime("2019-06-01 10:00:00"), Exited: flowTime("2019-06-02 10:00:00")},
+				{StageID: 2, Entered: flowTime("2019-06-02 10:00:00"), Exited: flowTime("2019-06-05 10:00:00")},
+			},
+		},
+		{
+			name:    "unreadable stage",
+			deal:    Deal{StageID: 1, AddTime: "2019-06-01 10:00:00"},
+			changes: []DealChange{stageChange("2019-06-02 10:00:00", `1`, `null`)},
+			want:    []StageStay{{StageID: 1, Entered: flowTime("2019-06-01 10:00:00")}},
+		},
+	}
+
+	for _, tt := range tests {
+		deal := tt.deal
+
+		if got := StageHistory(&deal, tt.changes); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("StageHistory of %v returned %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDealService_Changes(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/deals/1/flow", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("start") == "" {
+			writeJSON(w, http.StatusOK, `{"success": true, "data": [
+				{"object": "dealChange", "timestamp": "2019-06-02 10:00:00", "data": {"id": 1, "field_key": "stage_id"}},
+				{"object": "note", "timestamp": "2019-06-02 09:00:00", "data": {"id": 2}}
+			], "additional_data": {"pagination": {"start": 0, "limit": 2, "more_items_in_collection": true, "next_start": 2}}}`)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, `{"success": true, "data": [
+			{"object": "dealChange", "timestamp": "2019-06-01 10:00:00", "data": {"id": 3, "field_key": "title"}}
+		]}`)
+	})
+
+	changes, _, err := client.Deals.Changes(context.Background(), 1)
+
+	if err != nil {
+		t.Fatalf("Changes returned error: %v", err)
+	}
+
+	if len(changes) != 2 || changes[0].ID != 1 || changes[1].ID != 3 {
+		t.Errorf("Changes returned %+v, want the changes 1 and 3", changes)
+	}
+}
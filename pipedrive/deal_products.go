@@ -0,0 +1,220 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+)
+
+// DiscountType is how the discount of a product attached to a deal is
+// given.
+type DiscountType string
+
+// DiscountType constants.
+const (
+	DiscountPercentage DiscountType = "percentage"
+	DiscountAmount     DiscountType = "amount"
+)
+
+// TaxMethod is how the tax of a product attached to a deal is applied.
+type TaxMethod string
+
+// TaxMethod constants.
+const (
+	TaxExclusive TaxMethod = "exclusive"
+	TaxInclusive TaxMethod = "inclusive"
+	TaxNone      TaxMethod = "none"
+)
+
+// DealProduct represents a product attached to a deal. ID identifies the
+// attachment, ProductID the product.
+type DealProduct struct {
+	ID                 int          `json:"id"`
+	DealID             int          `json:"deal_id"`
+	ProductID          int          `json:"product_id"`
+	Name               string       `json:"name"`
+	ItemPrice          float64      `json:"item_price"`
+	Quantity           float64      `json:"quantity"`
+	Duration           float64      `json:"duration"`
+	DurationUnit       string       `json:"duration_unit"`
+	Discount           float64      `json:"discount"`
+	DiscountType       DiscountType `json:"discount_type"`
+	DiscountPercentage float64      `json:"discount_percentage"`
+	Tax                float64      `json:"tax"`
+	TaxMethod          TaxMethod    `json:"tax_method"`
+	Sum                float64      `json:"sum"`
+	Currency           string       `json:"currency"`
+	EnabledFlag        bool         `json:"enabled_flag"`
+	Comments           string       `json:"comments"`
+}
+
+func (p DealProduct) String() string {
+	return Stringify(p)
+}
+
+// Total returns the price of the attached product: the item price times
+// quantity and duration, less the discount, plus the tax unless the tax is
+// included in the price. The total is rounded to cents.
+func (p DealProduct) Total() float64 {
+	duration := p.Duration
+
+	if duration == 0 {
+		duration = 1
+	}
+
+	total := p.ItemPrice * p.Quantity * duration
+
+	switch p.DiscountType {
+	case DiscountAmount:
+		total -= p.Discount
+	case DiscountPercentage:
+		total -= total * p.Discount / 100
+	default:
+		total -= total * p.DiscountPercentage / 100
+	}
+
+	if p.TaxMethod == TaxExclusive || p.TaxMethod == "" {
+		total += total * p.Tax / 100
+	}
+
+	return roundCents(total)
+}
+
+// DealProducts are the products attached to a deal.
+type DealProducts []DealProduct
+
+// Total returns the sum of the totals of the enabled products, the value
+// Pipedrive gives a deal with products.
+func (p DealProducts) Total() float64 {
+	var total float64
+
+	for _, product := range p {
+		if product.EnabledFlag {
+			total += product.Total()
+		}
+	}
+
+	return roundCents(total)
+}
+
+func roundCents(value float64) float64 {
+	return math.Floor(value*100+0.5) / 100
+}
+
+// DealProductsResponse represents multiple deal products response.
+type DealProductsResponse struct {
+	Success        bool           `json:"success"`
+	Data           DealProducts   `json:"data"`
+	AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// DealProductsListOptions specifices the optional parameters to the
+// DealService.ListProducts method.
+type DealProductsListOptions struct {
+	Start uint `url:"start,omitempty"`
+	Limit uint `url:"limit,omitempty"`
+}
+
+// ListProducts lists the products attached to a deal.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Deals/get_deals_id_products
+func (s *DealService) ListProducts(ctx context.Context, id int, opt *DealProductsListOptions) (*DealProductsResponse, *Response, error) {
+	uri := fmt.Sprintf("/deals/%v/products", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *DealProductsResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// ListAllProducts returns the products of every page of ListProducts.
+func (s *DealService) ListAllProducts(ctx context.Context, id int) (DealProducts, *Response, error) {
+	page := DealProductsListOptions{Limit: listAllPageLimit}
+	pager := s.client.NewPager(fmt.Sprintf("/deals/%v/products", id), 0)
+
+	var products DealProducts
+
+	for {
+		page.Start = pager.Start
+		result, resp, err := s.ListProducts(ctx, id, &page)
+
+		if err != nil {
+			return products, resp, err
+		}
+
+		products = append(products, result.Data...)
+
+		if more, err := pager.Next(result.AdditionalData.Pagination, len(result.Data)); !more {
+			return products, resp, err
+		}
+	}
+}
+
+// DealValueCheck compares the value of a deal to the total of its
+// products.
+type DealValueCheck struct {
+	DealID   int
+	Value    float64
+	Expected float64
+	Products DealProducts
+
+	// Updated is set when the value of the deal was changed to Expected.
+	Updated bool
+}
+
+// Mismatch reports whether the value of the deal differs from the total
+// of its products.
+func (c DealValueCheck) Mismatch() bool {
+	return math.Abs(c.Value-c.Expected) >= 0.005
+}
+
+// CheckValue compares the value of the deal to the total of its products,
+// for example to find deals whose value was not recalculated after the
+// price of a product changed.
+func (s *DealService) CheckValue(ctx context.Context, deal *Deal) (*DealValueCheck, *Response, error) {
+	products, resp, err := s.ListAllProducts(ctx, deal.ID)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &DealValueCheck{
+		DealID:   deal.ID,
+		Value:    deal.Value,
+		Expected: products.Total(),
+		Products: products,
+	}, resp, nil
+}
+
+// RecalculateValue checks the value of the deal like CheckValue and
+// updates it to the total of its products when they differ. Deals without
+// products are left alone.
+func (s *DealService) RecalculateValue(ctx context.Context, deal *Deal) (*DealValueCheck, *Response, error) {
+	check, resp, err := s.CheckValue(ctx, deal)
+
+	if err != nil || !check.Mismatch() || len(check.Products) == 0 {
+		return check, resp, err
+	}
+
+	value := strconv.FormatFloat(check.Expected, 'f', 2, 64)
+
+	if resp, err = s.Update(ctx, deal.ID, &DealsUpdateOptions{Value: &value}); err != nil {
+		return check, resp, err
+	}
+
+	check.Updated = true
+
+	return check, resp, nil
+}
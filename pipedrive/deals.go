@@ -2,6 +2,7 @@ package pipedrive
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 )
@@ -34,16 +35,10 @@ type Deal struct {
 		Value      int    `json:"value"`
 	} `json:"user_id"`
 	PersonID struct {
-		Name  string `json:"name"`
-		Email []struct {
-			Value   string `json:"value"`
-			Primary bool   `json:"primary"`
-		} `json:"email"`
-		Phone []struct {
-			Value   string `json:"value"`
-			Primary bool   `json:"primary"`
-		} `json:"phone"`
-		Value int `json:"value"`
+		Name  string         `json:"name"`
+		Email []ContactValue `json:"email"`
+		Phone []ContactValue `json:"phone"`
+		Value int            `json:"value"`
 	} `json:"person_id"`
 	OrgID struct {
 		Name        string      `json:"name"`
@@ -62,15 +57,16 @@ type Deal struct {
 	StageChangeTime          string      `json:"stage_change_time"`
 	Active                   bool        `json:"active"`
 	Deleted                  bool        `json:"deleted"`
-	Status                   string      `json:"status"`
-	Probability              interface{} `json:"probability"`
+	Status                   DealStatus  `json:"status"`
+	Probability              *float64    `json:"probability"`
 	NextActivityDate         interface{} `json:"next_activity_date"`
 	NextActivityTime         interface{} `json:"next_activity_time"`
 	NextActivityID           interface{} `json:"next_activity_id"`
 	LastActivityID           int         `json:"last_activity_id"`
 	LastActivityDate         string      `json:"last_activity_date"`
 	LostReason               string      `json:"lost_reason"`
-	VisibleTo                string      `json:"visible_to"`
+	Label                    LabelID     `json:"label"`
+	VisibleTo                VisibleTo   `json:"visible_to"`
 	CloseTime                string      `json:"close_time"`
 	PipelineID               int         `json:"pipeline_id"`
 	WonTime                  interface{} `json:"won_time"`
@@ -98,7 +94,7 @@ type Deal struct {
 	NextActivityNote         interface{} `json:"next_activity_note"`
 	FormattedValue           string      `json:"formatted_value"`
 	RottenTime               interface{} `json:"rotten_time"`
-	WeightedValue            int         `json:"weighted_value"`
+	WeightedValue            float64     `json:"weighted_value"`
 	FormattedWeightedValue   string      `json:"formatted_weighted_value"`
 	OwnerName                string      `json:"owner_name"`
 	CcEmail                  string      `json:"cc_email"`
@@ -121,12 +117,46 @@ type Deal struct {
 	LeadSource          uint   `json:"5d4fbabc9b032aeb3df515d9c66994d6892ee062"`
 	TemporaryLink       string `json:"4fe88fad67d8dcbc17d18d9ee1faac55122249fd"`
 	RideCosts           string `json:"31443a48d1405182dfccac9bf378bbe8216ffc9a"`
+
+	// CustomFields holds the values of all custom fields by field key.
+	CustomFields CustomFields `json:"-"`
+
+	// Raw holds the JSON the deal was decoded from.
+	Raw json.RawMessage `json:"-"`
 }
 
 func (d Deal) String() string {
 	return Stringify(d)
 }
 
+// UnmarshalJSON decodes a deal and collects its custom fields.
+func (d *Deal) UnmarshalJSON(data []byte) error {
+	return d.decode(Codec{}, data)
+}
+
+// decode implements modelDecoder.
+func (d *Deal) decode(codec Codec, data []byte) error {
+	type deal Deal
+
+	var v deal
+
+	if err := codec.unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	fields, err := decodeCustomFields(data)
+
+	if err != nil {
+		return err
+	}
+
+	*d = Deal(v)
+	d.CustomFields = fields
+	d.Raw = copyRaw(data)
+
+	return nil
+}
+
 // DealsResponse represents multiple deals response.
 type DealsResponse struct {
 	Success        bool           `json:"success,omitempty"`
@@ -253,38 +283,150 @@ func (s *DealService) Find(ctx context.Context, term string) (*DealsResponse, *R
 	return record, resp, nil
 }
 
+// DealsSearchOptions specifices the parameters to the
+// DealService.Search method.
+//
+// Fields restricts the fields searched in: "custom_fields", "notes" and
+// "title". IncludeFields accepts "deal.cc_email".
+type DealsSearchOptions struct {
+	Term           string     `url:"term"`
+	Fields         Fields     `url:"fields,omitempty,comma"`
+	ExactMatch     bool       `url:"exact_match,omitempty"`
+	PersonID       uint       `url:"person_id,omitempty"`
+	OrganizationID uint       `url:"organization_id,omitempty"`
+	Status         DealStatus `url:"status,omitempty"`
+	IncludeFields  Fields     `url:"include_fields,omitempty,comma"`
+	Start          uint       `url:"start,omitempty"`
+	Limit          uint       `url:"limit,omitempty"`
+}
+
+// Search deals by title, notes and custom fields.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Deals/get_deals_search
+func (s *DealService) Search(ctx context.Context, opt *DealsSearchOptions) (*ItemSearchResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "/deals/search", opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *ItemSearchResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// SearchAll returns every deal matching the search, fetching all pages.
+// Deals found on several pages are returned once. When more than
+// maxResults match, the first maxResults are returned with ErrSearchLimit.
+func (s *DealService) SearchAll(ctx context.Context, opt *DealsSearchOptions, maxResults int) ([]SearchItem, *Response, error) {
+	var page DealsSearchOptions
+
+	if opt != nil {
+		page = *opt
+	}
+
+	if page.Limit == 0 {
+		page.Limit = searchPageLimit
+	}
+
+	return searchAll(ctx, s.client.NewPager("/deals/search", page.Start), maxResults, func(start uint) (*ItemSearchResponse, *Response, error) {
+		page.Start = start
+
+		return s.Search(ctx, &page)
+	})
+}
+
 type FilterOptions struct {
 	FilterID int    `url:"filter_id"`
 	Status   string `url:"status"`
 }
 
+// DealStatus represents the status of a deal. DealStatusDeleted and
+// DealStatusAllNotDeleted are only used for filtering.
+type DealStatus string
+
+// DealStatus constants.
+const (
+	DealStatusOpen          DealStatus = "open"
+	DealStatusWon           DealStatus = "won"
+	DealStatusLost          DealStatus = "lost"
+	DealStatusDeleted       DealStatus = "deleted"
+	DealStatusAllNotDeleted DealStatus = "all_not_deleted"
+)
+
+// DealsListOptions specifices the optional parameters to the
+// DealService.List method.
+//
+// UserID and FilterID are ignored when OwnedByYou is set.
+type DealsListOptions struct {
+	UserID     uint       `url:"user_id,omitempty"`
+	FilterID   uint       `url:"filter_id,omitempty"`
+	StageID    uint       `url:"stage_id,omitempty"`
+	Status     DealStatus `url:"status,omitempty"`
+	Start      uint       `url:"start,omitempty"`
+	Limit      uint       `url:"limit,omitempty"`
+	Sort       Sort       `url:"sort,omitempty"`
+	OwnedByYou uint8      `url:"owned_by_you,omitempty"`
+}
+
 // List deals.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Deals/get_deals
-func (s *DealService) List(ctx context.Context, opts PaginationParameters) (map[string]interface{}, *Response, error) {
-	var (
-		err error
-		req *http.Request
-		f   interface{}
-	)
-
-	switch {
-	case opts.Start > 0 || opts.Limit > 0:
-		req, err = s.client.NewRequest(http.MethodGet, "/deals", &opts, nil)
-	default:
-		req, err = s.client.NewRequest(http.MethodGet, "/deals", nil, nil)
-	}
+func (s *DealService) List(ctx context.Context, opt *DealsListOptions) (*DealsResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "/deals", opt, nil)
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	resp, err := s.client.Do(ctx, req, &f)
+	var record *DealsResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
 	if err != nil {
 		return nil, resp, err
 	}
 
-	return f.(map[string]interface{}), resp, nil
+	return record, resp, nil
+}
+
+// ListAll returns the deals of every page of List. The Start of opt is
+// the first deal returned, Limit is the page size and defaults to 500.
+func (s *DealService) ListAll(ctx context.Context, opt *DealsListOptions) ([]Deal, *Response, error) {
+	var page DealsListOptions
+
+	if opt != nil {
+		page = *opt
+	}
+
+	if page.Limit == 0 {
+		page.Limit = listAllPageLimit
+	}
+
+	var deals []Deal
+
+	pager := s.client.NewPager("/deals", page.Start)
+
+	for {
+		page.Start = pager.Start
+		result, resp, err := s.List(ctx, &page)
+
+		if err != nil {
+			return deals, resp, err
+		}
+
+		deals = append(deals, result.Data...)
+
+		if more, err := pager.Next(result.AdditionalData.Pagination, len(result.Data)); !more {
+			return deals, resp, err
+		}
+	}
 }
 
 // Duplicate a deal.
@@ -331,19 +473,68 @@ func (s *DealService) Merge(ctx context.Context, id int, opt *DealsMergeOptions)
 
 // DealsUpdateOptions specifices the optional parameters to the
 // DealService.Update method.
+//
+// Set PersonID or OrganizationID to NoID() to unlink the deal from them.
 type DealsUpdateOptions struct {
-	Title               string `json:"title,omitempty"`
-	Value               string `json:"value,omitempty"`
-	Currency            string `json:"currency,omitempty"`
-	UserID              uint   `json:"user_id,omitempty"`
-	PersonID            uint   `json:"person_id,omitempty"`
-	OrganizationID      uint   `json:"org_id,omitempty"`
-	StageID             uint   `json:"stage_id,omitempty"`
-	Status              string `json:"status,omitempty"`
-	LostReason          string `json:"lost_reason,omitempty"`
-	VisibleTo           uint   `json:"visible_to,omitempty"`
-	RequirementAnalysis string `json:"56d3d40c37c0db60fff576ae73ba2fea0d58dc09,omitempty"`
-	TemporaryLink       string `json:"4fe88fad67d8dcbc17d18d9ee1faac55122249fd,omitempty"`
+	Title               *string     `json:"title,omitempty"`
+	Value               *string     `json:"value,omitempty"`
+	Currency            *string     `json:"currency,omitempty"`
+	UserID              *uint       `json:"user_id,omitempty"`
+	PersonID            *OptionalID `json:"person_id,omitempty"`
+	OrganizationID      *OptionalID `json:"org_id,omitempty"`
+	PipelineID          *uint       `json:"pipeline_id,omitempty"`
+	StageID             *uint       `json:"stage_id,omitempty"`
+	Status              *DealStatus `json:"status,omitempty"`
+	Probability         *float64    `json:"probability,omitempty"`
+	LostReason          *string     `json:"lost_reason,omitempty"`
+	Label               *uint       `json:"label,omitempty"`
+	ExpectedCloseDate   *DueDate    `json:"expected_close_date,omitempty"`
+	VisibleTo           *VisibleTo  `json:"visible_to,omitempty"`
+	RequirementAnalysis *string     `json:"56d3d40c37c0db60fff576ae73ba2fea0d58dc09,omitempty"`
+	TemporaryLink       *string     `json:"4fe88fad67d8dcbc17d18d9ee1faac55122249fd,omitempty"`
+
+	// CustomFields are sent along with the other fields.
+	CustomFields CustomFields `json:"-"`
+
+	// Null holds the keys of fields to clear.
+	Null NullFields `json:"-"`
+}
+
+// Validate checks the status and visibility values.
+func (o DealsUpdateOptions) Validate() error {
+	var status DealStatus
+
+	if o.Status != nil {
+		status = *o.Status
+	}
+
+	return validateDealValues(status, visibleToValue(o.VisibleTo))
+}
+
+// validateDealValues checks the status and visibility of a deal to create
+// or update. The statuses only used for filtering are rejected.
+func validateDealValues(status DealStatus, visibleTo VisibleTo) error {
+	switch status {
+	case "", DealStatusOpen, DealStatusWon, DealStatusLost:
+	default:
+		return enumError("status", status)
+	}
+
+	return validateVisibleTo(visibleTo)
+}
+
+// MarshalJSON encodes the options together with the custom fields and
+// the cleared fields.
+func (o DealsUpdateOptions) MarshalJSON() ([]byte, error) {
+	type options DealsUpdateOptions
+
+	data, err := withCustomFields(options(o), o.CustomFields)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return o.Null.apply(data)
 }
 
 // Update a deal.
@@ -359,18 +550,36 @@ func (s *DealService) Update(ctx context.Context, id int, opt *DealsUpdateOption
 	return s.client.Do(ctx, req, nil)
 }
 
+// Win marks a specific deal as won.
+func (s *DealService) Win(ctx context.Context, id int) (*Response, error) {
+	status := DealStatusWon
+
+	return s.Update(ctx, id, &DealsUpdateOptions{Status: &status})
+}
+
+// Lose marks a specific deal as lost, the reason is left out when empty.
+func (s *DealService) Lose(ctx context.Context, id int, lostReason string) (*Response, error) {
+	status := DealStatusLost
+	opt := &DealsUpdateOptions{Status: &status}
+
+	if lostReason != "" {
+		opt.LostReason = &lostReason
+	}
+
+	return s.Update(ctx, id, opt)
+}
+
+// MoveToStage moves a specific deal to another stage, which may belong to
+// another pipeline.
+func (s *DealService) MoveToStage(ctx context.Context, id int, stageID uint) (*Response, error) {
+	return s.Update(ctx, id, &DealsUpdateOptions{StageID: &stageID})
+}
+
 // DeleteFollower of a deal.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Deals/delete_deals_id_followers_follower_id
 func (s *DealService) DeleteFollower(ctx context.Context, id int, followerID int) (*Response, error) {
-	uri := fmt.Sprintf("/deals/%v/followers/%v", id, followerID)
-	req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
-
-	if err != nil {
-		return nil, err
-	}
-
-	return s.client.Do(ctx, req, nil)
+	return s.client.Followers.Remove(ctx, FollowedDeal, id, followerID)
 }
 
 // DeleteMultiple deletes deals in bulk.
@@ -378,7 +587,7 @@ func (s *DealService) DeleteFollower(ctx context.Context, id int, followerID int
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Deals/delete_deals
 func (s *DealService) DeleteMultiple(ctx context.Context, ids []int) (*Response, error) {
 	req, err := s.client.NewRequest(http.MethodDelete, "/deals", &DeleteMultipleOptions{
-		Ids: arrayToString(ids, ","),
+		Ids: ids,
 	}, nil)
 
 	if err != nil {
@@ -433,63 +642,85 @@ func (s *DealService) DeleteAttachedProduct(ctx context.Context, dealID int, pro
 // DealCreateOptions specifices the optional parameters to the
 // DealsService.Create method.
 type DealCreateOptions struct {
-	Title               string    `json:"title"`
-	Value               string    `json:"value"`
-	Currency            string    `json:"currency"`
-	UserID              uint      `json:"user_id"`
-	PersonID            uint      `json:"person_id"`
-	OrgID               uint      `json:"org_id"`
-	StageID             uint      `json:"stage_id"`
-	Status              string    `json:"status"`
-	Probability         uint      `json:"probability"`
-	LostReason          string    `json:"lost_reason"`
-	AddTime             Timestamp `json:"add_time"`
-	VisibleTo           VisibleTo `json:"visible_to"`
-	RequirementAnalysis string    `json:"56d3d40c37c0db60fff576ae73ba2fea0d58dc09"`
-	WantedStartTime     Timestamp `json:"a3114acce61bb930180af173b395d76f42af8794"`
-	TemporaryLink       string    `json:"4fe88fad67d8dcbc17d18d9ee1faac55122249fd,omitempty"`
-	LeadSource          uint      `json:"5d4fbabc9b032aeb3df515d9c66994d6892ee062,omitempty"`
+	Title               string     `json:"title"`
+	Value               string     `json:"value"`
+	Currency            string     `json:"currency"`
+	UserID              uint       `json:"user_id"`
+	PersonID            uint       `json:"person_id"`
+	OrgID               uint       `json:"org_id"`
+	StageID             uint       `json:"stage_id"`
+	Status              DealStatus `json:"status"`
+	Probability         uint       `json:"probability"`
+	LostReason          string     `json:"lost_reason"`
+	AddTime             Timestamp  `json:"add_time"`
+	VisibleTo           VisibleTo  `json:"visible_to"`
+	RequirementAnalysis string     `json:"56d3d40c37c0db60fff576ae73ba2fea0d58dc09"`
+	WantedStartTime     Timestamp  `json:"a3114acce61bb930180af173b395d76f42af8794"`
+	TemporaryLink       string     `json:"4fe88fad67d8dcbc17d18d9ee1faac55122249fd,omitempty"`
+	LeadSource          uint       `json:"5d4fbabc9b032aeb3df515d9c66994d6892ee062,omitempty"`
+
+	// CustomFields are sent along with the other fields.
+	CustomFields CustomFields `json:"-"`
+}
+
+// Validate checks the required title and the status and visibility values.
+func (o DealCreateOptions) Validate() error {
+	if o.Title == "" {
+		return requiredError("title")
+	}
+
+	return validateDealValues(o.Status, o.VisibleTo)
+}
+
+// MarshalJSON encodes the options together with the custom fields, with
+// the times formatted as the API expects them.
+func (o DealCreateOptions) MarshalJSON() ([]byte, error) {
+	return withCustomFields(struct {
+		Title               string     `json:"title"`
+		Value               string     `json:"value"`
+		Currency            string     `json:"currency"`
+		UserID              uint       `json:"user_id"`
+		PersonID            uint       `json:"person_id"`
+		OrgID               uint       `json:"org_id"`
+		StageID             uint       `json:"stage_id"`
+		Status              DealStatus `json:"status"`
+		Probability         uint       `json:"probability"`
+		LostReason          string     `json:"lost_reason"`
+		AddTime             string     `json:"add_time"`
+		VisibleTo           VisibleTo  `json:"visible_to"`
+		RequirementAnalysis string     `json:"56d3d40c37c0db60fff576ae73ba2fea0d58dc09"`
+		WantedStartTime     string     `json:"a3114acce61bb930180af173b395d76f42af8794"`
+		TemporaryLink       string     `json:"4fe88fad67d8dcbc17d18d9ee1faac55122249fd,omitempty"`
+		LeadSource          uint       `json:"5d4fbabc9b032aeb3df515d9c66994d6892ee062,omitempty"`
+	}{
+		o.Title,
+		o.Value,
+		o.Currency,
+		o.UserID,
+		o.PersonID,
+		o.OrgID,
+		o.StageID,
+		o.Status,
+		o.Probability,
+		o.LostReason,
+		o.AddTime.FormatFull(),
+		o.VisibleTo,
+		o.RequirementAnalysis,
+		o.WantedStartTime.Format(),
+		o.TemporaryLink,
+		o.LeadSource,
+	}, o.CustomFields)
 }
 
 // Create a new deal.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Deals/post_deals
 func (s *DealService) Create(ctx context.Context, opt *DealCreateOptions) (*DealResponse, *Response, error) {
-	req, err := s.client.NewRequest(http.MethodPost, "/deals", nil, struct {
-		Title               string    `json:"title"`
-		Value               string    `json:"value"`
-		Currency            string    `json:"currency"`
-		UserID              uint      `json:"user_id"`
-		PersonID            uint      `json:"person_id"`
-		OrgID               uint      `json:"org_id"`
-		StageID             uint      `json:"stage_id"`
-		Status              string    `json:"status"`
-		Probability         uint      `json:"probability"`
-		LostReason          string    `json:"lost_reason"`
-		AddTime             string    `json:"add_time"`
-		VisibleTo           VisibleTo `json:"visible_to"`
-		RequirementAnalysis string    `json:"56d3d40c37c0db60fff576ae73ba2fea0d58dc09"`
-		WantedStartTime     string    `json:"a3114acce61bb930180af173b395d76f42af8794"`
-		TemporaryLink       string    `json:"4fe88fad67d8dcbc17d18d9ee1faac55122249fd,omitempty"`
-		LeadSource          uint      `json:"5d4fbabc9b032aeb3df515d9c66994d6892ee062,omitempty"`
-	}{
-		opt.Title,
-		opt.Value,
-		opt.Currency,
-		opt.UserID,
-		opt.PersonID,
-		opt.OrgID,
-		opt.StageID,
-		opt.Status,
-		opt.Probability,
-		opt.LostReason,
-		opt.AddTime.FormatFull(),
-		opt.VisibleTo,
-		opt.RequirementAnalysis,
-		opt.WantedStartTime.Format(),
-		opt.TemporaryLink,
-		opt.LeadSource,
-	})
+	if opt == nil {
+		return nil, nil, requiredError("title")
+	}
+
+	req, err := s.client.NewRequest(http.MethodPost, "/deals", nil, opt)
 
 	if err != nil {
 		return nil, nil, err
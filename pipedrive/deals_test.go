@@ -0,0 +1,72 @@
+package pipedrive
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestDealService_Create(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/deals", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		var body map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+
+		if body["title"] != "Deal" || body["add_time"] != "2019-06-01 10:00:00" || body["abc"] != "x" {
+			t.Errorf("Request body: %v, want the title, add time and custom field", body)
+		}
+
+		writeJSON(w, http.StatusOK, `{"success": true, "data": {"id": 1, "title": "Deal"}}`)
+	})
+
+	deal, _, err := client.Deals.Create(context.Background(), &DealCreateOptions{
+		Title:        "Deal",
+		AddTime:      Timestamp{time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC)},
+		CustomFields: CustomFields{"abc": json.RawMessage(`"x"`)},
+	})
+
+	if err != nil || deal.Data.ID != 1 {
+		t.Errorf("Create returned %+v, %v", deal, err)
+	}
+}
+
+func TestDealService_Create_invalid(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/deals", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Create sent a request for invalid options")
+	})
+
+	tests := []*DealCreateOptions{
+		nil,
+		{},
+		{Title: "Deal", Status: DealStatusDeleted},
+		{Title: "Deal", Status: DealStatusAllNotDeleted},
+	}
+
+	for _, opt := range tests {
+		if _, _, err := client.Deals.Create(context.Background(), opt); err == nil {
+			t.Errorf("Create returned no error for %+v", opt)
+		} else if _, ok := err.(*ValidationError); !ok {
+			t.Errorf("Create returned %v for %+v, want a *ValidationError", err, opt)
+		}
+	}
+}
+
+func TestDealService_Update_deletedStatus(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	status := DealStatusDeleted
+
+	if _, err := client.Deals.Update(context.Background(), 1, &DealsUpdateOptions{Status: &status}); err == nil {
+		t.Error("Update returned no error for the deleted status")
+	}
+}
@@ -0,0 +1,135 @@
+package pipedrive
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// IsDeleted reports whether the deal has been deleted.
+func (d Deal) IsDeleted() bool {
+	return d.Deleted || d.Status == DealStatusDeleted
+}
+
+// IsDeleted reports whether the person has been deleted.
+func (p Person) IsDeleted() bool {
+	return !p.ActiveFlag
+}
+
+// IsDeleted reports whether the organization has been deleted.
+func (o Organization) IsDeleted() bool {
+	return !o.ActiveFlag
+}
+
+// IsDeleted reports whether the record is the deletion of its object.
+// Deleted deals are flagged as deleted, other objects as inactive.
+func (r RecentRecord) IsDeleted() bool {
+	var object struct {
+		ActiveFlag *bool `json:"active_flag"`
+		Deleted    bool  `json:"deleted"`
+	}
+
+	if json.Unmarshal(r.Object, &object) != nil {
+		return false
+	}
+
+	return object.Deleted || object.ActiveFlag != nil && !*object.ActiveFlag
+}
+
+// ListDeleted returns the objects deleted since the given time, fetching
+// all pages of /recents. Items limits the objects, such as "deal" or
+// "person", all objects when empty.
+func (s *RecentsService) ListDeleted(ctx context.Context, since time.Time, items ...string) ([]RecentRecord, *Response, error) {
+	opt := &RecentsListOptions{
+		SinceTimestamp: since.UTC().Format(recentsTimeLayout),
+		Items:          strings.Join(items, ","),
+		Limit:          recentsPageLimit,
+	}
+
+	pager := s.client.NewPager("/recents", 0)
+
+	var deleted []RecentRecord
+
+	for {
+		opt.Start = pager.Start
+		page, resp, err := s.List(ctx, opt)
+
+		if err != nil {
+			return deleted, resp, err
+		}
+
+		for _, record := range page.Data {
+			if record.IsDeleted() {
+				deleted = append(deleted, record)
+			}
+		}
+
+		if more, err := pager.Next(page.AdditionalData.Pagination, len(page.Data)); !more {
+			return deleted, resp, err
+		}
+	}
+}
+
+// ListDeleted returns the deleted deals, fetching all pages of List with
+// the status filter set to deleted. The other options of opt apply.
+func (s *DealService) ListDeleted(ctx context.Context, opt *DealsListOptions) ([]Deal, *Response, error) {
+	var page DealsListOptions
+
+	if opt != nil {
+		page = *opt
+	}
+
+	page.Status = DealStatusDeleted
+
+	return s.ListAll(ctx, &page)
+}
+
+// ListDeleted returns the persons deleted since the given time. Persons
+// can not be listed by status, so the deletions are read from /recents.
+func (s *PersonsService) ListDeleted(ctx context.Context, since time.Time) ([]Person, *Response, error) {
+	records, resp, err := s.client.Recents.ListDeleted(ctx, since, string(OBJECT_PERSON))
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	persons := make([]Person, 0, len(records))
+
+	for _, record := range records {
+		var person Person
+
+		if err := json.Unmarshal(record.Object, &person); err != nil {
+			return persons, resp, err
+		}
+
+		persons = append(persons, person)
+	}
+
+	return persons, resp, nil
+}
+
+// ListDeleted returns the organizations deleted since the given time.
+// Organizations can not be listed by status, so the deletions are read
+// from /recents.
+func (s *OrganizationsService) ListDeleted(ctx context.Context, since time.Time) ([]Organization, *Response, error) {
+	records, resp, err := s.client.Recents.ListDeleted(ctx, since, string(OBJECT_ORGANIZATION))
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	organizations := make([]Organization, 0, len(records))
+
+	for _, record := range records {
+		var organization Organization
+
+		if err := json.Unmarshal(record.Object, &organization); err != nil {
+			return organizations, resp, err
+		}
+
+		organizations = append(organizations, organization)
+	}
+
+	return organizations, resp, nil
+}
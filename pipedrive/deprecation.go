@@ -0,0 +1,80 @@
+package pipedrive
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// Set when the endpoint is deprecated, either to "true", to an
+	// @-prefixed Unix timestamp or to an HTTP date.
+	headerDeprecation = "Deprecation"
+
+	// The HTTP date after which the endpoint stops working.
+	headerSunset = "Sunset"
+
+	headerLink = "Link"
+)
+
+// linkRelation matches the target and relation type of a Link header value.
+var linkRelation = regexp.MustCompile(`<([^>]*)>\s*;[^,]*rel="?(deprecation|sunset)"?`)
+
+// Deprecation represents the deprecation notice of an endpoint, sent by
+// Pipedrive in the Deprecation, Sunset and Link response headers.
+type Deprecation struct {
+	// Date the endpoint was deprecated on, zero when not given.
+	Date Timestamp `json:"date"`
+
+	// Sunset is the date after which the endpoint stops working, zero
+	// when not given.
+	Sunset Timestamp `json:"sunset"`
+
+	// Link to documentation about the deprecation.
+	Link string `json:"link"`
+}
+
+func (d Deprecation) String() string {
+	return Stringify(d)
+}
+
+// DeprecationHandler is called with the request and the deprecation
+// notice every time a deprecated endpoint is used.
+type DeprecationHandler func(*http.Request, *Deprecation)
+
+// parseDeprecationFromResponse returns the deprecation notice of the
+// response, or nil if the endpoint is not deprecated.
+func parseDeprecationFromResponse(r *http.Response) *Deprecation {
+	deprecation := r.Header.Get(headerDeprecation)
+	sunset := r.Header.Get(headerSunset)
+
+	if (deprecation == "" || deprecation == "false") && sunset == "" {
+		return nil
+	}
+
+	d := &Deprecation{}
+
+	if strings.HasPrefix(deprecation, "@") {
+		if value, err := strconv.ParseInt(deprecation[1:], 10, 64); err == nil {
+			d.Date = Timestamp{time.Unix(value, 0)}
+		}
+	} else if date, err := http.ParseTime(deprecation); err == nil {
+		d.Date = Timestamp{date}
+	}
+
+	if date, err := http.ParseTime(sunset); err == nil {
+		d.Sunset = Timestamp{date}
+	}
+
+	for _, link := range r.Header[headerLink] {
+		for _, match := range linkRelation.FindAllStringSubmatch(link, -1) {
+			if d.Link == "" || match[2] == "deprecation" {
+				d.Link = match[1]
+			}
+		}
+	}
+
+	return d
+}
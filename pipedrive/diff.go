@@ -0,0 +1,162 @@
+package pipedrive
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+// FieldChange represents a field whose value differs between two versions
+// of an entity. Before or After is null when the field is missing from
+// that version.
+type FieldChange struct {
+	Key    string
+	Before json.RawMessage
+	After  json.RawMessage
+}
+
+// FieldChanges are the changes between two versions of an entity.
+type FieldChanges []FieldChange
+
+// Diff returns the fields that differ between two versions of an entity,
+// ordered by key. The versions are models such as *Deal, *Person,
+// *Organization or *Activity, including their custom fields, or JSON
+// objects as json.RawMessage, for example the previous and current data
+// of a webhook event. A nil old version counts as empty.
+func Diff(old, new interface{}) (FieldChanges, error) {
+	before, err := entityFields(old)
+
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := entityFields(new)
+
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]bool, len(after))
+
+	for key := range before {
+		keys[key] = true
+	}
+
+	for key := range after {
+		keys[key] = true
+	}
+
+	var changes FieldChanges
+
+	for key := range keys {
+		if !jsonEqual(before[key], after[key]) {
+			changes = append(changes, FieldChange{Key: key, Before: orNull(before[key]), After: orNull(after[key])})
+		}
+	}
+
+	sort.Sort(byFieldKey(changes))
+
+	return changes, nil
+}
+
+// Changed reports whether the field with the given key changed.
+func (c FieldChanges) Changed(key string) bool {
+	for _, change := range c {
+		if change.Key == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Payload returns the new values of the changed fields, as the body of an
+// update request. Related objects, such as the owner in user_id, are
+// reduced to their ID.
+func (c FieldChanges) Payload() map[string]json.RawMessage {
+	payload := make(map[string]json.RawMessage, len(c))
+
+	for _, change := range c {
+		payload[change.Key] = relatedID(change.After)
+	}
+
+	return payload
+}
+
+// entityFields returns the fields of v by key, with its custom fields.
+func entityFields(v interface{}) (map[string]json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	value := reflect.ValueOf(v)
+
+	if value.Kind() == reflect.Ptr && value.IsNil() {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(v)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	if value = reflect.Indirect(value); value.Kind() == reflect.Struct {
+		if custom, ok := value.FieldByName("CustomFields").Interface().(CustomFields); ok {
+			for key, raw := range custom {
+				fields[key] = raw
+			}
+		}
+	}
+
+	return fields, nil
+}
+
+func jsonEqual(a, b json.RawMessage) bool {
+	var x, y interface{}
+
+	json.Unmarshal(orNull(a), &x)
+	json.Unmarshal(orNull(b), &y)
+
+	return reflect.DeepEqual(x, y)
+}
+
+func orNull(value json.RawMessage) json.RawMessage {
+	if len(value) == 0 {
+		return json.RawMessage("null")
+	}
+
+	return value
+}
+
+// relatedID returns the value or ID of a related object, other values
+// unchanged.
+func relatedID(value json.RawMessage) json.RawMessage {
+	var object map[string]json.RawMessage
+
+	if json.Unmarshal(value, &object) != nil {
+		return value
+	}
+
+	if id, ok := object["value"]; ok {
+		return id
+	}
+
+	if id, ok := object["id"]; ok {
+		return id
+	}
+
+	return value
+}
+
+type byFieldKey FieldChanges
+
+func (s byFieldKey) Len() int           { return len(s) }
+func (s byFieldKey) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byFieldKey) Less(i, j int) bool { return s[i].Key < s[j].Key }
@@ -0,0 +1,81 @@
+package pipedrive
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		old, new string
+		want     []string
+	}{
+		{old: `{"a": 1, "b": "x"}`, new: `{"a": 1, "b": "x"}`, want: nil},
+		{old: `{"a": 1, "b": "x"}`, new: `{"b": "y", "a": 1.0}`, want: []string{"b"}},
+		{old: `{"a": {"id": 1, "name": "Ann"}}`, new: `{"a": {"name": "Ann", "id": 1}}`, want: nil},
+		{old: `{"a": 1}`, new: `{"b": 1}`, want: []string{"a", "b"}},
+		{old: `{"a": null}`, new: `{}`, want: nil},
+		{old: `{"c": [1, 2]}`, new: `{"c": [2, 1]}`, want: []string{"c"}},
+	}
+
+	for _, tt := range tests {
+		changes, err := Diff(json.RawMessage(tt.old), json.RawMessage(tt.new))
+
+		if err != nil {
+			t.Fatalf("Diff(%s, %s) returned error: %v", tt.old, tt.new, err)
+		}
+
+		var keys []string
+
+		for _, change := range changes {
+			keys = append(keys, change.Key)
+		}
+
+		if !reflect.DeepEqual(keys, tt.want) {
+			t.Errorf("Diff(%s, %s) changed %v, want %v", tt.old, tt.new, keys, tt.want)
+		}
+	}
+}
+
+func TestDiff_models(t *testing.T) {
+	old := &Deal{ID: 1, Title: "Deal", CustomFields: CustomFields{"abc": json.RawMessage(`"x"`)}}
+	new := &Deal{ID: 1, Title: "Deal", CustomFields: CustomFields{"abc": json.RawMessage(`"y"`)}}
+
+	changes, err := Diff(old, new)
+
+	if err != nil {
+		t.Fatalf("Diff returned error: %v", err)
+	}
+
+	if len(changes) != 1 || !changes.Changed("abc") || string(changes[0].Before) != `"x"` {
+		t.Errorf("Diff returned %+v, want the custom field abc changed", changes)
+	}
+
+	var none *Deal
+
+	if changes, _ := Diff(none, new); !changes.Changed("title") || string(changes[0].Before) != "null" {
+		t.Errorf("Diff of a nil deal returned %+v, want every field added", changes)
+	}
+}
+
+func TestFieldChanges_Payload(t *testing.T) {
+	tests := []struct {
+		after string
+		want  string
+	}{
+		{after: `"x"`, want: `"x"`},
+		{after: `{"id": 5, "name": "Ann"}`, want: `5`},
+		{after: `{"value": 7, "name": "Ann"}`, want: `7`},
+		{after: `{"name": "Ann"}`, want: `{"name": "Ann"}`},
+		{after: `null`, want: `null`},
+	}
+
+	for _, tt := range tests {
+		payload := FieldChanges{{Key: "k", After: json.RawMessage(tt.after)}}.Payload()
+
+		if got := string(payload["k"]); got != tt.want {
+			t.Errorf("Payload of %s returned %s, want %s", tt.after, got, tt.want)
+		}
+	}
+}
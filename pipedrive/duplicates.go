@@ -0,0 +1,583 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+const (
+	defaultDuplicateMinConfidence = 0.5
+	defaultDuplicateCandidates    = 20
+)
+
+// DuplicateMatch represents what a DuplicateRule compares.
+type DuplicateMatch string
+
+// DuplicateMatch constants.
+const (
+	// MatchEmail compares the email addresses of persons, ignoring case.
+	MatchEmail DuplicateMatch = "email"
+
+	// MatchPhone compares the digits of the phone numbers of persons.
+	MatchPhone DuplicateMatch = "phone"
+
+	// MatchName compares names, ignoring case and repeated spaces.
+	MatchName DuplicateMatch = "name"
+
+	// MatchDomain compares the domains of the email addresses of persons,
+	// or the domain of the website of organizations, see
+	// DuplicateRule.Field.
+	MatchDomain DuplicateMatch = "domain"
+)
+
+// DuplicateRule is a criterion for duplicates. Every value of a record,
+// such as each email address, is searched for, and the candidates found
+// match the rule when they have an equal value.
+type DuplicateRule struct {
+	Match DuplicateMatch
+
+	// Weight is the confidence that two records matching the rule are
+	// duplicates, between 0 and 1.
+	Weight float64
+
+	// Partial searches for partial matches of the values instead of exact
+	// ones, which finds phone numbers written differently. Candidates
+	// still need an equal normalized value.
+	Partial bool
+
+	// Field is the key of the custom field holding the website of
+	// organizations, for MatchDomain. The search results of organizations
+	// lack custom fields, so candidates found by the domain are not
+	// compared further.
+	Field string
+}
+
+// DefaultPersonDuplicateRules are used by PersonsService.FindDuplicates
+// when no rules are given.
+var DefaultPersonDuplicateRules = []DuplicateRule{
+	{Match: MatchEmail, Weight: 0.9},
+	{Match: MatchPhone, Weight: 0.6},
+	{Match: MatchName, Weight: 0.5},
+}
+
+// DefaultOrganizationDuplicateRules are used by
+// OrganizationsService.FindDuplicates when no rules are given.
+var DefaultOrganizationDuplicateRules = []DuplicateRule{
+	{Match: MatchName, Weight: 0.8},
+}
+
+// DuplicateOptions specifices the optional parameters to the
+// PersonsService.FindDuplicates and OrganizationsService.FindDuplicates
+// methods.
+type DuplicateOptions struct {
+	// Rules are the criteria for duplicates, the default rules of the
+	// entity when empty.
+	Rules []DuplicateRule
+
+	// MinConfidence is the confidence pairs need to be reported, 0.5 when
+	// zero.
+	MinConfidence float64
+
+	// MaxCandidates is the number of search results considered per value,
+	// 20 when zero. Common names find more candidates than are useful.
+	MaxCandidates uint
+}
+
+// DuplicatePair represents two records that are probably the same.
+// Confidence combines the weights of the rules they match: each rule
+// lowers the chance that they are different by its weight.
+type DuplicatePair struct {
+	IDs        [2]int
+	Confidence float64
+	Reasons    []DuplicateMatch
+}
+
+// DuplicateGroup represents records that are probably all the same, linked
+// by pairs. IDs are ordered, the first is the oldest record, which
+// MergeDuplicates keeps. Confidence is the lowest of the pairs linking the
+// group, review groups with a low confidence before merging them.
+type DuplicateGroup struct {
+	IDs        []int
+	Confidence float64
+	Pairs      []DuplicatePair
+}
+
+// FindDuplicates searches the persons of the account for duplicates of the
+// given persons, such as all persons of a list or those just imported, and
+// returns the groups of duplicates ordered by confidence.
+func (s *PersonsService) FindDuplicates(ctx context.Context, persons []Person, opt *DuplicateOptions) ([]DuplicateGroup, error) {
+	records := make([]duplicateRecord, len(persons))
+
+	for i, person := range persons {
+		records[i] = newDuplicateRecord(person.ID)
+
+		for _, email := range person.Email {
+			records[i].add(MatchEmail, email.Value)
+			records[i].add(MatchDomain, emailDomain(email.Value))
+		}
+
+		for _, phone := range person.Phone {
+			records[i].add(MatchPhone, phone.Value)
+		}
+
+		records[i].add(MatchName, person.Name)
+	}
+
+	finder := newDuplicateFinder(opt, DefaultPersonDuplicateRules)
+
+	return finder.find(ctx, records, func(rule DuplicateRule, term string) ([]SearchItem, error) {
+		field := string(rule.Match)
+
+		if rule.Match == MatchDomain {
+			field = string(MatchEmail)
+		}
+
+		result, _, err := s.Search(ctx, &PersonsSearchOptions{
+			Term:       term,
+			Fields:     Fields{field},
+			ExactMatch: !rule.Partial && rule.Match != MatchDomain,
+			Limit:      finder.candidates,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		return result.Data.Items, nil
+	}, personSearchValues)
+}
+
+// FindDuplicates searches the organizations of the account for duplicates
+// of the given organizations, see PersonsService.FindDuplicates.
+func (s *OrganizationsService) FindDuplicates(ctx context.Context, organizations []Organization, opt *DuplicateOptions) ([]DuplicateGroup, error) {
+	finder := newDuplicateFinder(opt, DefaultOrganizationDuplicateRules)
+	records := make([]duplicateRecord, len(organizations))
+
+	for i, organization := range organizations {
+		records[i] = newDuplicateRecord(organization.ID)
+
+		records[i].add(MatchName, organization.Name)
+
+		for _, rule := range finder.rules {
+			if rule.Match != MatchDomain || rule.Field == "" {
+				continue
+			}
+
+			if website, ok := organization.CustomFields.String(rule.Field); ok {
+				records[i].add(MatchDomain, websiteDomain(website))
+			}
+		}
+	}
+
+	return finder.find(ctx, records, func(rule DuplicateRule, term string) ([]SearchItem, error) {
+		field := string(rule.Match)
+
+		if rule.Match == MatchDomain {
+			field = "custom_fields"
+		}
+
+		result, _, err := s.Search(ctx, &OrganizationsSearchOptions{
+			Term:       term,
+			Fields:     Fields{field},
+			ExactMatch: !rule.Partial && rule.Match != MatchDomain,
+			Limit:      finder.candidates,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		return result.Data.Items, nil
+	}, organizationSearchValues)
+}
+
+// MergeDuplicates merges the other persons of the group into the first
+// one, whose data is kept on conflicts.
+func (s *PersonsService) MergeDuplicates(ctx context.Context, group DuplicateGroup) (*Response, error) {
+	var resp *Response
+
+	for i := 1; i < len(group.IDs); i++ {
+		var err error
+
+		if _, resp, err = s.Merge(ctx, group.IDs[i], group.IDs[0]); err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
+
+// MergeDuplicates merges the other organizations of the group into the
+// first one, whose data is kept on conflicts.
+func (s *OrganizationsService) MergeDuplicates(ctx context.Context, group DuplicateGroup) (*Response, error) {
+	var resp *Response
+
+	for i := 1; i < len(group.IDs); i++ {
+		var err error
+
+		if _, resp, err = s.Merge(ctx, group.IDs[i], group.IDs[0]); err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
+
+// duplicateRecord holds the values of a record by match, as written for
+// the search terms and normalized for comparisons.
+type duplicateRecord struct {
+	id     int
+	terms  map[DuplicateMatch][]string
+	values map[DuplicateMatch][]string
+}
+
+func newDuplicateRecord(id int) duplicateRecord {
+	return duplicateRecord{
+		id:     id,
+		terms:  make(map[DuplicateMatch][]string),
+		values: make(map[DuplicateMatch][]string),
+	}
+}
+
+func (r *duplicateRecord) add(match DuplicateMatch, value string) {
+	if normalized := normalizeDuplicateValue(match, value); normalized != "" && !r.has(match, normalized) {
+		r.terms[match] = append(r.terms[match], strings.TrimSpace(value))
+		r.values[match] = append(r.values[match], normalized)
+	}
+}
+
+func (r *duplicateRecord) has(match DuplicateMatch, value string) bool {
+	for _, v := range r.values[match] {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// duplicateSearch searches for a value of a rule.
+type duplicateSearch func(rule DuplicateRule, term string) ([]SearchItem, error)
+
+// searchValues returns the values of a search item for a match, false when
+// the search results do not hold them.
+type searchValues func(item map[string]interface{}, match DuplicateMatch) ([]string, bool)
+
+type duplicateFinder struct {
+	rules         []DuplicateRule
+	minConfidence float64
+	candidates    uint
+}
+
+func newDuplicateFinder(opt *DuplicateOptions, defaults []DuplicateRule) *duplicateFinder {
+	f := &duplicateFinder{
+		rules:         defaults,
+		minConfidence: defaultDuplicateMinConfidence,
+		candidates:    defaultDuplicateCandidates,
+	}
+
+	if opt == nil {
+		return f
+	}
+
+	if len(opt.Rules) > 0 {
+		f.rules = opt.Rules
+	}
+
+	if opt.MinConfidence > 0 {
+		f.minConfidence = opt.MinConfidence
+	}
+
+	if opt.MaxCandidates > 0 {
+		f.candidates = opt.MaxCandidates
+	}
+
+	return f
+}
+
+func (f *duplicateFinder) find(ctx context.Context, records []duplicateRecord, search duplicateSearch, values searchValues) ([]DuplicateGroup, error) {
+	pairs := make(map[[2]int]*DuplicatePair)
+	searched := make(map[string][]SearchItem)
+
+	for _, record := range records {
+		for _, rule := range f.rules {
+			for i, value := range record.values[rule.Match] {
+				if err := ctx.Err(); err != nil {
+					return nil, err
+				}
+
+				term := record.terms[rule.Match][i]
+				key := fmt.Sprint(rule.Match, rule.Partial, ":", term)
+				items, ok := searched[key]
+
+				if !ok {
+					var err error
+
+					if items, err = search(rule, term); err != nil {
+						return nil, err
+					}
+
+					searched[key] = items
+				}
+
+				for _, item := range items {
+					f.match(pairs, record, rule, value, item.Item, values)
+				}
+			}
+		}
+	}
+
+	return f.groups(pairs), nil
+}
+
+// match records that the record and a search item match a rule, when the
+// item has the searched value too.
+func (f *duplicateFinder) match(pairs map[[2]int]*DuplicatePair, record duplicateRecord, rule DuplicateRule, value string, item map[string]interface{}, values searchValues) {
+	id, ok := item["id"].(float64)
+
+	if !ok || int(id) == record.id {
+		return
+	}
+
+	if found, ok := values(item, rule.Match); ok {
+		candidate := newDuplicateRecord(int(id))
+
+		for _, v := range found {
+			candidate.add(rule.Match, v)
+		}
+
+		if !candidate.has(rule.Match, value) {
+			return
+		}
+	}
+
+	ids := [2]int{record.id, int(id)}
+
+	if ids[0] > ids[1] {
+		ids[0], ids[1] = ids[1], ids[0]
+	}
+
+	pair, ok := pairs[ids]
+
+	if !ok {
+		pair = &DuplicatePair{IDs: ids}
+		pairs[ids] = pair
+	}
+
+	for _, reason := range pair.Reasons {
+		if reason == rule.Match {
+			return
+		}
+	}
+
+	pair.Reasons = append(pair.Reasons, rule.Match)
+	pair.Confidence = 1 - (1-pair.Confidence)*(1-rule.Weight)
+}
+
+// groups links the pairs with enough confidence into groups.
+func (f *duplicateFinder) groups(pairs map[[2]int]*DuplicatePair) []DuplicateGroup {
+	parent := make(map[int]int)
+
+	var root func(id int) int
+
+	root = func(id int) int {
+		p, ok := parent[id]
+
+		if !ok || p == id {
+			return id
+		}
+
+		parent[id] = root(p)
+
+		return parent[id]
+	}
+
+	var linked duplicatePairs
+
+	for _, pair := range pairs {
+		if pair.Confidence >= f.minConfidence {
+			linked = append(linked, *pair)
+			parent[pair.IDs[0]] = pair.IDs[0]
+			parent[pair.IDs[1]] = pair.IDs[1]
+		}
+	}
+
+	sort.Sort(linked)
+
+	for _, pair := range linked {
+		a, b := root(pair.IDs[0]), root(pair.IDs[1])
+
+		if a > b {
+			a, b = b, a
+		}
+
+		parent[b] = a
+	}
+
+	byRoot := make(map[int]*DuplicateGroup)
+
+	var groups duplicateGroups
+
+	for _, pair := range linked {
+		r := root(pair.IDs[0])
+		group, ok := byRoot[r]
+
+		if !ok {
+			groups = append(groups, &DuplicateGroup{Confidence: pair.Confidence})
+			group = groups[len(groups)-1]
+			byRoot[r] = group
+		}
+
+		group.Pairs = append(group.Pairs, pair)
+
+		if pair.Confidence < group.Confidence {
+			group.Confidence = pair.Confidence
+		}
+	}
+
+	for id := range parent {
+		if group, ok := byRoot[root(id)]; ok {
+			group.IDs = append(group.IDs, id)
+		}
+	}
+
+	sort.Sort(groups)
+	result := make([]DuplicateGroup, len(groups))
+
+	for i, group := range groups {
+		sort.Ints(group.IDs)
+		result[i] = *group
+	}
+
+	return result
+}
+
+// duplicatePairs orders pairs by their IDs.
+type duplicatePairs []DuplicatePair
+
+func (p duplicatePairs) Len() int { return len(p) }
+
+func (p duplicatePairs) Less(i, j int) bool {
+	if p[i].IDs[0] != p[j].IDs[0] {
+		return p[i].IDs[0] < p[j].IDs[0]
+	}
+
+	return p[i].IDs[1] < p[j].IDs[1]
+}
+
+func (p duplicatePairs) Swap(i, j int) { p[i], p[j] = p[j], p[i] }
+
+// duplicateGroups orders groups by descending confidence.
+type duplicateGroups []*DuplicateGroup
+
+func (g duplicateGroups) Len() int { return len(g) }
+
+func (g duplicateGroups) Less(i, j int) bool { return g[i].Confidence > g[j].Confidence }
+
+func (g duplicateGroups) Swap(i, j int) { g[i], g[j] = g[j], g[i] }
+
+// personSearchValues returns the values of a person search item.
+func personSearchValues(item map[string]interface{}, match DuplicateMatch) ([]string, bool) {
+	switch match {
+	case MatchEmail:
+		return searchItemStrings(item["emails"]), true
+	case MatchPhone:
+		return searchItemStrings(item["phones"]), true
+	case MatchName:
+		return searchItemStrings(item["name"]), true
+	case MatchDomain:
+		var domains []string
+
+		for _, email := range searchItemStrings(item["emails"]) {
+			domains = append(domains, emailDomain(email))
+		}
+
+		return domains, true
+	}
+
+	return nil, false
+}
+
+// organizationSearchValues returns the values of an organization search
+// item, which lacks the website.
+func organizationSearchValues(item map[string]interface{}, match DuplicateMatch) ([]string, bool) {
+	if match == MatchName {
+		return searchItemStrings(item["name"]), true
+	}
+
+	return nil, false
+}
+
+// searchItemStrings returns a string or the strings of a list.
+func searchItemStrings(value interface{}) []string {
+	switch value := value.(type) {
+	case string:
+		return []string{value}
+	case []interface{}:
+		var values []string
+
+		for _, v := range value {
+			if s, ok := v.(string); ok {
+				values = append(values, s)
+			}
+		}
+
+		return values
+	}
+
+	return nil
+}
+
+// normalizeDuplicateValue returns the form values are compared in, empty
+// for values too short to search for.
+func normalizeDuplicateValue(match DuplicateMatch, value string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	switch match {
+	case MatchPhone:
+		value = strings.Map(func(r rune) rune {
+			if r < '0' || r > '9' {
+				return -1
+			}
+
+			return r
+		}, value)
+	case MatchName:
+		value = strings.Join(strings.Fields(value), " ")
+	}
+
+	if len(value) < 2 {
+		return ""
+	}
+
+	return value
+}
+
+// emailDomain returns the domain of an email address.
+func emailDomain(email string) string {
+	if at := strings.LastIndex(email, "@"); at >= 0 {
+		return email[at+1:]
+	}
+
+	return ""
+}
+
+// websiteDomain returns the domain of a website, without www.
+func websiteDomain(website string) string {
+	website = strings.TrimSpace(website)
+
+	if !strings.Contains(website, "://") {
+		website = "http://" + website
+	}
+
+	u, err := url.Parse(website)
+
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimPrefix(strings.ToLower(u.Host), "www.")
+}
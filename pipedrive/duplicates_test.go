@@ -0,0 +1,135 @@
+package pipedrive
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeDuplicateValue(t *testing.T) {
+	tests := []struct {
+		match DuplicateMatch
+		value string
+		want  string
+	}{
+		{match: MatchEmail, value: " Ann@Example.com ", want: "ann@example.com"},
+		{match: MatchPhone, value: "+372 (555) 12-34", want: "3725551234"},
+		{match: MatchPhone, value: "ext. 1", want: ""},
+		{match: MatchName, value: "  Ann   Smith ", want: "ann smith"},
+		{match: MatchName, value: "A", want: ""},
+		{match: MatchDomain, value: "Example.COM", want: "example.com"},
+	}
+
+	for _, tt := range tests {
+		if got := normalizeDuplicateValue(tt.match, tt.value); got != tt.want {
+			t.Errorf("normalizeDuplicateValue(%v, %q) returned %q, want %q", tt.match, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestWebsiteDomain(t *testing.T) {
+	tests := []struct {
+		website string
+		want    string
+	}{
+		{website: "https://www.Example.com/about", want: "example.com"},
+		{website: "example.com", want: "example.com"},
+		{website: " shop.example.com:8080 ", want: "shop.example.com:8080"},
+		{website: "http://[::1", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := websiteDomain(tt.website); got != tt.want {
+			t.Errorf("websiteDomain(%q) returned %q, want %q", tt.website, got, tt.want)
+		}
+	}
+}
+
+// duplicatePerson returns a duplicate record of a person and the search item it is
+// found as.
+func duplicatePerson(id int, name, email, phone string) (duplicateRecord, SearchItem) {
+	record := newDuplicateRecord(id)
+	record.add(MatchEmail, email)
+	record.add(MatchPhone, phone)
+	record.add(MatchName, name)
+
+	item := map[string]interface{}{
+		"id":     float64(id),
+		"name":   name,
+		"emails": []interface{}{email},
+		"phones": []interface{}{phone},
+	}
+
+	return record, SearchItem{Item: item}
+}
+
+func TestDuplicateFinder_find(t *testing.T) {
+	ann, annItem := duplicatePerson(1, "Ann Smith", "ann@example.com", "555 1234")
+	annCopy, annCopyItem := duplicatePerson(2, "ann  smith", "ANN@example.com", "5551234")
+	_, annTypoItem := duplicatePerson(3, "Ann Smyth", "ann@example.com", "")
+	bob, bobItem := duplicatePerson(4, "Ann Smith", "bob@example.com", "")
+
+	// The search finds everyone, the finder compares the values.
+	search := func(rule DuplicateRule, term string) ([]SearchItem, error) {
+		return []SearchItem{annItem, annCopyItem, annTypoItem, bobItem}, nil
+	}
+
+	tests := []struct {
+		name    string
+		records []duplicateRecord
+		opt     *DuplicateOptions
+		want    [][]int
+		conf    []float64
+	}{
+		{
+			name:    "all rules",
+			records: []duplicateRecord{ann, annCopy},
+			want:    [][]int{{1, 2, 3, 4}},
+			conf:    []float64{0.5},
+		},
+		{
+			name:    "email only",
+			records: []duplicateRecord{ann},
+			opt:     &DuplicateOptions{Rules: []DuplicateRule{{Match: MatchEmail, Weight: 0.9}}},
+			want:    [][]int{{1, 2, 3}},
+			conf:    []float64{0.9},
+		},
+		{
+			name:    "name below the confidence",
+			records: []duplicateRecord{bob},
+			opt:     &DuplicateOptions{MinConfidence: 0.6},
+			want:    nil,
+		},
+		{
+			name:    "email and phone combined",
+			records: []duplicateRecord{annCopy},
+			opt:     &DuplicateOptions{MinConfidence: 0.95},
+			want:    [][]int{{1, 2}},
+			conf:    []float64{1 - 0.1*0.4*0.5},
+		},
+	}
+
+	for _, tt := range tests {
+		finder := newDuplicateFinder(tt.opt, DefaultPersonDuplicateRules)
+		groups, err := finder.find(context.Background(), tt.records, search, personSearchValues)
+
+		if err != nil {
+			t.Fatalf("find of %v returned error: %v", tt.name, err)
+		}
+
+		var ids [][]int
+
+		for i, group := range groups {
+			ids = append(ids, group.IDs)
+
+			if math.Abs(group.Confidence-tt.conf[i]) > 1e-9 {
+				t.Errorf("find of %v returned group %v with confidence %v, want %v", tt.name, group.IDs, group.Confidence, tt.conf[i])
+			}
+		}
+
+		if !reflect.DeepEqual(ids, tt.want) {
+			t.Errorf("find of %v returned groups %v, want %v", tt.name, ids, tt.want)
+		}
+	}
+}
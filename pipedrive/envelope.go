@@ -0,0 +1,196 @@
+package pipedrive
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+)
+
+// modelDecoder is implemented by models that decode parts of their JSON
+// themselves, such as the custom fields of deals. Their UnmarshalJSON
+// methods decode with encoding/json, the client with its Codec.
+type modelDecoder interface {
+	decode(codec Codec, data []byte) error
+}
+
+var modelDecoderType = reflect.TypeOf((*modelDecoder)(nil)).Elem()
+
+// unmarshal decodes a response body into v with the codec of the client.
+//
+// The members of response envelopes, structs with a Data field, are split
+// with encoding/json first. Pipedrive returns false, an empty string or an
+// empty array or object of the wrong shape for some empty results; these
+// are decoded as if data was null, leaving Data at its zero value. The models in the
+// data are decoded with the codec as well, and the related objects are
+// decoded into the AdditionalData field, when v has one.
+func (c *Client) unmarshal(data []byte, v interface{}) error {
+	t := reflect.TypeOf(v)
+
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t == nil || t.Kind() != reflect.Struct {
+		return c.Codec.unmarshal(data, v)
+	}
+
+	field, ok := t.FieldByName("Data")
+
+	var members map[string]json.RawMessage
+
+	if !ok || json.Unmarshal(data, &members) != nil || members == nil {
+		return c.Codec.unmarshal(data, v)
+	}
+
+	models := members["data"]
+	empty := isEmptyData(models, field.Type.Kind())
+
+	if empty || !decodesModels(field.Type) {
+		models = nil
+	}
+
+	body := data
+
+	if empty || models != nil {
+		// Data is left at its zero value or decoded with the models below.
+		members["data"] = json.RawMessage("null")
+
+		envelope, err := json.Marshal(members)
+
+		if err != nil {
+			return err
+		}
+
+		body = envelope
+	}
+
+	related := members["related_objects"]
+
+	if err := c.Codec.unmarshal(body, v); err != nil {
+		return err
+	}
+
+	envelope := reflect.ValueOf(v)
+
+	for envelope.Kind() == reflect.Ptr {
+		if envelope.IsNil() {
+			return nil
+		}
+
+		envelope = envelope.Elem()
+	}
+
+	if models != nil {
+		if err := c.decodeModels(models, envelope.FieldByIndex(field.Index)); err != nil {
+			return err
+		}
+	}
+
+	c.decodeRelatedObjects(related, envelope)
+
+	return nil
+}
+
+// decodesModels reports whether values of type t, or the elements of a
+// slice of type t, decode themselves.
+func decodesModels(t reflect.Type) bool {
+	if t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return reflect.PtrTo(t).Implements(modelDecoderType)
+}
+
+// decodeModels decodes data into v, a model or a slice of models.
+func (c *Client) decodeModels(data []byte, v reflect.Value) error {
+	if isNull(data) {
+		return nil
+	}
+
+	if v.Kind() != reflect.Slice {
+		return c.decodeModel(data, v)
+	}
+
+	var elements []json.RawMessage
+
+	if json.Unmarshal(data, &elements) != nil {
+		// Let the codec report what is wrong.
+		return c.Codec.unmarshal(data, v.Addr().Interface())
+	}
+
+	models := reflect.MakeSlice(v.Type(), len(elements), len(elements))
+
+	for i, element := range elements {
+		if err := c.decodeModel(element, models.Index(i)); err != nil {
+			return err
+		}
+	}
+
+	v.Set(models)
+
+	return nil
+}
+
+// decodeModel decodes data into v, a model or a pointer to one.
+func (c *Client) decodeModel(data []byte, v reflect.Value) error {
+	if isNull(data) {
+		return nil
+	}
+
+	if v.Kind() == reflect.Ptr {
+		v.Set(reflect.New(v.Type().Elem()))
+		v = v.Elem()
+	}
+
+	return v.Addr().Interface().(modelDecoder).decode(c.Codec, data)
+}
+
+// decodeRelatedObjects decodes the related objects into the AdditionalData
+// field of the envelope. They only help to show names, so related objects
+// of an unexpected shape are left out rather than failing the response.
+func (c *Client) decodeRelatedObjects(data []byte, envelope reflect.Value) {
+	if len(data) == 0 || isEmptyData(data, reflect.Struct) {
+		return
+	}
+
+	field := envelope.FieldByName("AdditionalData")
+
+	if !field.IsValid() || field.Type() != reflect.TypeOf(AdditionalData{}) {
+		return
+	}
+
+	var related RelatedObjects
+
+	if c.Codec.unmarshal(data, &related) == nil {
+		field.Addr().Interface().(*AdditionalData).RelatedObjects = related
+	}
+}
+
+// copyRaw returns a copy of data, which the decoder may reuse.
+func copyRaw(data []byte) json.RawMessage {
+	raw := make(json.RawMessage, len(data))
+	copy(raw, data)
+
+	return raw
+}
+
+// isEmptyData reports whether raw is one of Pipedrive's placeholders for
+// an empty value of the given kind.
+func isEmptyData(raw json.RawMessage, kind reflect.Kind) bool {
+	raw = bytes.TrimSpace(raw)
+
+	switch string(raw) {
+	case "false", `""`:
+		return kind != reflect.Bool && kind != reflect.String
+	case "[]":
+		return kind != reflect.Slice && kind != reflect.Array
+	case "{}":
+		return kind == reflect.Slice || kind == reflect.Array
+	}
+
+	return false
+}
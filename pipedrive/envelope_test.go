@@ -0,0 +1,129 @@
+package pipedrive
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestClient_Do_emptyDataPlaceholders(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	// Strict decoding reports the errors of decoding.
+	client.StrictDecoding = true
+	body := `{"success": true, "data": false}`
+
+	mux.HandleFunc("/v1/deals/1", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, body)
+	})
+
+	for _, placeholder := range []string{`false`, `""`, `[]`} {
+		body = `{"success": true, "data": ` + placeholder + `}`
+
+		req, err := client.NewRequest(http.MethodGet, "/deals/1", nil, nil)
+
+		if err != nil {
+			t.Fatalf("NewRequest returned error: %v", err)
+		}
+
+		var record *DealResponse
+
+		if _, err := client.Do(context.Background(), req, &record); err != nil {
+			t.Errorf("Do with data %v returned error: %v", placeholder, err)
+		}
+
+		if record == nil || !record.Success || record.Data.ID != 0 {
+			t.Errorf("Do with data %v returned %+v, want a successful response with empty data", placeholder, record)
+		}
+	}
+}
+
+func TestClient_Do_emptyListPlaceholder(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	client.StrictDecoding = true
+
+	mux.HandleFunc("/v1/deals", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"success": true, "data": {}, "additional_data": {"pagination": {"limit": 10}}}`)
+	})
+
+	record, _, err := client.Deals.List(context.Background(), nil)
+
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+
+	if len(record.Data) != 0 || record.AdditionalData.Pagination.Limit != 10 {
+		t.Errorf("List returned %+v, want no deals and the pagination", record)
+	}
+}
+
+func TestClient_Do_relatedObjects(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/deals", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		writeJSON(w, http.StatusOK, `{
+			"success": true,
+			"data": [{"id": 1, "title": "Deal {1}", "user_id": {"id": 7}}],
+			"related_objects": {"user": {"7": {"id": 7, "name": "Jane \"JD\" Doe"}}}
+		}`)
+	})
+
+	record, _, err := client.Deals.List(context.Background(), nil)
+
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+
+	if len(record.Data) != 1 || record.Data[0].Title != "Deal {1}" {
+		t.Errorf("List returned %+v, want deal 1", record.Data)
+	}
+
+	if user, ok := record.AdditionalData.RelatedObjects.User(7); !ok || user.Name != `Jane "JD" Doe` {
+		t.Errorf("RelatedObjects.User(7) = %+v, %v, want Jane \"JD\" Doe", user, ok)
+	}
+}
+
+func TestClient_Do_malformedEnvelope(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	client.StrictDecoding = true
+
+	var body string
+
+	mux.HandleFunc("/v1/notes/1", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, body)
+	})
+
+	for _, body = range []string{
+		`{"success": true, "data": {"id": 1}`,
+		`{"success": true, "data": {"id": "x"}}`,
+		`{"success": true, "data": [1]}`,
+		`{"success": true, "data": {"id": 1}} x`,
+		`[{"id": 1}]`,
+	} {
+		if _, _, err := client.Notes.GetByID(context.Background(), 1); err == nil {
+			t.Errorf("GetByID of %q returned no error", body)
+		}
+	}
+}
+
+func TestClient_Do_malformedModels(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	client.StrictDecoding = true
+
+	mux.HandleFunc("/v1/deals", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"success": true, "data": [{"id": 1}, {"id": "x"}]}`)
+	})
+
+	if _, _, err := client.Deals.List(context.Background(), nil); err == nil {
+		t.Error("List returned no error for a malformed deal")
+	}
+}
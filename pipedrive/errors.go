@@ -15,8 +15,8 @@ type RateLimitError struct {
 
 func (e *RateLimitError) Error() string {
 	return fmt.Sprintf("%v %v: %d %v",
-		e.Response.Request.Method, e.Response.Request.URL,
-		e.Response.StatusCode, e.Message)
+		e.Response.Request.Method, DefaultRedactor.URL(e.Response.Request.URL),
+		e.Response.StatusCode, DefaultRedactor.String(e.Message))
 }
 
 // ErrorResponse reports one or more errors caused by an API request.
@@ -27,6 +27,58 @@ type ErrorResponse struct {
 
 func (e *ErrorResponse) Error() string {
 	return fmt.Sprintf("%v %v: %d %v",
-		e.Response.Request.Method, e.Response.Request.URL,
-		e.Response.StatusCode, e.Message)
+		e.Response.Request.Method, DefaultRedactor.URL(e.Response.Request.URL),
+		e.Response.StatusCode, DefaultRedactor.String(e.Message))
+}
+
+// BatchFailure represents an item of a batch operation that failed.
+type BatchFailure struct {
+	// Index of the item in the batch.
+	Index int
+
+	// ID of the entity of the item, 0 when it is not known, for example
+	// when creating it failed.
+	ID  int
+	Err error
+}
+
+// BatchError reports the items of a batch operation that failed while the
+// others were still performed.
+type BatchError struct {
+	// Total is the number of items in the batch.
+	Total    int
+	Failures []BatchFailure
+}
+
+func (e *BatchError) Error() string {
+	if len(e.Failures) == 0 {
+		return "batch failed"
+	}
+
+	first := e.Failures[0]
+
+	return fmt.Sprintf("%d of %d batch items failed, first at index %d: %v",
+		len(e.Failures), e.Total, first.Index, first.Err)
+}
+
+// Unwrap returns the errors of the failed items, so errors.Is and errors.As
+// find them from Go 1.20 on.
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, len(e.Failures))
+
+	for i, failure := range e.Failures {
+		errs[i] = failure.Err
+	}
+
+	return errs
+}
+
+// batchError returns a *BatchError for the failures, nil when there are
+// none.
+func batchError(total int, failures []BatchFailure) error {
+	if len(failures) == 0 {
+		return nil
+	}
+
+	return &BatchError{Total: total, Failures: failures}
 }
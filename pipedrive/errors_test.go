@@ -0,0 +1,28 @@
+package pipedrive
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBatchError_Error(t *testing.T) {
+	tests := []struct {
+		err  *BatchError
+		want string
+	}{
+		{
+			err:  &BatchError{Total: 3, Failures: []BatchFailure{{Index: 1, Err: errors.New("failed")}, {Index: 2, Err: errors.New("other")}}},
+			want: "2 of 3 batch items failed, first at index 1: failed",
+		},
+		{
+			err:  &BatchError{Total: 3},
+			want: "batch failed",
+		},
+	}
+
+	for _, tt := range tests {
+		if got := tt.err.Error(); got != tt.want {
+			t.Errorf("Error returned %q, want %q", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package pipedrive
+
+//go:generate go run gen-events.go
+
+// EventAction represents the action of a webhook event.
+type EventAction string
+
+// Valid reports whether a is an action webhooks can subscribe to.
+func (a EventAction) Valid() bool {
+	return eventActions[a]
+}
+
+// EventObject represents the object of a webhook event.
+type EventObject string
+
+// Valid reports whether o is an object webhooks can subscribe to.
+func (o EventObject) Valid() bool {
+	return eventObjects[o]
+}
+
+// mergeableObjects holds the objects with merged events.
+var mergeableObjects = map[EventObject]bool{
+	OBJECT_DEAL:         true,
+	OBJECT_ORGANIZATION: true,
+	OBJECT_PERSON:       true,
+	OBJECT_ALL:          true,
+}
+
+// ValidFor reports whether webhooks can subscribe to action a on object o.
+// Only deals, organizations and persons are merged.
+func (a EventAction) ValidFor(o EventObject) bool {
+	if !a.Valid() || !o.Valid() {
+		return false
+	}
+
+	return a != ACTION_MERGED || mergeableObjects[o]
+}
+
+const (
+	// Deprecated: use OBJECT_ACTIVITY_TYPE instead.
+	OBJECT_ACTIVTIY_TYPE = OBJECT_ACTIVITY_TYPE
+
+	// Deprecated: use OBJECT_ALL instead.
+	OBJECT_ALL_ = OBJECT_ALL
+)
@@ -0,0 +1,312 @@
+package pipedrive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultFieldRegistryTTL = 10 * time.Minute
+
+	// fieldMissRefreshInterval is how old cached fields must be before a
+	// lookup of an unknown field reloads them, so lookups of fields that
+	// do not exist cannot cause a reload each.
+	fieldMissRefreshInterval = 10 * time.Second
+)
+
+// FieldEntity represents an entity with fields.
+type FieldEntity string
+
+// FieldEntity constants.
+const (
+	FieldEntityDeal         FieldEntity = "deal"
+	FieldEntityPerson       FieldEntity = "person"
+	FieldEntityOrganization FieldEntity = "organization"
+	FieldEntityProduct      FieldEntity = "product"
+	FieldEntityActivity     FieldEntity = "activity"
+	FieldEntityNote         FieldEntity = "note"
+)
+
+// FieldDefinition represents a field of an entity, as returned by the
+// fields endpoints such as DealFieldsService.List.
+type FieldDefinition struct {
+	ID            int           `json:"id"`
+	Key           string        `json:"key"`
+	Name          string        `json:"name"`
+	FieldType     FieldType     `json:"field_type"`
+	MandatoryFlag bool          `json:"mandatory_flag"`
+	EditFlag      bool          `json:"edit_flag"`
+	Options       []FieldOption `json:"options,omitempty"`
+}
+
+// IsCustom reports whether the field is a custom field.
+func (f FieldDefinition) IsCustom() bool {
+	return len(f.Key) == customFieldKeyLength
+}
+
+// Option returns the option with the given label, compared
+// case-insensitively, or ID.
+func (f FieldDefinition) Option(labelOrID string) (FieldOption, bool) {
+	for _, option := range f.Options {
+		if strings.EqualFold(option.Label, labelOrID) || option.ID == labelOrID {
+			return option, true
+		}
+	}
+
+	return FieldOption{}, false
+}
+
+// FieldOption represents an option of an enum or set field. The IDs of
+// custom field options are numbers, those of some built-in fields, such as
+// the deal status, are strings. Both are held as strings.
+type FieldOption struct {
+	ID    string
+	Label string
+
+	// Color is set for the options of label fields.
+	Color string
+}
+
+// UnmarshalJSON decodes an option with a numeric or string ID.
+func (o *FieldOption) UnmarshalJSON(data []byte) error {
+	var option struct {
+		ID    json.RawMessage `json:"id"`
+		Label string          `json:"label"`
+		Color string          `json:"color"`
+	}
+
+	if err := json.Unmarshal(data, &option); err != nil {
+		return err
+	}
+
+	var id string
+
+	if err := json.Unmarshal(option.ID, &id); err != nil {
+		id = string(option.ID)
+	}
+
+	o.ID = id
+	o.Label = option.Label
+	o.Color = option.Color
+
+	return nil
+}
+
+// FieldNotFoundError is returned by FieldRegistry.Lookup for a field that
+// does not exist, even after reloading the fields.
+type FieldNotFoundError struct {
+	Entity FieldEntity
+	Field  string
+}
+
+func (e *FieldNotFoundError) Error() string {
+	return fmt.Sprintf("%v field %q not found", e.Entity, e.Field)
+}
+
+// FieldRegistry caches the field definitions of the entities, for
+// resolving custom fields by name without a request each time.
+type FieldRegistry struct {
+	client *Client
+
+	// TTL is how long field definitions are cached, 10 minutes when zero.
+	TTL time.Duration
+
+	mu      sync.Mutex
+	entries map[FieldEntity]*fieldEntry
+}
+
+type fieldEntry struct {
+	mu     sync.Mutex
+	fields []FieldDefinition
+	loaded time.Time
+}
+
+// NewFieldRegistry returns an empty FieldRegistry, fields are loaded when
+// first needed.
+func (c *Client) NewFieldRegistry() *FieldRegistry {
+	return &FieldRegistry{
+		client:  c,
+		entries: make(map[FieldEntity]*fieldEntry),
+	}
+}
+
+// Fields returns the fields of an entity, loading them when they are not
+// cached or expired.
+func (r *FieldRegistry) Fields(ctx context.Context, entity FieldEntity) ([]FieldDefinition, error) {
+	entry := r.entry(entity)
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if entry.loaded.IsZero() || time.Since(entry.loaded) > r.ttl() {
+		if err := r.load(ctx, entity, entry); err != nil {
+			return nil, err
+		}
+	}
+
+	return entry.fields, nil
+}
+
+// Lookup returns the field of an entity with the given key or, failing
+// that, name, compared case-insensitively. When the field is not cached,
+// for example because it was just created, the fields are reloaded once.
+// A field that still is not found is reported with a *FieldNotFoundError.
+func (r *FieldRegistry) Lookup(ctx context.Context, entity FieldEntity, keyOrName string) (FieldDefinition, error) {
+	fields, err := r.Fields(ctx, entity)
+
+	if err != nil {
+		return FieldDefinition{}, err
+	}
+
+	if field, ok := findField(fields, keyOrName); ok {
+		return field, nil
+	}
+
+	entry := r.entry(entity)
+
+	entry.mu.Lock()
+
+	if time.Since(entry.loaded) > fieldMissRefreshInterval {
+		err = r.load(ctx, entity, entry)
+	}
+
+	fields = entry.fields
+	entry.mu.Unlock()
+
+	if err != nil {
+		return FieldDefinition{}, err
+	}
+
+	if field, ok := findField(fields, keyOrName); ok {
+		return field, nil
+	}
+
+	return FieldDefinition{}, &FieldNotFoundError{Entity: entity, Field: keyOrName}
+}
+
+// Refresh reloads the fields of the given entities, of all cached
+// entities when none are given.
+func (r *FieldRegistry) Refresh(ctx context.Context, entities ...FieldEntity) error {
+	if len(entities) == 0 {
+		r.mu.Lock()
+
+		for entity := range r.entries {
+			entities = append(entities, entity)
+		}
+
+		r.mu.Unlock()
+	}
+
+	for _, entity := range entities {
+		entry := r.entry(entity)
+
+		entry.mu.Lock()
+		err := r.load(ctx, entity, entry)
+		entry.mu.Unlock()
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Invalidate drops the cached fields of an entity, they are loaded again
+// when next needed.
+func (r *FieldRegistry) Invalidate(entity FieldEntity) {
+	r.mu.Lock()
+	delete(r.entries, entity)
+	r.mu.Unlock()
+}
+
+func (r *FieldRegistry) ttl() time.Duration {
+	if r.TTL > 0 {
+		return r.TTL
+	}
+
+	return defaultFieldRegistryTTL
+}
+
+func (r *FieldRegistry) entry(entity FieldEntity) *fieldEntry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entry, ok := r.entries[entity]
+
+	if !ok {
+		entry = &fieldEntry{}
+		r.entries[entity] = entry
+	}
+
+	return entry
+}
+
+// load loads all fields of an entity into entry, which must be locked.
+func (r *FieldRegistry) load(ctx context.Context, entity FieldEntity, entry *fieldEntry) error {
+	opt := &struct {
+		Start uint `url:"start,omitempty"`
+		Limit uint `url:"limit,omitempty"`
+	}{Limit: listAllPageLimit}
+
+	path := "/" + string(entity) + "Fields"
+	pager := r.client.NewPager(path, 0)
+
+	var fields []FieldDefinition
+
+	for {
+		opt.Start = pager.Start
+		req, err := r.client.NewRequest(http.MethodGet, path, opt, nil)
+
+		if err != nil {
+			return err
+		}
+
+		var page struct {
+			Data           []FieldDefinition `json:"data"`
+			AdditionalData AdditionalData    `json:"additional_data"`
+		}
+
+		if _, err := r.client.Do(ctx, req, &page); err != nil {
+			return err
+		}
+
+		fields = append(fields, page.Data...)
+
+		more, err := pager.Next(page.AdditionalData.Pagination, len(page.Data))
+
+		if err != nil {
+			return err
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	entry.fields = fields
+	entry.loaded = time.Now()
+
+	return nil
+}
+
+func findField(fields []FieldDefinition, keyOrName string) (FieldDefinition, bool) {
+	for _, field := range fields {
+		if field.Key == keyOrName {
+			return field, true
+		}
+	}
+
+	for _, field := range fields {
+		if strings.EqualFold(field.Name, keyOrName) {
+			return field, true
+		}
+	}
+
+	return FieldDefinition{}, false
+}
@@ -0,0 +1,214 @@
+package pipedrive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// fieldEntityResources maps the resources whose payloads can be validated
+// to their entities.
+var fieldEntityResources = map[string]FieldEntity{
+	"deals":         FieldEntityDeal,
+	"persons":       FieldEntityPerson,
+	"organizations": FieldEntityOrganization,
+	"products":      FieldEntityProduct,
+	"activities":    FieldEntityActivity,
+	"notes":         FieldEntityNote,
+}
+
+// ValidatePayload checks a create or update payload of an entity against
+// its field definitions: on create the mandatory custom fields must be
+// set, and the values of enum, set, monetary, numeric and date fields must
+// be valid options, numbers and dates. Keys without a field definition are
+// not checked. The error is a *ValidationError.
+func (r *FieldRegistry) ValidatePayload(ctx context.Context, entity FieldEntity, payload interface{}, create bool) error {
+	data, err := json.Marshal(payload)
+
+	if err != nil {
+		return err
+	}
+
+	var values map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	fields, err := r.Fields(ctx, entity)
+
+	if err != nil {
+		return err
+	}
+
+	for _, field := range fields {
+		value, ok := values[field.Key]
+
+		if create && field.MandatoryFlag && field.IsCustom() && (!ok || isNull(value)) {
+			return requiredError(field.Name)
+		}
+
+		if ok && !isNull(value) {
+			if err := validateFieldValue(field, value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateFieldValue(field FieldDefinition, value json.RawMessage) error {
+	switch field.FieldType {
+	case FieldTypeEnum:
+		if id, ok := rawScalar(value); !ok || !hasOption(field, id) {
+			return enumError(field.Name, string(value))
+		}
+	case FieldTypeSet:
+		for _, id := range rawList(value) {
+			if !hasOption(field, id) {
+				return enumError(field.Name, id)
+			}
+		}
+	case FieldTypeMonetary, FieldTypeDouble, FieldTypeInt:
+		number, ok := rawScalar(value)
+
+		if _, err := strconv.ParseFloat(number, 64); !ok || (err != nil && number != "") {
+			return &ValidationError{Field: field.Name, Message: fmt.Sprintf("%s is not a number", value)}
+		}
+	case FieldTypeDate:
+		date, ok := rawScalar(value)
+
+		if _, err := time.Parse("2006-01-02", date); !ok || (err != nil && date != "") {
+			return &ValidationError{Field: field.Name, Message: fmt.Sprintf("%s is not a date of the form YYYY-MM-DD", value)}
+		}
+	}
+
+	return nil
+}
+
+func hasOption(field FieldDefinition, id string) bool {
+	for _, option := range field.Options {
+		if option.ID == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isNull(value json.RawMessage) bool {
+	return string(bytes.TrimSpace(value)) == "null"
+}
+
+// rawScalar returns a JSON string or number as text.
+func rawScalar(value json.RawMessage) (string, bool) {
+	var s string
+
+	if json.Unmarshal(value, &s) == nil {
+		return s, true
+	}
+
+	var number json.Number
+
+	if json.Unmarshal(value, &number) == nil {
+		return number.String(), true
+	}
+
+	return "", false
+}
+
+// rawList returns the items of a JSON array or comma separated string.
+func rawList(value json.RawMessage) []string {
+	var items []json.RawMessage
+
+	if json.Unmarshal(value, &items) == nil {
+		list := make([]string, 0, len(items))
+
+		for _, item := range items {
+			s, _ := rawScalar(item)
+			list = append(list, s)
+		}
+
+		return list
+	}
+
+	s, _ := rawScalar(value)
+
+	var list []string
+
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+
+	return list
+}
+
+// validateRequestFields validates the JSON body of create and update
+// requests for entities with fields, see Client.FieldValidation.
+func (c *Client) validateRequestFields(ctx context.Context, request *http.Request) error {
+	create := request.Method == http.MethodPost
+
+	if !create && request.Method != http.MethodPut && request.Method != http.MethodPatch {
+		return nil
+	}
+
+	if request.Body == nil || !strings.HasPrefix(request.Header.Get("Content-Type"), "application/json") {
+		return nil
+	}
+
+	entity, ok := requestFieldEntity(request.URL.Path, create)
+
+	if !ok {
+		return nil
+	}
+
+	data, err := ioutil.ReadAll(request.Body)
+	request.Body.Close()
+
+	if err != nil {
+		return err
+	}
+
+	request.Body = ioutil.NopCloser(bytes.NewReader(data))
+
+	return c.FieldValidation.ValidatePayload(ctx, entity, json.RawMessage(data), create)
+}
+
+// requestFieldEntity returns the entity a request path creates or updates,
+// such as /v1/deals or /v1/deals/5.
+func requestFieldEntity(path string, create bool) (FieldEntity, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	for i, segment := range segments {
+		entity, ok := fieldEntityResources[segment]
+
+		if !ok {
+			continue
+		}
+
+		rest := segments[i+1:]
+
+		if create && len(rest) == 0 {
+			return entity, true
+		}
+
+		if !create && len(rest) == 1 {
+			if _, err := strconv.Atoi(rest[0]); err == nil {
+				return entity, true
+			}
+		}
+
+		return "", false
+	}
+
+	return "", false
+}
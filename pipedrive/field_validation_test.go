@@ -0,0 +1,115 @@
+package pipedrive
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestValidateFieldValue(t *testing.T) {
+	enum := FieldDefinition{Name: "Size", FieldType: FieldTypeEnum, Options: []FieldOption{{ID: "1"}, {ID: "2"}}}
+	set := FieldDefinition{Name: "Tags", FieldType: FieldTypeSet, Options: []FieldOption{{ID: "1"}, {ID: "2"}}}
+	number := FieldDefinition{Name: "Budget", FieldType: FieldTypeMonetary}
+	date := FieldDefinition{Name: "Due", FieldType: FieldTypeDate}
+	text := FieldDefinition{Name: "Note", FieldType: FieldTypeVarchar}
+
+	tests := []struct {
+		field   FieldDefinition
+		value   string
+		wantErr bool
+	}{
+		{field: enum, value: `1`},
+		{field: enum, value: `"2"`},
+		{field: enum, value: `3`, wantErr: true},
+		{field: enum, value: `[1]`, wantErr: true},
+		{field: set, value: `[1, "2"]`},
+		{field: set, value: `"1, 2"`},
+		{field: set, value: `[1, 3]`, wantErr: true},
+		{field: number, value: `12.5`},
+		{field: number, value: `"12"`},
+		{field: number, value: `""`},
+		{field: number, value: `"twelve"`, wantErr: true},
+		{field: number, value: `{}`, wantErr: true},
+		{field: date, value: `"2019-06-01"`},
+		{field: date, value: `""`},
+		{field: date, value: `"01.06.2019"`, wantErr: true},
+		{field: date, value: `20190601`, wantErr: true},
+		{field: text, value: `{"any": "thing"}`},
+	}
+
+	for _, tt := range tests {
+		err := validateFieldValue(tt.field, json.RawMessage(tt.value))
+
+		if (err != nil) != tt.wantErr {
+			t.Errorf("validateFieldValue(%v, %s) returned %v, want error %v", tt.field.Name, tt.value, err, tt.wantErr)
+		}
+
+		if _, ok := err.(*ValidationError); err != nil && !ok {
+			t.Errorf("validateFieldValue(%v, %s) returned %T, want *ValidationError", tt.field.Name, tt.value, err)
+		}
+	}
+}
+
+func TestRequestFieldEntity(t *testing.T) {
+	tests := []struct {
+		path   string
+		create bool
+		want   FieldEntity
+		wantOK bool
+	}{
+		{path: "/v1/deals", create: true, want: FieldEntityDeal, wantOK: true},
+		{path: "/v1/persons/5", want: FieldEntityPerson, wantOK: true},
+		{path: "/api/v2/organizations/5", want: FieldEntityOrganization, wantOK: true},
+		{path: "/v1/deals/5", create: true},
+		{path: "/v1/deals"},
+		{path: "/v1/deals/5/followers", create: true},
+		{path: "/v1/deals/abc"},
+		{path: "/v1/pipelines", create: true},
+	}
+
+	for _, tt := range tests {
+		got, ok := requestFieldEntity(tt.path, tt.create)
+
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("requestFieldEntity(%v, %v) returned %v, %v, want %v, %v", tt.path, tt.create, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestFieldRegistry_ValidatePayload(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	key := strings.Repeat("a", customFieldKeyLength)
+
+	mux.HandleFunc("/v1/dealFields", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"success": true, "data": [
+			{"key": "title", "name": "Title", "field_type": "varchar", "mandatory_flag": true},
+			{"key": "`+key+`", "name": "Size", "field_type": "enum", "mandatory_flag": true, "options": [{"id": 1, "label": "Small"}]}
+		]}`)
+	})
+
+	registry := client.NewFieldRegistry()
+
+	tests := []struct {
+		payload map[string]interface{}
+		create  bool
+		wantErr bool
+	}{
+		{payload: map[string]interface{}{key: 1}, create: true},
+		{payload: map[string]interface{}{"title": "Deal"}, create: true, wantErr: true},
+		{payload: map[string]interface{}{key: nil}, create: true, wantErr: true},
+		{payload: map[string]interface{}{"title": "Deal"}},
+		{payload: map[string]interface{}{key: 2}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		err := registry.ValidatePayload(context.Background(), FieldEntityDeal, tt.payload, tt.create)
+
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ValidatePayload(%v, %v) returned %v, want error %v", tt.payload, tt.create, err, tt.wantErr)
+		}
+	}
+}
@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"io/ioutil"
+	"io"
+	"mime"
 	"mime/multipart"
 	"net/http"
 	"os"
+	"path/filepath"
+	"strconv"
 )
 
 // FilesService handles files related
@@ -18,36 +21,42 @@ type FilesService service
 
 // File represents a Pipedrive file.
 type File struct {
-	ID             int         `json:"id"`
-	UserID         int         `json:"user_id"`
-	DealID         int         `json:"deal_id"`
-	PersonID       int         `json:"person_id"`
-	OrgID          int         `json:"org_id"`
-	ProductID      int         `json:"product_id"`
-	LeadID         int         `json:"lead_id"`
-	EmailMessageID interface{} `json:"email_message_id"`
-	ActivityID     interface{} `json:"activity_id"`
-	NoteID         interface{} `json:"note_id"`
-	LogID          interface{} `json:"log_id"`
-	AddTime        string      `json:"add_time"`
-	UpdateTime     string      `json:"update_time"`
-	FileName       string      `json:"file_name"`
-	FileType       string      `json:"file_type"`
-	FileSize       int         `json:"file_size"`
-	ActiveFlag     bool        `json:"active_flag"`
-	InlineFlag     bool        `json:"inline_flag"`
-	RemoteLocation string      `json:"remote_location"`
-	RemoteID       string      `json:"remote_id"`
-	Cid            interface{} `json:"cid"`
-	S3Bucket       interface{} `json:"s3_bucket"`
-	MailMessageID  interface{} `json:"mail_message_id"`
-	DealName       string      `json:"deal_name"`
-	PersonName     string      `json:"person_name"`
-	OrgName        string      `json:"org_name"`
-	ProductName    interface{} `json:"product_name"`
-	URL            string      `json:"url"`
-	Name           string      `json:"name"`
-	Description    interface{} `json:"description"`
+	ID             int    `json:"id"`
+	UserID         int    `json:"user_id"`
+	DealID         int    `json:"deal_id"`
+	PersonID       int    `json:"person_id"`
+	OrgID          int    `json:"org_id"`
+	ProductID      int    `json:"product_id"`
+	ActivityID     int    `json:"activity_id"`
+	NoteID         int    `json:"note_id"`
+	LeadID         string `json:"lead_id"`
+	LogID          string `json:"log_id"`
+	MailMessageID  string `json:"mail_message_id"`
+	MailTemplateID string `json:"mail_template_id"`
+	AddTime        string `json:"add_time"`
+	UpdateTime     string `json:"update_time"`
+	FileName       string `json:"file_name"`
+	FileType       string `json:"file_type"`
+	FileSize       int64  `json:"file_size"`
+	ActiveFlag     bool   `json:"active_flag"`
+	InlineFlag     bool   `json:"inline_flag"`
+	RemoteLocation string `json:"remote_location"`
+	RemoteID       string `json:"remote_id"`
+	Cid            string `json:"cid"`
+	S3Bucket       string `json:"s3_bucket"`
+	DealName       string `json:"deal_name"`
+	PersonName     string `json:"person_name"`
+	OrgName        string `json:"org_name"`
+	ProductName    string `json:"product_name"`
+	LeadName       string `json:"lead_name"`
+	URL            string `json:"url"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+}
+
+// IsRemote reports whether file is stored in a remote location (e.g. Google Drive).
+func (f File) IsRemote() bool {
+	return f.RemoteLocation != "" && f.RemoteID != ""
 }
 
 func (f File) String() string {
@@ -68,21 +77,34 @@ type FilesResponse struct {
 	AdditionalData AdditionalData `json:"additional_data,omitempty"`
 }
 
+// FilesListOptions specifices the optional parameters to the
+// FilesService.ListWithOptions method.
+type FilesListOptions struct {
+	Start               uint  `url:"start,omitempty"`
+	Limit               uint  `url:"limit,omitempty"`
+	IncludeDeletedFiles uint8 `url:"include_deleted_files,omitempty"`
+	Sort                Sort  `url:"sort,omitempty"`
+}
+
 // List all files.
 //
+// Deprecated: use ListWithOptions instead, which also sorts the files and
+// includes deleted ones.
+//
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Files/get_files
 func (s *FilesService) List(ctx context.Context, opts PaginationParameters) (*FilesResponse, *Response, error) {
-	var (
-		err error
-		req *http.Request
-	)
+	return s.ListWithOptions(ctx, &FilesListOptions{
+		Start: uint(opts.Start),
+		Limit: uint(opts.Limit),
+	})
+}
 
-	switch {
-	case opts.Start > 0 || opts.Limit > 0:
-		req, err = s.client.NewRequest(http.MethodGet, "/files", &opts, nil)
-	default:
-		req, err = s.client.NewRequest(http.MethodGet, "/files", nil, nil)
-	}
+// ListWithOptions returns all files, optionally sorted and including
+// deleted ones.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Files/get_files
+func (s *FilesService) ListWithOptions(ctx context.Context, opt *FilesListOptions) (*FilesResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "/files", opt, nil)
 
 	if err != nil {
 		return nil, nil, err
@@ -135,7 +157,48 @@ func (s *FilesService) GetDownloadLinkByID(id int) (string, *http.Request, error
 	return string(req.URL.Scheme + "://" + req.URL.Host + req.URL.Path), req, nil
 }
 
-// Upload a file.
+// FileUploadOptions specifices the optional parameters to the
+// FilesService.UploadReader method. The file is linked to every item given.
+type FileUploadOptions struct {
+	DealID     uint
+	PersonID   uint
+	OrgID      uint
+	ProductID  uint
+	ActivityID uint
+	LeadID     string
+}
+
+// fields returns form fields for the multipart upload body.
+func (opt *FileUploadOptions) fields() map[string]string {
+	fields := make(map[string]string)
+
+	if opt == nil {
+		return fields
+	}
+
+	for name, id := range map[string]uint{
+		"deal_id":     opt.DealID,
+		"person_id":   opt.PersonID,
+		"org_id":      opt.OrgID,
+		"product_id":  opt.ProductID,
+		"activity_id": opt.ActivityID,
+	} {
+		if id > 0 {
+			fields[name] = strconv.FormatUint(uint64(id), 10)
+		}
+	}
+
+	if opt.LeadID != "" {
+		fields["lead_id"] = opt.LeadID
+	}
+
+	return fields
+}
+
+// Upload a file from the local file system under the name fileName.
+//
+// Deprecated: use UploadFile or UploadReader instead, which also associate
+// the file with an item.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Files/post_files
 func (s *FilesService) Upload(ctx context.Context, fileName string, filePath string) (*FileResponse, *Response, error) {
@@ -147,40 +210,81 @@ func (s *FilesService) Upload(ctx context.Context, fileName string, filePath str
 
 	defer file.Close()
 
-	fileContents, err := ioutil.ReadAll(file)
+	return s.UploadReader(ctx, file, fileName, nil)
+}
+
+// UploadReader uploads a file and associates it with a deal, person,
+// organization, product, activity or lead. The content is read from r and
+// sent as multipart/form-data.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Files/post_files
+func (s *FilesService) UploadReader(ctx context.Context, r io.Reader, fileName string, opt *FileUploadOptions) (*FileResponse, *Response, error) {
+	body, contentType, err := multipartBody(opt.fields(), fileName, r)
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	fileInfo, err := file.Stat()
+	req, err := s.client.NewUploadRequest(http.MethodPost, "/files", nil, body, contentType)
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	var body *bytes.Buffer
-	writer := multipart.NewWriter(body)
-	part, err := writer.CreateFormFile(fileName, fileInfo.Name())
+	var record *FileResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
 
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
 	}
 
-	part.Write(fileContents)
+	return record, resp, nil
+}
 
-	err = writer.Close()
+// FileUploadStreamOptions specifices the optional parameters to the
+// FilesService.UploadStream method.
+type FileUploadStreamOptions struct {
+	FileUploadOptions
 
-	if err != nil {
-		return nil, nil, err
+	// Size is the size of the content in bytes, reported as the total to
+	// Progress. Zero or less means the size is unknown.
+	Size int64
+
+	// Progress is called each time a chunk of the content has been sent,
+	// total is -1 when Size is unknown.
+	Progress func(sent, total int64)
+}
+
+// UploadStream uploads a file like UploadReader, but streams the content from r
+// while it is sent instead of buffering it, so files of any size can be
+// uploaded. Canceling ctx aborts the upload.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Files/post_files
+func (s *FilesService) UploadStream(ctx context.Context, r io.Reader, fileName string, opt *FileUploadStreamOptions) (*FileResponse, *Response, error) {
+	if opt == nil {
+		opt = &FileUploadStreamOptions{}
 	}
 
-	req, err := s.client.NewRequest(http.MethodPost, "/files", nil, body)
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+
+	req, err := s.client.NewUploadRequest(http.MethodPost, "/files", nil, pr, writer.FormDataContentType())
 
 	if err != nil {
 		return nil, nil, err
 	}
 
+	// Closing the reader ends the writer when the request is not sent or
+	// is aborted.
+	defer pr.Close()
+
+	content := &progressReader{ctx: ctx, r: r, total: opt.Size, progress: opt.Progress}
+
+	go func() {
+		pw.CloseWithError(writeMultipart(writer, opt.fields(), fileName, content))
+	}()
+
 	var record *FileResponse
 
 	resp, err := s.client.Do(ctx, req, &record)
@@ -192,6 +296,150 @@ func (s *FilesService) Upload(ctx context.Context, fileName string, filePath str
 	return record, resp, nil
 }
 
+// progressReader reports the bytes read from r and stops reading once ctx
+// is done.
+type progressReader struct {
+	ctx      context.Context
+	r        io.Reader
+	sent     int64
+	total    int64
+	progress func(sent, total int64)
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	if err := p.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	n, err := p.r.Read(b)
+
+	if n > 0 {
+		p.sent += int64(n)
+
+		if p.progress != nil {
+			total := p.total
+
+			if total <= 0 {
+				total = -1
+			}
+
+			p.progress(p.sent, total)
+		}
+	}
+
+	return n, err
+}
+
+// multipartBody buffers a multipart form with the given fields and the
+// contents of r as the "file" part.
+func multipartBody(fields map[string]string, fileName string, r io.Reader) (*bytes.Buffer, string, error) {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+
+	if err := writeMultipart(writer, fields, fileName, r); err != nil {
+		return nil, "", err
+	}
+
+	return body, writer.FormDataContentType(), nil
+}
+
+// writeMultipart writes the fields and the contents of r as the "file"
+// part, then closes the form.
+func writeMultipart(writer *multipart.Writer, fields map[string]string, fileName string, r io.Reader) error {
+	for name, value := range fields {
+		if err := writer.WriteField(name, value); err != nil {
+			return err
+		}
+	}
+
+	part, err := writer.CreateFormFile("file", filepath.Base(fileName))
+
+	if err != nil {
+		return err
+	}
+
+	if _, err = io.Copy(part, r); err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
+
+// UploadFile uploads a file from the local file system.
+func (s *FilesService) UploadFile(ctx context.Context, filePath string, opt *FileUploadOptions) (*FileResponse, *Response, error) {
+	file, err := os.Open(filePath)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer file.Close()
+
+	return s.UploadReader(ctx, file, filePath, opt)
+}
+
+// Download streams the contents of a specific file into w.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Files/get_files_id_download
+func (s *FilesService) Download(ctx context.Context, id int, w io.Writer) (*Response, error) {
+	uri := fmt.Sprintf("/files/%v/download", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, w)
+}
+
+// FileContent is the content of a file as it is downloaded. It must be
+// closed by the caller.
+type FileContent struct {
+	io.ReadCloser
+
+	// ContentType is the media type of the file as sent by Pipedrive.
+	ContentType string
+
+	// Size is the size of the file in bytes, -1 when it is unknown.
+	Size int64
+
+	// FileName is the name of the file from the Content-Disposition header,
+	// empty when the header is missing.
+	FileName string
+}
+
+// Open opens a specific file for reading. The content is streamed from
+// Pipedrive as it is read, so large files can be passed on without
+// buffering them in memory. Canceling ctx aborts the download.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Files/get_files_id_download
+func (s *FilesService) Open(ctx context.Context, id int) (*FileContent, *Response, error) {
+	uri := fmt.Sprintf("/files/%v/download", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := s.client.send(ctx, req)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	content := &FileContent{
+		ReadCloser:  resp.Body,
+		ContentType: resp.Header.Get("Content-Type"),
+		Size:        resp.ContentLength,
+	}
+
+	if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition")); err == nil {
+		content.FileName = params["filename"]
+	}
+
+	return content, resp, nil
+}
+
 // CreateRemoteLinkedFileOptions specifices the optional parameters to the
 // FilesService.CreateRemoteLinkedFile method.
 type CreateRemoteLinkedFileOptions struct {
@@ -204,6 +452,8 @@ type CreateRemoteLinkedFileOptions struct {
 
 // CreateRemoteLinkedFile creates a remote file and link it to an item.
 //
+// Deprecated: use CreateRemote instead.
+//
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Files/post_files_remote
 func (s *FilesService) CreateRemoteLinkedFile(ctx context.Context, opt *CreateRemoteLinkedFileOptions) (*FileResponse, *Response, error) {
 	req, err := s.client.NewRequest(http.MethodPost, "/files/remote", nil, opt)
@@ -234,6 +484,8 @@ type LinkRemoteFileToItemOptions struct {
 
 // LinkRemoteFileToItem links an existing remote file (googledrive, etc) to the item you supply.
 //
+// Deprecated: use LinkRemote instead.
+//
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Files/post_files_remoteLink
 func (s *FilesService) LinkRemoteFileToItem(ctx context.Context, opt *LinkRemoteFileToItemOptions) (*FileResponse, *Response, error) {
 	req, err := s.client.NewRequest(http.MethodPost, "/files/remoteLink", nil, opt)
@@ -253,14 +505,99 @@ func (s *FilesService) LinkRemoteFileToItem(ctx context.Context, opt *LinkRemote
 	return record, resp, nil
 }
 
+// Remote file types.
+type RemoteFileType string
+
+const (
+	RemoteFileTypeDocument    RemoteFileType = "gdoc"
+	RemoteFileTypeSlides      RemoteFileType = "gslides"
+	RemoteFileTypeSpreadsheet RemoteFileType = "gsheet"
+	RemoteFileTypeForm        RemoteFileType = "gform"
+	RemoteFileTypeDrawing     RemoteFileType = "gdraw"
+)
+
+// Remote locations, currently only Google Drive is supported.
+const RemoteLocationGoogleDrive = "googledrive"
+
+// Remote file item types.
+type RemoteFileItemType string
+
+const (
+	RemoteFileItemTypeDeal         RemoteFileItemType = "deal"
+	RemoteFileItemTypeOrganization RemoteFileItemType = "organization"
+	RemoteFileItemTypePerson       RemoteFileItemType = "person"
+)
+
+// FileCreateRemoteOptions specifices the parameters to the
+// FilesService.CreateRemote method.
+type FileCreateRemoteOptions struct {
+	FileType       RemoteFileType     `json:"file_type"`
+	Title          string             `json:"title"`
+	ItemType       RemoteFileItemType `json:"item_type"`
+	ItemID         uint               `json:"item_id"`
+	RemoteLocation string             `json:"remote_location"`
+}
+
+// CreateRemote creates a new empty file in the remote location (googledrive)
+// that will be linked to the item you supply.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Files/post_files_remote
+func (s *FilesService) CreateRemote(ctx context.Context, opt *FileCreateRemoteOptions) (*FileResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "/files/remote", nil, opt)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *FileResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// FileLinkRemoteOptions specifices the parameters to the
+// FilesService.LinkRemote method.
+type FileLinkRemoteOptions struct {
+	ItemType       RemoteFileItemType `json:"item_type"`
+	ItemID         uint               `json:"item_id"`
+	RemoteID       string             `json:"remote_id"`
+	RemoteLocation string             `json:"remote_location"`
+}
+
+// LinkRemote links an existing remote file (googledrive) to the item you supply.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Files/post_files_remoteLink
+func (s *FilesService) LinkRemote(ctx context.Context, opt *FileLinkRemoteOptions) (*FileResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "/files/remoteLink", nil, opt)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *FileResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
 // UpdateFileDetailsOptions specifices the optional parameters to the
 // FilesService.Update method.
 type UpdateFileDetailsOptions struct {
-	Name        string `url:"name"`
-	Description string `url:"description"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
 }
 
-// Update the properties of a file.
+// Update the name or description of a file.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Files/put_files_id
 func (s *FilesService) Update(ctx context.Context, id int, opt *UpdateFileDetailsOptions) (*FileResponse, *Response, error) {
@@ -0,0 +1,107 @@
+package pipedrive
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestFilesService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/files", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		if got, want := r.URL.Query().Encode(), "limit=10&start=20"; got != want {
+			t.Errorf("Request query: %v, want %v", got, want)
+		}
+
+		writeJSON(w, http.StatusOK, `{"success": true, "data": [{"id": 1}]}`)
+	})
+
+	files, _, err := client.Files.List(context.Background(), PaginationParameters{Start: 20, Limit: 10})
+
+	if err != nil || len(files.Data) != 1 {
+		t.Errorf("List returned %+v, %v", files, err)
+	}
+}
+
+func TestFilesService_ListWithOptions(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/files", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("include_deleted_files"); got != "1" {
+			t.Errorf("Request include_deleted_files: %v, want 1", got)
+		}
+
+		writeJSON(w, http.StatusOK, `{"success": true, "data": []}`)
+	})
+
+	if _, _, err := client.Files.ListWithOptions(context.Background(), &FilesListOptions{IncludeDeletedFiles: 1}); err != nil {
+		t.Errorf("ListWithOptions returned error: %v", err)
+	}
+}
+
+func TestFilesService_Upload(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/files", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+
+		file, header, err := r.FormFile("file")
+
+		if err != nil {
+			t.Fatalf("Request has no file: %v", err)
+		}
+
+		content, _ := ioutil.ReadAll(file)
+
+		if header.Filename != "report.txt" || string(content) != "content" {
+			t.Errorf("Request file %v with %q, want report.txt with the content", header.Filename, content)
+		}
+
+		writeJSON(w, http.StatusOK, `{"success": true, "data": {"id": 1}}`)
+	})
+
+	f, err := ioutil.TempFile("", "upload")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.Remove(f.Name())
+
+	f.WriteString("content")
+	f.Close()
+
+	file, _, err := client.Files.Upload(context.Background(), "report.txt", f.Name())
+
+	if err != nil || file.Data.ID != 1 {
+		t.Errorf("Upload returned %+v, %v", file, err)
+	}
+}
+
+func TestFilesService_UploadReader(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/files", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.FormValue("deal_id"); got != "5" {
+			t.Errorf("Request deal_id: %v, want 5", got)
+		}
+
+		writeJSON(w, http.StatusOK, `{"success": true, "data": {"id": 1}}`)
+	})
+
+	_, _, err := client.Files.UploadReader(context.Background(), strings.NewReader("content"), "report.txt", &FileUploadOptions{DealID: 5})
+
+	if err != nil {
+		t.Errorf("UploadReader returned error: %v", err)
+	}
+}
@@ -0,0 +1,252 @@
+package pipedrive
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Filter condition operators.
+type FilterOperator string
+
+const (
+	FilterOperatorEqual          FilterOperator = "="
+	FilterOperatorNotEqual       FilterOperator = "!="
+	FilterOperatorLess           FilterOperator = "<"
+	FilterOperatorGreater        FilterOperator = ">"
+	FilterOperatorLessOrEqual    FilterOperator = "<="
+	FilterOperatorGreaterOrEqual FilterOperator = ">="
+	FilterOperatorContains       FilterOperator = "LIKE '%$%'"
+	FilterOperatorNotContains    FilterOperator = "NOT LIKE '%$%'"
+	FilterOperatorStartsWith     FilterOperator = "LIKE '$%'"
+	FilterOperatorNotStartsWith  FilterOperator = "NOT LIKE '$%'"
+	FilterOperatorEndsWith       FilterOperator = "LIKE '%$'"
+	FilterOperatorNotEndsWith    FilterOperator = "NOT LIKE '%$'"
+	FilterOperatorIsEmpty        FilterOperator = "IS NULL"
+	FilterOperatorIsNotEmpty     FilterOperator = "IS NOT NULL"
+)
+
+var (
+	filterEmptyOperators = []FilterOperator{
+		FilterOperatorIsEmpty,
+		FilterOperatorIsNotEmpty,
+	}
+
+	filterTextOperators = append([]FilterOperator{
+		FilterOperatorEqual,
+		FilterOperatorNotEqual,
+		FilterOperatorContains,
+		FilterOperatorNotContains,
+		FilterOperatorStartsWith,
+		FilterOperatorNotStartsWith,
+		FilterOperatorEndsWith,
+		FilterOperatorNotEndsWith,
+	}, filterEmptyOperators...)
+
+	filterRangeOperators = append([]FilterOperator{
+		FilterOperatorEqual,
+		FilterOperatorNotEqual,
+		FilterOperatorLess,
+		FilterOperatorGreater,
+		FilterOperatorLessOrEqual,
+		FilterOperatorGreaterOrEqual,
+	}, filterEmptyOperators...)
+
+	filterOptionOperators = append([]FilterOperator{
+		FilterOperatorEqual,
+		FilterOperatorNotEqual,
+	}, filterEmptyOperators...)
+
+	// Operators allowed for each field type.
+	filterOperatorsByFieldType = map[FieldType][]FilterOperator{
+		FieldTypeVarchar:     filterTextOperators,
+		FieldTypeVarcharAuto: filterTextOperators,
+		FieldTypeText:        filterTextOperators,
+		FieldTypePhone:       filterTextOperators,
+		FieldTypeDouble:      filterRangeOperators,
+		FieldTypeMonetary:    filterRangeOperators,
+		FieldTypeDate:        filterRangeOperators,
+		FieldTypeDaterange:   filterRangeOperators,
+		FieldTypeTime:        filterRangeOperators,
+		FieldTypeTimerange:   filterRangeOperators,
+		FieldTypeEnum:        filterOptionOperators,
+		FieldTypeSet:         filterOptionOperators,
+		FieldTypeUser:        filterOptionOperators,
+		FieldTypeOrg:         filterOptionOperators,
+		FieldTypePeople:      filterOptionOperators,
+	}
+)
+
+// FilterBuilder builds the conditions payload of a filter. Conditions added
+// with And must all match, at least one of the conditions added with Or must
+// match.
+//
+//	conditions, err := pipedrive.NewFilterBuilder().
+//		Field("12", pipedrive.FieldTypeMonetary).
+//		And("deal", "12", pipedrive.FilterOperatorGreater, 1000).
+//		Or("deal", "9", pipedrive.FilterOperatorEqual, "open").
+//		Build()
+type FilterBuilder struct {
+	and        []FilterCondition
+	or         []FilterCondition
+	fieldTypes map[string]FieldType
+}
+
+// NewFilterBuilder returns a new empty filter builder.
+func NewFilterBuilder() *FilterBuilder {
+	return &FilterBuilder{
+		fieldTypes: make(map[string]FieldType),
+	}
+}
+
+// Field registers the type of a field, conditions on that field are then
+// validated against operators and values allowed for the type.
+func (b *FilterBuilder) Field(fieldID string, fieldType FieldType) *FilterBuilder {
+	b.fieldTypes[fieldID] = fieldType
+
+	return b
+}
+
+// And adds a condition which must match.
+func (b *FilterBuilder) And(object string, fieldID string, operator FilterOperator, value interface{}) *FilterBuilder {
+	b.and = append(b.and, newFilterCondition(object, fieldID, operator, value))
+
+	return b
+}
+
+// Or adds a condition of which at least one must match.
+func (b *FilterBuilder) Or(object string, fieldID string, operator FilterOperator, value interface{}) *FilterBuilder {
+	b.or = append(b.or, newFilterCondition(object, fieldID, operator, value))
+
+	return b
+}
+
+// Build validates added conditions and returns the conditions payload
+// for FiltersService.Create and FiltersService.Update.
+func (b *FilterBuilder) Build() (FilterConditions, error) {
+	if len(b.and) == 0 && len(b.or) == 0 {
+		return FilterConditions{}, fmt.Errorf("filter must have at least one condition")
+	}
+
+	// Both groups must be present in the payload, even if empty.
+	groups := []FilterConditionGroup{
+		{Glue: FilterGlueAnd, Conditions: append([]FilterCondition{}, b.and...)},
+		{Glue: FilterGlueOr, Conditions: append([]FilterCondition{}, b.or...)},
+	}
+
+	for _, group := range groups {
+		for i, condition := range group.Conditions {
+			if err := b.validate(condition); err != nil {
+				return FilterConditions{}, fmt.Errorf("invalid %q condition %d on field %q: %v", group.Glue, i, condition.FieldID, err)
+			}
+		}
+	}
+
+	return FilterConditions{
+		Glue:       FilterGlueAnd,
+		Conditions: groups,
+	}, nil
+}
+
+func (b *FilterBuilder) validate(condition FilterCondition) error {
+	if condition.Object == "" {
+		return fmt.Errorf("object is required")
+	}
+
+	if condition.FieldID == "" {
+		return fmt.Errorf("field ID is required")
+	}
+
+	operator := FilterOperator(condition.Operator)
+	isEmptyOperator := containsFilterOperator(filterEmptyOperators, operator)
+
+	switch {
+	case isEmptyOperator && condition.Value != nil:
+		return fmt.Errorf("operator %q does not accept a value", operator)
+	case !isEmptyOperator && condition.Value == nil:
+		return fmt.Errorf("operator %q requires a value", operator)
+	}
+
+	fieldType, ok := b.fieldTypes[condition.FieldID]
+
+	if !ok {
+		if !containsFilterOperator(filterTextOperators, operator) && !containsFilterOperator(filterRangeOperators, operator) {
+			return fmt.Errorf("unknown operator %q", operator)
+		}
+
+		return nil
+	}
+
+	allowed, ok := filterOperatorsByFieldType[fieldType]
+
+	if !ok {
+		return fmt.Errorf("field type %q can not be filtered", fieldType)
+	}
+
+	if !containsFilterOperator(allowed, operator) {
+		return fmt.Errorf("operator %q is not allowed for field type %q", operator, fieldType)
+	}
+
+	if isEmptyOperator {
+		return nil
+	}
+
+	return validateFilterValue(fieldType, condition.Value)
+}
+
+func validateFilterValue(fieldType FieldType, value interface{}) error {
+	switch fieldType {
+	case FieldTypeDouble, FieldTypeMonetary:
+		if !isFilterNumber(value) {
+			return fmt.Errorf("value %v is not a number", value)
+		}
+	case FieldTypeEnum, FieldTypeSet, FieldTypeUser, FieldTypeOrg, FieldTypePeople:
+		if !isFilterNumber(value) {
+			return fmt.Errorf("value %v is not an option or item ID", value)
+		}
+	case FieldTypeDate, FieldTypeDaterange:
+		v, ok := value.(string)
+
+		if !ok {
+			return fmt.Errorf("value %v is not a date", value)
+		}
+
+		if _, err := time.Parse("2006-01-02", v); err != nil {
+			return fmt.Errorf("value %q is not a date in YYYY-MM-DD format", v)
+		}
+	}
+
+	return nil
+}
+
+func isFilterNumber(value interface{}) bool {
+	switch v := value.(type) {
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return true
+	case string:
+		_, err := strconv.ParseFloat(v, 64)
+
+		return err == nil
+	}
+
+	return false
+}
+
+func containsFilterOperator(operators []FilterOperator, operator FilterOperator) bool {
+	for _, o := range operators {
+		if o == operator {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newFilterCondition(object string, fieldID string, operator FilterOperator, value interface{}) FilterCondition {
+	return FilterCondition{
+		Object:   object,
+		FieldID:  fieldID,
+		Operator: string(operator),
+		Value:    value,
+	}
+}
@@ -2,8 +2,10 @@ package pipedrive
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 )
 
 // FiltersService handles activities related
@@ -12,12 +14,25 @@ import (
 // Pipedrive API dcos: https://developers.pipedrive.com/docs/api/v1/#!/Filters
 type FiltersService service
 
+// Filter types.
+type FilterType string
+
+const (
+	FilterTypeDeals    FilterType = "deals"
+	FilterTypeLeads    FilterType = "leads"
+	FilterTypeOrg      FilterType = "org"
+	FilterTypePeople   FilterType = "people"
+	FilterTypeProducts FilterType = "products"
+	FilterTypeActivity FilterType = "activity"
+	FilterTypeProjects FilterType = "projects"
+)
+
 // Filter represents a Pipedrive filter.
 type Filter struct {
 	ID            int         `json:"id"`
 	Name          string      `json:"name"`
 	ActiveFlag    bool        `json:"active_flag"`
-	Type          string      `json:"type"`
+	Type          FilterType  `json:"type"`
 	TemporaryFlag interface{} `json:"temporary_flag"`
 	UserID        int         `json:"user_id"`
 	AddTime       string      `json:"add_time"`
@@ -30,19 +45,34 @@ func (f Filter) String() string {
 	return Stringify(f)
 }
 
-// FilterConditions represents filter conditions.
+// Filter condition glues.
+const (
+	FilterGlueAnd = "and"
+	FilterGlueOr  = "or"
+)
+
+// FilterCondition represents a single filter condition on a field.
+// Value and ExtraValue are encoded as given, use nil for empty values.
+type FilterCondition struct {
+	Object     string      `json:"object"`
+	FieldID    string      `json:"field_id"`
+	Operator   string      `json:"operator"`
+	Value      interface{} `json:"value"`
+	ExtraValue interface{} `json:"extra_value"`
+}
+
+// FilterConditionGroup represents a group of filter conditions joined by glue.
+type FilterConditionGroup struct {
+	Glue       string            `json:"glue"`
+	Conditions []FilterCondition `json:"conditions"`
+}
+
+// FilterConditions represents filter conditions. Pipedrive expects exactly
+// two groups at the top level: the first is joined with "and",
+// the second with "or".
 type FilterConditions struct {
-	Glue       string `json:"glue"`
-	Conditions []struct {
-		Glue       string `json:"glue"`
-		Conditions []struct {
-			Object     string      `json:"object"`
-			FieldID    string      `json:"field_id"`
-			Operator   string      `json:"operator"`
-			Value      string      `json:"value"`
-			ExtraValue interface{} `json:"extra_value"`
-		} `json:"conditions"`
-	} `json:"conditions"`
+	Glue       string                 `json:"glue"`
+	Conditions []FilterConditionGroup `json:"conditions"`
 }
 
 // FilterResponse represents single filter response.
@@ -64,7 +94,7 @@ type FiltersResponse struct {
 // FiltersListOptions specifices the optional parameters to the
 // FiltersService.List method.
 type FiltersListOptions struct {
-	Type string `url:"type,omitempty"`
+	Type FilterType `url:"type,omitempty"`
 }
 
 // List filters.
@@ -110,12 +140,111 @@ func (s *FiltersService) GetByID(ctx context.Context, id int) (*FilterResponse,
 	return record, resp, nil
 }
 
+// FilterHelpers represents operators and relative dates supported by filters.
+// Operators are keyed by field type.
+type FilterHelpers struct {
+	Operators              map[string]FilterHelperOperators `json:"operators"`
+	DeprecatedOperators    map[string]FilterHelperOperators `json:"deprecated_operators"`
+	RelativeDates          map[string]interface{}           `json:"relative_dates"`
+	AddressFieldComponents map[string]interface{}           `json:"address_field_components"`
+}
+
+// FilterHelperOperators represents operators available for a field type.
+type FilterHelperOperators []FilterOperator
+
+// UnmarshalJSON accepts operators given either as a list of codes, a list of
+// objects with code and label, or an object of labels keyed by code.
+func (o *FilterHelperOperators) UnmarshalJSON(data []byte) error {
+	var codes []string
+
+	if err := json.Unmarshal(data, &codes); err == nil {
+		for _, code := range codes {
+			*o = append(*o, FilterOperator(code))
+		}
+
+		return nil
+	}
+
+	var objects []struct {
+		Code string `json:"code"`
+	}
+
+	if err := json.Unmarshal(data, &objects); err == nil {
+		for _, object := range objects {
+			*o = append(*o, FilterOperator(object.Code))
+		}
+
+		return nil
+	}
+
+	var labels map[string]interface{}
+
+	if err := json.Unmarshal(data, &labels); err != nil {
+		return err
+	}
+
+	for code := range labels {
+		*o = append(*o, FilterOperator(code))
+	}
+
+	sort.Sort(byOperatorCode(*o))
+
+	return nil
+}
+
+type byOperatorCode FilterHelperOperators
+
+func (s byOperatorCode) Len() int           { return len(s) }
+func (s byOperatorCode) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s byOperatorCode) Less(i, j int) bool { return s[i] < s[j] }
+
+// FilterHelpersResponse represents filter helpers response.
+type FilterHelpersResponse struct {
+	Success bool          `json:"success"`
+	Data    FilterHelpers `json:"data"`
+}
+
+// GetHelpers returns all supported filter helpers. It helps to know what
+// conditions and helpers are available when you want to add or update filters.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Filters/get_filters_helpers
+func (s *FiltersService) GetHelpers(ctx context.Context) (*FilterHelpersResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "/filters/helpers", nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *FilterHelpersResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
 // FilterCreateOptions specifices the optional parameters to the
 // FiltersService.Create method.
 type FilterCreateOptions struct {
-	Name       string `url:"name,omitempty"`
-	Conditions string `url:"conditions,omitempty"`
-	Type       string `url:"type,omitempty"`
+	Name       string           `json:"name"`
+	Conditions FilterConditions `json:"conditions"`
+	Type       FilterType       `json:"type"`
+}
+
+// Validate checks the required name and type.
+func (o FilterCreateOptions) Validate() error {
+	if o.Name == "" {
+		return requiredError("name")
+	}
+
+	if o.Type == "" {
+		return requiredError("type")
+	}
+
+	return nil
 }
 
 // Create a filter.
@@ -142,8 +271,8 @@ func (s *FiltersService) Create(ctx context.Context, opt *FilterCreateOptions) (
 // FilterUpdateOptions specifices the optional parameters to the
 // FiltersService.Update method.
 type FilterUpdateOptions struct {
-	Name       string `url:"name,omitempty"`
-	Conditions string `url:"conditions,omitempty"`
+	Name       string            `json:"name,omitempty"`
+	Conditions *FilterConditions `json:"conditions,omitempty"`
 }
 
 // Update a specific filter.
@@ -172,8 +301,8 @@ func (s *FiltersService) Update(ctx context.Context, id int, opt *FilterUpdateOp
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Filters/delete_filters
 func (s *FiltersService) DeleteMultiple(ctx context.Context, ids []int) (*Response, error) {
-	req, err := s.client.NewRequest(http.MethodDelete, "/filter", &DeleteMultipleOptions{
-		Ids: arrayToString(ids, ","),
+	req, err := s.client.NewRequest(http.MethodDelete, "/filters", &DeleteMultipleOptions{
+		Ids: ids,
 	}, nil)
 
 	if err != nil {
@@ -0,0 +1,138 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// FollowersService handles the followers of deals, persons, organizations
+// and products, the users notified about their changes.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Deals/get_deals_id_followers
+type FollowersService service
+
+// FollowedEntity represents an entity that can be followed.
+type FollowedEntity string
+
+// FollowedEntity constants.
+const (
+	FollowedDeal         FollowedEntity = "deals"
+	FollowedPerson       FollowedEntity = "persons"
+	FollowedOrganization FollowedEntity = "organizations"
+	FollowedProduct      FollowedEntity = "products"
+)
+
+// Valid reports whether the entity can be followed.
+func (e FollowedEntity) Valid() bool {
+	switch e {
+	case FollowedDeal, FollowedPerson, FollowedOrganization, FollowedProduct:
+		return true
+	}
+
+	return false
+}
+
+// Follower represents a user following an entity. ID identifies the
+// follower for removing it.
+type Follower struct {
+	ID      int    `json:"id"`
+	UserID  int    `json:"user_id"`
+	AddTime string `json:"add_time"`
+}
+
+func (f Follower) String() string {
+	return Stringify(f)
+}
+
+// FollowersResponse represents multiple followers response.
+type FollowersResponse struct {
+	Success        bool           `json:"success"`
+	Data           []Follower     `json:"data"`
+	AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// FollowerResponse represents single follower response.
+type FollowerResponse struct {
+	Success bool     `json:"success"`
+	Data    Follower `json:"data"`
+}
+
+// List the followers of an entity.
+func (s *FollowersService) List(ctx context.Context, entity FollowedEntity, id int) (*FollowersResponse, *Response, error) {
+	uri, err := followersPath(entity, id)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *FollowersResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// Add a user as follower of an entity.
+func (s *FollowersService) Add(ctx context.Context, entity FollowedEntity, id int, userID int) (*FollowerResponse, *Response, error) {
+	uri, err := followersPath(entity, id)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodPost, uri, nil, struct {
+		UserID int `json:"user_id"`
+	}{
+		userID,
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *FollowerResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// Remove a follower, as identified by Follower.ID, from an entity.
+func (s *FollowersService) Remove(ctx context.Context, entity FollowedEntity, id int, followerID int) (*Response, error) {
+	uri, err := followersPath(entity, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodDelete, fmt.Sprintf("%v/%v", uri, followerID), nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func followersPath(entity FollowedEntity, id int) (string, error) {
+	if !entity.Valid() {
+		return "", enumError("entity", entity)
+	}
+
+	return fmt.Sprintf("/%v/%v/followers", entity, id), nil
+}
@@ -0,0 +1,95 @@
+//go:build ignore
+// +build ignore
+
+// gen-events generates the webhook EventAction and EventObject constants.
+// Run it with go generate from the pipedrive directory.
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"text/template"
+)
+
+type event struct {
+	Name  string
+	Value string
+}
+
+var actions = []event{
+	{"ADDED", "added"},
+	{"UPDATED", "updated"},
+	{"MERGED", "merged"},
+	{"DELETED", "deleted"},
+	{"ALL", "*"},
+}
+
+var objects = []event{
+	{"ACTIVITY", "activity"},
+	{"ACTIVITY_TYPE", "activityType"},
+	{"DEAL", "deal"},
+	{"NOTE", "note"},
+	{"ORGANIZATION", "organization"},
+	{"PERSON", "person"},
+	{"PIPELINE", "pipeline"},
+	{"PRODUCT", "product"},
+	{"STAGE", "stage"},
+	{"USER", "user"},
+	{"ALL", "*"},
+}
+
+var source = template.Must(template.New("events").Parse(`// Code generated by gen-events.go; DO NOT EDIT.
+
+package pipedrive
+
+// Webhook event actions.
+const (
+{{- range .Actions}}
+	ACTION_{{.Name}} EventAction = "{{.Value}}"
+{{- end}}
+)
+
+// Webhook event objects.
+const (
+{{- range .Objects}}
+	OBJECT_{{.Name}} EventObject = "{{.Value}}"
+{{- end}}
+)
+
+var eventActions = map[EventAction]bool{
+{{- range .Actions}}
+	ACTION_{{.Name}}: true,
+{{- end}}
+}
+
+var eventObjects = map[EventObject]bool{
+{{- range .Objects}}
+	OBJECT_{{.Name}}: true,
+{{- end}}
+}
+`))
+
+func main() {
+	var buf bytes.Buffer
+
+	err := source.Execute(&buf, map[string][]event{
+		"Actions": actions,
+		"Objects": objects,
+	})
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := ioutil.WriteFile("events_gen.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -9,7 +9,7 @@ import (
 // GoalsService handles goals related
 // methods of the Pipedrive API.
 //
-// Pipedrive API dcos: https://developers.pipedrive.com/docs/api/v1/#!/Goals
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Goals
 type GoalsService service
 
 // Goal represents a Pipedrive goal.
@@ -83,6 +83,104 @@ func (s *GoalsService) List(ctx context.Context, opt *GoalsListOptions) (*GoalsR
 	return record, resp, nil
 }
 
+// GoalTypeName represents what a goal tracks.
+type GoalTypeName string
+
+// GoalTypeName constants.
+const (
+	GoalTypeDealsWon            GoalTypeName = "deals_won"
+	GoalTypeDealsProgressed     GoalTypeName = "deals_progressed"
+	GoalTypeDealsStarted        GoalTypeName = "deals_started"
+	GoalTypeActivitiesCompleted GoalTypeName = "activities_completed"
+	GoalTypeActivitiesAdded     GoalTypeName = "activities_added"
+	GoalTypeRevenueForecast     GoalTypeName = "revenue_forecast"
+)
+
+// GoalDefinition represents a Pipedrive goal as returned by GoalsService.Find.
+type GoalDefinition struct {
+	ID      string `json:"id"`
+	OwnerID int    `json:"owner_id"`
+	Title   string `json:"title"`
+	Type    struct {
+		Name   GoalTypeName `json:"name"`
+		Params struct {
+			PipelineID     []int `json:"pipeline_id,omitempty"`
+			StageID        int   `json:"stage_id,omitempty"`
+			ActivityTypeID []int `json:"activity_type_id,omitempty"`
+		} `json:"params"`
+	} `json:"type"`
+	Assignee struct {
+		ID   int    `json:"id"`
+		Type string `json:"type"`
+	} `json:"assignee"`
+	Interval string `json:"interval"`
+	Duration struct {
+		Start string `json:"start"`
+		End   string `json:"end"`
+	} `json:"duration"`
+	ExpectedOutcome struct {
+		Target         float64 `json:"target"`
+		TrackingMetric string  `json:"tracking_metric"`
+		CurrencyID     int     `json:"currency_id,omitempty"`
+	} `json:"expected_outcome"`
+	IsActive  bool  `json:"is_active"`
+	ReportIds []int `json:"report_ids"`
+}
+
+func (g GoalDefinition) String() string {
+	return Stringify(g)
+}
+
+// GoalsFindResponse represents goals find response.
+type GoalsFindResponse struct {
+	Success bool `json:"success"`
+	Data    struct {
+		Goals []GoalDefinition `json:"goals"`
+	} `json:"data"`
+}
+
+// GoalsFindOptions specifices the optional parameters to the
+// GoalsService.Find method.
+//
+// PeriodStart and PeriodEnd are formatted as YYYY-MM-DD and must be
+// given together.
+type GoalsFindOptions struct {
+	TypeName                      GoalTypeName `url:"type.name,omitempty"`
+	Title                         string       `url:"title,omitempty"`
+	IsActive                      *bool        `url:"is_active,omitempty"`
+	AssigneeID                    uint         `url:"assignee.id,omitempty"`
+	AssigneeType                  string       `url:"assignee.type,omitempty"`
+	ExpectedOutcomeTarget         float64      `url:"expected_outcome.target,omitempty"`
+	ExpectedOutcomeTrackingMetric string       `url:"expected_outcome.tracking_metric,omitempty"`
+	ExpectedOutcomeCurrencyID     uint         `url:"expected_outcome.currency_id,omitempty"`
+	TypeParamsPipelineID          []int        `url:"type.params.pipeline_id,omitempty,comma"`
+	TypeParamsStageID             uint         `url:"type.params.stage_id,omitempty"`
+	TypeParamsActivityTypeID      []int        `url:"type.params.activity_type_id,omitempty,comma"`
+	PeriodStart                   string       `url:"period.start,omitempty"`
+	PeriodEnd                     string       `url:"period.end,omitempty"`
+}
+
+// Find returns goals matching the given criteria.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Goals/get_goals_find
+func (s *GoalsService) Find(ctx context.Context, opt *GoalsFindOptions) (*GoalsFindResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "/goals/find", opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *GoalsFindResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
 // GetByID returns data about a specific goal.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Goals/get_goals_id
@@ -139,12 +237,25 @@ func (s *GoalsService) Create(ctx context.Context, opt *GoalCreateOptions) (*Goa
 	return record, resp, nil
 }
 
+// GoalUpdateOptions specifices the optional parameters to the
+// GoalsService.Update method. Only the fields that are set are sent.
+type GoalUpdateOptions struct {
+	GoalType     *string `json:"goal_type,omitempty"`
+	ExpectedType *string `json:"expected_type,omitempty"`
+	UserID       *uint   `json:"user_id,omitempty"`
+	StageID      *uint   `json:"stage_id,omitempty"`
+	Period       *string `json:"period,omitempty"`
+	Expected     *uint   `json:"expected,omitempty"`
+	Currency     *string `json:"currency,omitempty"`
+	PipelineID   *uint   `json:"pipeline_id,omitempty"`
+}
+
 // Update the properties of a goal.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Goals/put_goals_id
-func (s *GoalsService) Update(ctx context.Context, id int, opt *GoalCreateOptions) (*GoalResponse, *Response, error) {
+func (s *GoalsService) Update(ctx context.Context, id int, opt *GoalUpdateOptions) (*GoalResponse, *Response, error) {
 	uri := fmt.Sprintf("/goals/%v", id)
-	req, err := s.client.NewRequest(http.MethodPost, uri, opt, nil)
+	req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
 
 	if err != nil {
 		return nil, nil, err
@@ -0,0 +1,236 @@
+package pipedrive
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	icalDateLayout     = "20060102"
+	icalDateTimeLayout = "20060102T150405Z"
+
+	// icalLineLength is the longest line in octets before it is folded.
+	icalLineLength = 75
+
+	defaultCalendarDomain = "pipedrive.com"
+)
+
+// Calendar converts activities into an iCalendar (RFC 5545) feed, for
+// bridges to calendar applications.
+type Calendar struct {
+	// Name is shown by calendar applications as name of the feed.
+	Name string
+
+	// Domain qualifies the UIDs of the events, pipedrive.com when empty.
+	// Set it to the company domain when feeds of several companies are
+	// merged.
+	Domain string
+}
+
+// Marshal returns the feed of the activities, see Write.
+func (c *Calendar) Marshal(activities []Activity) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := c.Write(&buf, activities); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Write writes the feed of the activities to w. Every activity with a due
+// date becomes an event with its subject, public description, location,
+// conference URL and attendees. Activities with a due time start at it
+// and last their duration, the others are all-day events.
+func (c *Calendar) Write(w io.Writer, activities []Activity) error {
+	writer := &icalWriter{w: bufio.NewWriter(w)}
+
+	writer.line("BEGIN:VCALENDAR")
+	writer.line("VERSION:2.0")
+	writer.line("PRODID:-//genert//pipedrive-api//EN")
+	writer.line("CALSCALE:GREGORIAN")
+
+	if c.Name != "" {
+		writer.property("X-WR-CALNAME", c.Name)
+	}
+
+	for _, activity := range activities {
+		if err := c.writeEvent(writer, &activity); err != nil {
+			return fmt.Errorf("activity %v: %v", activity.Id, err)
+		}
+	}
+
+	writer.line("END:VCALENDAR")
+
+	return writer.flush()
+}
+
+func (c *Calendar) writeEvent(w *icalWriter, activity *Activity) error {
+	if activity.DueDate == "" {
+		return nil
+	}
+
+	domain := c.Domain
+
+	if domain == "" {
+		domain = defaultCalendarDomain
+	}
+
+	schedule := ActivitySchedule{DueDate: activity.DueDate, DueTime: activity.DueTime}
+	start, err := schedule.Start(time.UTC)
+
+	if err != nil {
+		return err
+	}
+
+	stamp, err := time.Parse(dealFlowTimeLayout, activity.UpdateTime)
+
+	if err != nil {
+		stamp = time.Now()
+	}
+
+	w.line("BEGIN:VEVENT")
+	w.line(fmt.Sprintf("UID:activity-%v@%v", activity.Id, domain))
+	w.line("DTSTAMP:" + stamp.UTC().Format(icalDateTimeLayout))
+
+	if activity.DueTime == "" {
+		w.line("DTSTART;VALUE=DATE:" + start.Format(icalDateLayout))
+		w.line("DTEND;VALUE=DATE:" + start.AddDate(0, 0, 1).Format(icalDateLayout))
+	} else {
+		w.line("DTSTART:" + start.Format(icalDateTimeLayout))
+
+		if activity.Duration != "" {
+			duration, err := activity.Duration.Duration()
+
+			if err != nil {
+				return err
+			}
+
+			w.line("DTEND:" + start.Add(duration).Format(icalDateTimeLayout))
+		}
+	}
+
+	w.property("SUMMARY", activity.Subject)
+
+	if activity.Type != "" {
+		w.property("CATEGORIES", activity.Type)
+	}
+
+	if activity.PublicDescription != "" {
+		w.property("DESCRIPTION", activity.PublicDescription)
+	}
+
+	if location := activityLocation(activity); location != "" {
+		w.property("LOCATION", location)
+	}
+
+	if meeting := activity.ConferenceMeeting; meeting != nil && meeting.URL != "" {
+		w.property("URL", meeting.URL)
+	}
+
+	if activity.BusyFlag {
+		w.line("TRANSP:OPAQUE")
+	} else {
+		w.line("TRANSP:TRANSPARENT")
+	}
+
+	for _, attendee := range activity.Attendees {
+		if attendee.EmailAddress == "" {
+			continue
+		}
+
+		name := "ATTENDEE;PARTSTAT=" + icalPartStat(attendee.Status)
+
+		if attendee.IsOrganizer == 1 {
+			name = "ORGANIZER"
+		}
+
+		if attendee.Name != "" {
+			name += ";CN=" + icalParam(attendee.Name)
+		}
+
+		w.line(name + ":mailto:" + attendee.EmailAddress)
+	}
+
+	w.line("END:VEVENT")
+
+	return nil
+}
+
+func activityLocation(activity *Activity) string {
+	if activity.Location.FormattedAddress != "" {
+		return activity.Location.FormattedAddress
+	}
+
+	return activity.Location.Value
+}
+
+// icalPartStat returns the participation status of an attendee status.
+func icalPartStat(status string) string {
+	switch strings.ToLower(status) {
+	case "accepted":
+		return "ACCEPTED"
+	case "declined":
+		return "DECLINED"
+	case "tentative":
+		return "TENTATIVE"
+	}
+
+	return "NEEDS-ACTION"
+}
+
+// icalParam quotes a parameter value, which can not contain quotes.
+func icalParam(value string) string {
+	return `"` + strings.Replace(value, `"`, "'", -1) + `"`
+}
+
+var icalTextReplacer = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+// icalWriter writes content lines, folded and terminated by CRLF. The
+// first error is kept and returned by flush.
+type icalWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+// property writes a property with a text value.
+func (w *icalWriter) property(name, value string) {
+	w.line(name + ":" + icalTextReplacer.Replace(value))
+}
+
+func (w *icalWriter) line(line string) {
+	for len(line) > icalLineLength {
+		cut := icalLineLength
+
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		w.write(line[:cut] + "\r\n")
+
+		// Continuation lines start with a space, which counts towards
+		// their length.
+		line = " " + line[cut:]
+	}
+
+	w.write(line + "\r\n")
+}
+
+func (w *icalWriter) write(s string) {
+	if w.err == nil {
+		_, w.err = w.w.WriteString(s)
+	}
+}
+
+func (w *icalWriter) flush() error {
+	if w.err != nil {
+		return w.err
+	}
+
+	return w.w.Flush()
+}
@@ -0,0 +1,135 @@
+package pipedrive
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestCalendar_Marshal(t *testing.T) {
+	tests := []struct {
+		name     string
+		activity Activity
+		want     []string
+	}{
+		{
+			name:     "all-day",
+			activity: Activity{Id: 1, Subject: "Call", DueDate: "2019-06-01", UpdateTime: "2019-05-01 08:00:00"},
+			want: []string{
+				"UID:activity-1@example.com",
+				"DTSTAMP:20190501T080000Z",
+				"DTSTART;VALUE=DATE:20190601",
+				"DTEND;VALUE=DATE:20190602",
+				"SUMMARY:Call",
+				"TRANSP:TRANSPARENT",
+			},
+		},
+		{
+			name:     "timed",
+			activity: Activity{Id: 2, Subject: "Meeting", DueDate: "2019-06-01", DueTime: "09:30", Duration: "01:15", BusyFlag: true},
+			want: []string{
+				"DTSTART:20190601T093000Z",
+				"DTEND:20190601T104500Z",
+				"TRANSP:OPAQUE",
+			},
+		},
+		{
+			name: "escaped text",
+			activity: Activity{
+				Id:                3,
+				Subject:           "Lunch; with, Ann",
+				DueDate:           "2019-06-01",
+				PublicDescription: "Line one\nLine \\two",
+				Location:          Address{Value: "Main St", FormattedAddress: "Main St 1, Tallinn"},
+			},
+			want: []string{
+				`SUMMARY:Lunch\; with\, Ann`,
+				`DESCRIPTION:Line one\nLine \\two`,
+				`LOCATION:Main St 1\, Tallinn`,
+			},
+		},
+		{
+			name: "attendees",
+			activity: Activity{
+				Id:      4,
+				DueDate: "2019-06-01",
+				Attendees: []ActivityAttendee{
+					{EmailAddress: "ann@example.com", Name: `Ann "A"`, IsOrganizer: 1},
+					{EmailAddress: "bob@example.com", Status: "Accepted"},
+					{Name: "No email"},
+				},
+			},
+			want: []string{
+				`ORGANIZER;CN="Ann 'A'":mailto:ann@example.com`,
+				"ATTENDEE;PARTSTAT=ACCEPTED:mailto:bob@example.com",
+			},
+		},
+	}
+
+	calendar := &Calendar{Name: "Sales", Domain: "example.com"}
+
+	for _, tt := range tests {
+		data, err := calendar.Marshal([]Activity{tt.activity})
+
+		if err != nil {
+			t.Fatalf("Marshal of %v returned error: %v", tt.name, err)
+		}
+
+		feed := string(data)
+
+		for _, line := range tt.want {
+			if !strings.Contains(feed, "\r\n"+line+"\r\n") {
+				t.Errorf("Marshal of %v returned %q, want line %q", tt.name, feed, line)
+			}
+		}
+
+		if strings.Count(feed, "No email") != 0 {
+			t.Errorf("Marshal of %v returned an attendee without email", tt.name)
+		}
+	}
+}
+
+func TestCalendar_Marshal_skipsUndated(t *testing.T) {
+	data, err := (&Calendar{}).Marshal([]Activity{{Id: 1, Subject: "Someday"}})
+
+	if err != nil {
+		t.Fatalf("Marshal returned error: %v", err)
+	}
+
+	if bytes.Contains(data, []byte("BEGIN:VEVENT")) {
+		t.Errorf("Marshal returned %q, want no event for an activity without due date", data)
+	}
+}
+
+func TestICalWriter_line(t *testing.T) {
+	tests := []string{
+		"SUMMARY:short",
+		"SUMMARY:" + strings.Repeat("a", 200),
+		"SUMMARY:" + strings.Repeat("ä", 100),
+		"SUMMARY:" + strings.Repeat("x", 67),
+	}
+
+	for _, line := range tests {
+		var buf bytes.Buffer
+
+		w := &icalWriter{w: bufio.NewWriter(&buf)}
+		w.line(line)
+
+		if err := w.flush(); err != nil {
+			t.Fatalf("flush returned error: %v", err)
+		}
+
+		folded := strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n")
+
+		for _, part := range folded {
+			if len(part) > icalLineLength {
+				t.Errorf("line wrote %q of %v octets, want at most %v", part, len(part), icalLineLength)
+			}
+		}
+
+		if got := strings.Replace(buf.String(), "\r\n ", "", -1); got != line+"\r\n" {
+			t.Errorf("line wrote %q, unfolded to %q, want %q", buf.String(), got, line)
+		}
+	}
+}
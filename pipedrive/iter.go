@@ -0,0 +1,156 @@
+//go:build go1.23
+// +build go1.23
+
+package pipedrive
+
+import (
+	"context"
+	"iter"
+)
+
+// pages yields the items of every page of a list. fetch returns the page
+// at start together with its pagination. After an error, the error is
+// yielded once and the iteration stops.
+func pages[T any](ctx context.Context, c *Client, path string, start uint, fetch func(start uint) ([]T, Pagination, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		pager := c.NewPager(path, start)
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			items, pagination, err := fetch(pager.Start)
+
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if more, err := pager.Next(pagination, len(items)); !more {
+				if err != nil {
+					yield(zero, err)
+				}
+
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the activities of every page of List. The
+// Start of opt is the first activity returned, Limit is the page size and
+// defaults to 500. Pages are fetched as the iteration proceeds, and
+// breaking out of the loop stops fetching:
+//
+//	for activity, err := range client.Activities.All(ctx, opt) {
+//		if err != nil {
+//			return err
+//		}
+//	}
+func (s *ActivitiesService) All(ctx context.Context, opt *ActivitiesListOptions) iter.Seq2[Activity, error] {
+	var page ActivitiesListOptions
+
+	if opt != nil {
+		page = *opt
+	}
+
+	if page.Limit == 0 {
+		page.Limit = listAllPageLimit
+	}
+
+	return pages(ctx, s.client, "/activities", page.Start, func(start uint) ([]Activity, Pagination, error) {
+		page.Start = start
+		result, _, err := s.List(ctx, &page)
+
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+
+		return result.Data, result.AdditionalData.Pagination, nil
+	})
+}
+
+// All returns an iterator over the deals of every page of List, see
+// ActivitiesService.All.
+func (s *DealService) All(ctx context.Context, opt *DealsListOptions) iter.Seq2[Deal, error] {
+	var page DealsListOptions
+
+	if opt != nil {
+		page = *opt
+	}
+
+	if page.Limit == 0 {
+		page.Limit = listAllPageLimit
+	}
+
+	return pages(ctx, s.client, "/deals", page.Start, func(start uint) ([]Deal, Pagination, error) {
+		page.Start = start
+		result, _, err := s.List(ctx, &page)
+
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+
+		return result.Data, result.AdditionalData.Pagination, nil
+	})
+}
+
+// All returns an iterator over the persons of every page of
+// ListWithOptions, see ActivitiesService.All.
+func (s *PersonsService) All(ctx context.Context, opt *PersonsListOptions) iter.Seq2[Person, error] {
+	var page PersonsListOptions
+
+	if opt != nil {
+		page = *opt
+	}
+
+	if page.Limit == 0 {
+		page.Limit = listAllPageLimit
+	}
+
+	return pages(ctx, s.client, "/persons", page.Start, func(start uint) ([]Person, Pagination, error) {
+		page.Start = start
+		result, _, err := s.ListWithOptions(ctx, &page)
+
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+
+		return result.Data, result.AdditionalData.Pagination, nil
+	})
+}
+
+// All returns an iterator over the organizations of every page of
+// ListWithOptions, see ActivitiesService.All.
+func (s *OrganizationsService) All(ctx context.Context, opt *OrganizationsListOptions) iter.Seq2[Organization, error] {
+	var page OrganizationsListOptions
+
+	if opt != nil {
+		page = *opt
+	}
+
+	if page.Limit == 0 {
+		page.Limit = listAllPageLimit
+	}
+
+	return pages(ctx, s.client, "/organizations", page.Start, func(start uint) ([]Organization, Pagination, error) {
+		page.Start = start
+		result, _, err := s.ListWithOptions(ctx, &page)
+
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+
+		return result.Data, result.AdditionalData.Pagination, nil
+	})
+}
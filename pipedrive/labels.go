@@ -0,0 +1,121 @@
+package pipedrive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// labelFieldKey is the key of the label field of deals, persons and
+// organizations, an enum field whose options are the labels.
+const labelFieldKey = "label"
+
+// LabelID is the ID of the label of a deal, person or organization, 0 for
+// none. It decodes from numbers and from strings, which deals use.
+type LabelID uint
+
+// UnmarshalJSON decodes the label ID from a number, a string or null.
+func (l *LabelID) UnmarshalJSON(data []byte) error {
+	text := strings.Trim(string(data), `"`)
+
+	if text == "" || text == "null" {
+		*l = 0
+		return nil
+	}
+
+	id, err := strconv.ParseUint(text, 10, 0)
+
+	if err != nil {
+		return fmt.Errorf("invalid label value %s", data)
+	}
+
+	*l = LabelID(id)
+
+	return nil
+}
+
+// MarshalJSON encodes the label ID as number, or null for none.
+func (l LabelID) MarshalJSON() ([]byte, error) {
+	if l == 0 {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(uint(l))
+}
+
+// Label represents a label of deals, persons or organizations.
+type Label struct {
+	ID    LabelID
+	Name  string
+	Color string
+}
+
+// Labels are the labels of an entity.
+type Labels []Label
+
+// Get returns the label with the ID.
+func (l Labels) Get(id LabelID) (Label, bool) {
+	for _, label := range l {
+		if label.ID == id {
+			return label, true
+		}
+	}
+
+	return Label{}, false
+}
+
+// Find returns the label with the name, compared case-insensitively.
+func (l Labels) Find(name string) (Label, bool) {
+	for _, label := range l {
+		if strings.EqualFold(label.Name, name) {
+			return label, true
+		}
+	}
+
+	return Label{}, false
+}
+
+// Labels returns the labels of deals, persons or organizations, the
+// options of their label field.
+func (r *FieldRegistry) Labels(ctx context.Context, entity FieldEntity) (Labels, error) {
+	field, err := r.Lookup(ctx, entity, labelFieldKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	labels := make(Labels, 0, len(field.Options))
+
+	for _, option := range field.Options {
+		id, err := strconv.ParseUint(option.ID, 10, 0)
+
+		if err != nil {
+			continue
+		}
+
+		labels = append(labels, Label{ID: LabelID(id), Name: option.Label, Color: option.Color})
+	}
+
+	return labels, nil
+}
+
+// LabelID returns the ID of the label with the name, for the Label field
+// of the create and update options. The error is a *ValidationError when
+// there is no such label.
+func (r *FieldRegistry) LabelID(ctx context.Context, entity FieldEntity, name string) (uint, error) {
+	labels, err := r.Labels(ctx, entity)
+
+	if err != nil {
+		return 0, err
+	}
+
+	label, ok := labels.Find(name)
+
+	if !ok {
+		return 0, enumError(labelFieldKey, name)
+	}
+
+	return uint(label.ID), nil
+}
@@ -0,0 +1,257 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// LegacyTeamsService handles legacy teams related
+// methods of the Pipedrive API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/LegacyTeams
+type LegacyTeamsService service
+
+// Team represents a Pipedrive legacy team.
+type Team struct {
+	ID              int    `json:"id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	ManagerID       int    `json:"manager_id"`
+	Users           []int  `json:"users"`
+	ActiveFlag      int    `json:"active_flag"`
+	DeletedFlag     int    `json:"deleted_flag"`
+	AddTime         string `json:"add_time"`
+	CreatedByUserID int    `json:"created_by_user_id"`
+}
+
+func (t Team) String() string {
+	return Stringify(t)
+}
+
+// TeamsResponse represents multiple teams response.
+type TeamsResponse struct {
+	Success bool   `json:"success"`
+	Data    []Team `json:"data"`
+}
+
+// TeamResponse represents single team response.
+type TeamResponse struct {
+	Success bool `json:"success"`
+	Data    Team `json:"data"`
+}
+
+// TeamUsersResponse represents team users response.
+type TeamUsersResponse struct {
+	Success bool  `json:"success"`
+	Data    []int `json:"data"`
+}
+
+// LegacyTeamsListOptions specifices the optional parameters to the
+// LegacyTeamsService.List and LegacyTeamsService.ListForUser methods.
+type LegacyTeamsListOptions struct {
+	OrderBy   string `url:"order_by,omitempty"`
+	SkipUsers uint8  `url:"skip_users,omitempty"`
+}
+
+// List returns data about teams within the company.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/LegacyTeams/get_legacyTeams
+func (s *LegacyTeamsService) List(ctx context.Context, opt *LegacyTeamsListOptions) (*TeamsResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "/legacyTeams", opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *TeamsResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// GetByID returns data about a specific team.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/LegacyTeams/get_legacyTeams_id
+func (s *LegacyTeamsService) GetByID(ctx context.Context, id int) (*TeamResponse, *Response, error) {
+	uri := fmt.Sprintf("/legacyTeams/%v", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *TeamResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// ListForUser returns data about all teams which have a specific user as a member.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/LegacyTeams/get_legacyTeams_user_id
+func (s *LegacyTeamsService) ListForUser(ctx context.Context, userID int, opt *LegacyTeamsListOptions) (*TeamsResponse, *Response, error) {
+	uri := fmt.Sprintf("/legacyTeams/user/%v", userID)
+	req, err := s.client.NewRequest(http.MethodGet, uri, opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *TeamsResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// LegacyTeamCreateOptions specifices the parameters to the
+// LegacyTeamsService.Create method.
+type LegacyTeamCreateOptions struct {
+	Name        string `json:"name"`
+	ManagerID   uint   `json:"manager_id"`
+	Description string `json:"description,omitempty"`
+	Users       []int  `json:"users,omitempty"`
+}
+
+// Create a new team.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/LegacyTeams/post_legacyTeams
+func (s *LegacyTeamsService) Create(ctx context.Context, opt *LegacyTeamCreateOptions) (*TeamResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "/legacyTeams", nil, opt)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *TeamResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// LegacyTeamUpdateOptions specifices the optional parameters to the
+// LegacyTeamsService.Update method.
+type LegacyTeamUpdateOptions struct {
+	Name        string      `json:"name,omitempty"`
+	ManagerID   uint        `json:"manager_id,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Users       []int       `json:"users,omitempty"`
+	ActiveFlag  *ActiveFlag `json:"active_flag,omitempty"`
+	DeletedFlag *ActiveFlag `json:"deleted_flag,omitempty"`
+}
+
+// Update an existing team.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/LegacyTeams/put_legacyTeams_id
+func (s *LegacyTeamsService) Update(ctx context.Context, id int, opt *LegacyTeamUpdateOptions) (*TeamResponse, *Response, error) {
+	uri := fmt.Sprintf("/legacyTeams/%v", id)
+	req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *TeamResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// ListUsers returns IDs of all users belonging to the team.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/LegacyTeams/get_legacyTeams_id_users
+func (s *LegacyTeamsService) ListUsers(ctx context.Context, id int) (*TeamUsersResponse, *Response, error) {
+	uri := fmt.Sprintf("/legacyTeams/%v/users", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *TeamUsersResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// AddUsers adds users to an existing team.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/LegacyTeams/post_legacyTeams_id_users
+func (s *LegacyTeamsService) AddUsers(ctx context.Context, id int, userIDs []int) (*TeamUsersResponse, *Response, error) {
+	uri := fmt.Sprintf("/legacyTeams/%v/users", id)
+	req, err := s.client.NewRequest(http.MethodPost, uri, nil, struct {
+		Users []int `json:"users"`
+	}{
+		userIDs,
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *TeamUsersResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// DeleteUsers removes users from an existing team.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/LegacyTeams/delete_legacyTeams_id_users
+func (s *LegacyTeamsService) DeleteUsers(ctx context.Context, id int, userIDs []int) (*TeamUsersResponse, *Response, error) {
+	uri := fmt.Sprintf("/legacyTeams/%v/users", id)
+	req, err := s.client.NewRequest(http.MethodDelete, uri, nil, struct {
+		Users []int `json:"users"`
+	}{
+		userIDs,
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *TeamUsersResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
@@ -0,0 +1,130 @@
+package pipedrive
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrStarted is returned by Start when a subsystem was started before.
+// Subsystems that drain their work are started once, also after Shutdown.
+var ErrStarted = errors.New("already started")
+
+// Lifecycle is implemented by the background subsystems, Queue, Outbox,
+// WebhookMonitor, WebhookHandler and the sync engine of the syncer
+// package, so services embedding them can start them with their own
+// startup and stop them cleanly.
+type Lifecycle interface {
+	// Start starts the subsystem in the background and returns. The
+	// subsystem stops at once when ctx is done.
+	Start(ctx context.Context) error
+
+	// Shutdown stops accepting new work, drains the work already
+	// accepted and returns once the subsystem stopped. When ctx is done
+	// first, the remaining work is canceled and ctx.Err() is returned.
+	Shutdown(ctx context.Context) error
+}
+
+// LifecycleRunner implements Lifecycle for a subsystem with a Run
+// method, such as the subsystems of this package and the sync engine of
+// the syncer package. Start runs it in the background, and Shutdown
+// closes the channel of Draining, which Run watches to return nil once it
+// drained. The zero value is ready to use.
+type LifecycleRunner struct {
+	mu      sync.Mutex
+	started bool
+	cancel  context.CancelFunc
+	done    chan struct{}
+	err     error
+	drain   chan struct{}
+}
+
+// Draining returns a channel that is closed when Shutdown is called.
+func (l *LifecycleRunner) Draining() <-chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.drain == nil {
+		l.drain = make(chan struct{})
+	}
+
+	return l.drain
+}
+
+// IsDraining reports whether Shutdown was called.
+func (l *LifecycleRunner) IsDraining() bool {
+	select {
+	case <-l.Draining():
+		return true
+	default:
+		return false
+	}
+}
+
+// Start calls run in the background with a ctx that is canceled when ctx
+// is done or Shutdown times out. A runner is started once, ErrStarted is
+// returned after, also after Shutdown.
+func (l *LifecycleRunner) Start(ctx context.Context, run func(context.Context) error) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.started {
+		return ErrStarted
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	l.started = true
+	l.cancel = cancel
+	l.done = make(chan struct{})
+
+	go func() {
+		err := run(ctx)
+		cancel()
+
+		l.mu.Lock()
+		l.err = err
+		close(l.done)
+		l.mu.Unlock()
+	}()
+
+	return nil
+}
+
+// Shutdown closes the channel of Draining and waits for run to return,
+// then returns its error. When ctx is done first, run is canceled and
+// ctx.Err() is returned once it returned.
+func (l *LifecycleRunner) Shutdown(ctx context.Context) error {
+	l.mu.Lock()
+
+	if l.drain == nil {
+		l.drain = make(chan struct{})
+	}
+
+	select {
+	case <-l.drain:
+	default:
+		close(l.drain)
+	}
+
+	done, cancel := l.done, l.cancel
+	l.mu.Unlock()
+
+	if done == nil {
+		return nil
+	}
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		cancel()
+		<-done
+
+		return ctx.Err()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.err
+}
@@ -0,0 +1,95 @@
+package pipedrive
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLifecycleRunner_drains(t *testing.T) {
+	var l LifecycleRunner
+
+	drained := false
+
+	err := l.Start(context.Background(), func(ctx context.Context) error {
+		<-l.Draining()
+		drained = true
+
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("Start returned error: %v", err)
+	}
+
+	if err := l.Start(context.Background(), nil); err != ErrStarted {
+		t.Errorf("Start of a started runner returned %v, want %v", err, ErrStarted)
+	}
+
+	if err := l.Shutdown(context.Background()); err != nil {
+		t.Errorf("Shutdown returned error: %v", err)
+	}
+
+	if !drained {
+		t.Error("Shutdown returned before run drained")
+	}
+
+	if err := l.Start(context.Background(), nil); err != ErrStarted {
+		t.Errorf("Start after Shutdown returned %v, want %v", err, ErrStarted)
+	}
+}
+
+func TestLifecycleRunner_shutdownReturnsRunError(t *testing.T) {
+	var l LifecycleRunner
+
+	want := errors.New("failed")
+
+	l.Start(context.Background(), func(ctx context.Context) error {
+		<-l.Draining()
+		return want
+	})
+
+	if err := l.Shutdown(context.Background()); err != want {
+		t.Errorf("Shutdown returned %v, want %v", err, want)
+	}
+}
+
+func TestLifecycleRunner_shutdownTimeoutCancelsRun(t *testing.T) {
+	var l LifecycleRunner
+
+	canceled := make(chan struct{})
+
+	// Run ignores draining and stops only when canceled.
+	l.Start(context.Background(), func(ctx context.Context) error {
+		<-ctx.Done()
+		close(canceled)
+
+		return ctx.Err()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.Shutdown(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Shutdown returned %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	select {
+	case <-canceled:
+	default:
+		t.Error("Shutdown returned before run was canceled")
+	}
+}
+
+func TestLifecycleRunner_shutdownBeforeStart(t *testing.T) {
+	var l LifecycleRunner
+
+	if err := l.Shutdown(context.Background()); err != nil {
+		t.Errorf("Shutdown before Start returned error: %v", err)
+	}
+
+	if !l.IsDraining() {
+		t.Error("IsDraining returned false after Shutdown")
+	}
+}
@@ -0,0 +1,85 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// LostReasonsService handles the reasons deals are lost for, as
+// configured in the company settings.
+type LostReasonsService service
+
+// LostReasons represents the lost reasons of a company.
+type LostReasons struct {
+	// FreeForm reports whether deals can be lost for any reason, not only
+	// the predefined ones.
+	FreeForm bool
+	Reasons  []ReasonsOptions
+}
+
+// Find returns the predefined reason with the label, compared
+// case-insensitively.
+func (r *LostReasons) Find(label string) (ReasonsOptions, bool) {
+	label = strings.TrimSpace(label)
+
+	for _, reason := range r.Reasons {
+		if strings.EqualFold(reason.Label, label) {
+			return reason, true
+		}
+	}
+
+	return ReasonsOptions{}, false
+}
+
+// Validate checks that a deal can be lost for the reason. Only the
+// predefined reasons are valid unless FreeForm is set.
+func (r *LostReasons) Validate(reason string) error {
+	if r.FreeForm {
+		return nil
+	}
+
+	if _, ok := r.Find(reason); !ok {
+		return &ValidationError{Field: "lost_reason", Message: fmt.Sprintf("%q is not a predefined reason", reason)}
+	}
+
+	return nil
+}
+
+// List returns the lost reasons of the company. Reasons are free form
+// when the lost reason field is a text field or has no options.
+func (s *LostReasonsService) List(ctx context.Context) (*LostReasons, *Response, error) {
+	record, resp, err := s.client.Deals.DealLostReasons(ctx)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	fieldType := FieldType(record.Data.FieldType)
+
+	return &LostReasons{
+		FreeForm: fieldType == FieldTypeVarchar || fieldType == FieldTypeText || len(record.Data.Options) == 0,
+		Reasons:  record.Data.Options,
+	}, resp, nil
+}
+
+// Lose marks a deal as lost for the reason, which is validated against
+// the lost reasons first. Predefined reasons are sent with their label as
+// configured.
+func (s *LostReasonsService) Lose(ctx context.Context, dealID int, reason string) (*Response, error) {
+	reasons, resp, err := s.List(ctx)
+
+	if err != nil {
+		return resp, err
+	}
+
+	if err := reasons.Validate(reason); err != nil {
+		return nil, err
+	}
+
+	if predefined, ok := reasons.Find(reason); ok {
+		reason = predefined.Label
+	}
+
+	return s.client.Deals.Lose(ctx, dealID, reason)
+}
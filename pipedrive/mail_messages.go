@@ -0,0 +1,101 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// MailMessagesService handles mail messages related
+// methods of the Pipedrive API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Mailbox
+type MailMessagesService service
+
+// MailParticipant represents a sender or recipient of a mail message.
+type MailParticipant struct {
+	ID                 int    `json:"id"`
+	EmailAddress       string `json:"email_address"`
+	Name               string `json:"name"`
+	LinkedPersonID     int    `json:"linked_person_id"`
+	LinkedPersonName   string `json:"linked_person_name"`
+	MailMessagePartyID int    `json:"mail_message_party_id"`
+}
+
+// MailMessage represents a Pipedrive mail message.
+type MailMessage struct {
+	ID                          int               `json:"id"`
+	From                        []MailParticipant `json:"from"`
+	To                          []MailParticipant `json:"to"`
+	Cc                          []MailParticipant `json:"cc"`
+	Bcc                         []MailParticipant `json:"bcc"`
+	Body                        string            `json:"body,omitempty"`
+	BodyURL                     string            `json:"body_url"`
+	AccountID                   string            `json:"account_id"`
+	UserID                      int               `json:"user_id"`
+	MailThreadID                int               `json:"mail_thread_id"`
+	Subject                     string            `json:"subject"`
+	Snippet                     string            `json:"snippet"`
+	MailTrackingStatus          string            `json:"mail_tracking_status"`
+	MailLinkTrackingEnabledFlag int               `json:"mail_link_tracking_enabled_flag"`
+	ReadFlag                    int               `json:"read_flag"`
+	Draft                       string            `json:"draft"`
+	DraftFlag                   int               `json:"draft_flag"`
+	SyncedFlag                  int               `json:"synced_flag"`
+	DeletedFlag                 int               `json:"deleted_flag"`
+	HasBodyFlag                 int               `json:"has_body_flag"`
+	SentFlag                    int               `json:"sent_flag"`
+	SentFromPipedriveFlag       int               `json:"sent_from_pipedrive_flag"`
+	SmartBccFlag                int               `json:"smart_bcc_flag"`
+	MessageTime                 string            `json:"message_time"`
+	AddTime                     string            `json:"add_time"`
+	UpdateTime                  string            `json:"update_time"`
+	HasAttachmentsFlag          int               `json:"has_attachments_flag"`
+	HasInlineAttachmentsFlag    int               `json:"has_inline_attachments_flag"`
+	HasRealAttachmentsFlag      int               `json:"has_real_attachments_flag"`
+}
+
+func (m MailMessage) String() string {
+	return Stringify(m)
+}
+
+// MailMessagesResponse represents multiple mail messages response.
+type MailMessagesResponse struct {
+	Success bool          `json:"success"`
+	Data    []MailMessage `json:"data"`
+}
+
+// MailMessageResponse represents single mail message response.
+type MailMessageResponse struct {
+	Success bool        `json:"success"`
+	Data    MailMessage `json:"data"`
+}
+
+// MailMessageGetOptions specifices the optional parameters to the
+// MailMessagesService.GetByID method.
+type MailMessageGetOptions struct {
+	IncludeBody uint8 `url:"include_body,omitempty"`
+}
+
+// GetByID returns a specific mail message. Set IncludeBody to 1 to
+// have the message body returned in Body.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Mailbox/get_mailbox_mailMessages_id
+func (s *MailMessagesService) GetByID(ctx context.Context, id int, opt *MailMessageGetOptions) (*MailMessageResponse, *Response, error) {
+	uri := fmt.Sprintf("/mailbox/mailMessages/%v", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *MailMessageResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
@@ -0,0 +1,208 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// MailThreadsService handles mail threads related
+// methods of the Pipedrive API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Mailbox
+type MailThreadsService service
+
+// MailFolder represents a mailbox folder.
+type MailFolder string
+
+// MailFolder constants.
+const (
+	MailFolderInbox   MailFolder = "inbox"
+	MailFolderDrafts  MailFolder = "drafts"
+	MailFolderSent    MailFolder = "sent"
+	MailFolderArchive MailFolder = "archive"
+)
+
+// MailThreadParties represents the participants of a mail thread.
+type MailThreadParties struct {
+	To   []MailParticipant `json:"to"`
+	From []MailParticipant `json:"from"`
+}
+
+// MailThread represents a Pipedrive mail thread.
+type MailThread struct {
+	ID                           int               `json:"id"`
+	AccountID                    string            `json:"account_id"`
+	UserID                       int               `json:"user_id"`
+	Subject                      string            `json:"subject"`
+	Snippet                      string            `json:"snippet"`
+	SnippetDraft                 string            `json:"snippet_draft"`
+	SnippetSent                  string            `json:"snippet_sent"`
+	Parties                      MailThreadParties `json:"parties"`
+	DraftsParties                []MailParticipant `json:"drafts_parties"`
+	Folders                      []MailFolder      `json:"folders"`
+	Version                      float64           `json:"version"`
+	MessageCount                 int               `json:"message_count"`
+	ReadFlag                     int               `json:"read_flag"`
+	MailTrackingStatus           string            `json:"mail_tracking_status"`
+	HasAttachmentsFlag           int               `json:"has_attachments_flag"`
+	HasInlineAttachmentsFlag     int               `json:"has_inline_attachments_flag"`
+	HasRealAttachmentsFlag       int               `json:"has_real_attachments_flag"`
+	HasDraftFlag                 int               `json:"has_draft_flag"`
+	HasSentFlag                  int               `json:"has_sent_flag"`
+	DeletedFlag                  int               `json:"deleted_flag"`
+	SyncedFlag                   int               `json:"synced_flag"`
+	SmartBccFlag                 int               `json:"smart_bcc_flag"`
+	MailLinkTrackingEnabledFlag  int               `json:"mail_link_tracking_enabled_flag"`
+	ArchivedFlag                 int               `json:"archived_flag"`
+	SharedFlag                   int               `json:"shared_flag"`
+	ExternalDeletedFlag          int               `json:"external_deleted_flag"`
+	FirstMessageToMeFlag         int               `json:"first_message_to_me_flag"`
+	AllMessagesSentFlag          int               `json:"all_messages_sent_flag"`
+	LastMessageTimestamp         string            `json:"last_message_timestamp"`
+	FirstMessageTimestamp        string            `json:"first_message_timestamp"`
+	LastMessageSentTimestamp     string            `json:"last_message_sent_timestamp"`
+	LastMessageReceivedTimestamp string            `json:"last_message_received_timestamp"`
+	AddTime                      string            `json:"add_time"`
+	UpdateTime                   string            `json:"update_time"`
+	DealID                       int               `json:"deal_id"`
+	DealStatus                   string            `json:"deal_status"`
+	LeadID                       string            `json:"lead_id"`
+}
+
+func (m MailThread) String() string {
+	return Stringify(m)
+}
+
+// MailThreadsResponse represents multiple mail threads response.
+type MailThreadsResponse struct {
+	Success        bool           `json:"success"`
+	Data           []MailThread   `json:"data"`
+	AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// MailThreadResponse represents single mail thread response.
+type MailThreadResponse struct {
+	Success bool       `json:"success"`
+	Data    MailThread `json:"data"`
+}
+
+// MailThreadsListOptions specifices the optional parameters to the
+// MailThreadsService.List method.
+type MailThreadsListOptions struct {
+	Folder MailFolder `url:"folder"`
+	Start  uint       `url:"start,omitempty"`
+	Limit  uint       `url:"limit,omitempty"`
+}
+
+// List returns mail threads in a specific folder ordered by the most
+// recent message within.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Mailbox/get_mailbox_mailThreads
+func (s *MailThreadsService) List(ctx context.Context, opt *MailThreadsListOptions) (*MailThreadsResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "/mailbox/mailThreads", opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *MailThreadsResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// GetByID returns a specific mail thread.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Mailbox/get_mailbox_mailThreads_id
+func (s *MailThreadsService) GetByID(ctx context.Context, id int) (*MailThreadResponse, *Response, error) {
+	uri := fmt.Sprintf("/mailbox/mailThreads/%v", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *MailThreadResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// MailThreadUpdateOptions specifices the optional parameters to the
+// MailThreadsService.Update method.
+type MailThreadUpdateOptions struct {
+	DealID       uint   `json:"deal_id,omitempty"`
+	LeadID       string `json:"lead_id,omitempty"`
+	SharedFlag   *uint8 `json:"shared_flag,omitempty"`
+	ReadFlag     *uint8 `json:"read_flag,omitempty"`
+	ArchivedFlag *uint8 `json:"archived_flag,omitempty"`
+}
+
+// Update the properties of a mail thread.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Mailbox/put_mailbox_mailThreads_id
+func (s *MailThreadsService) Update(ctx context.Context, id int, opt *MailThreadUpdateOptions) (*MailThreadResponse, *Response, error) {
+	uri := fmt.Sprintf("/mailbox/mailThreads/%v", id)
+	req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *MailThreadResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// Delete marks a mail thread as deleted.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Mailbox/delete_mailbox_mailThreads_id
+func (s *MailThreadsService) Delete(ctx context.Context, id int) (*Response, error) {
+	uri := fmt.Sprintf("/mailbox/mailThreads/%v", id)
+	req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListMessages returns all mail messages inside a specific mail thread.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Mailbox/get_mailbox_mailThreads_id_mailMessages
+func (s *MailThreadsService) ListMessages(ctx context.Context, id int) (*MailMessagesResponse, *Response, error) {
+	uri := fmt.Sprintf("/mailbox/mailThreads/%v/mailMessages", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *MailMessagesResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
@@ -0,0 +1,293 @@
+package pipedrive
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const defaultOAuthURL = "https://oauth.pipedrive.com/"
+
+// MarketplaceApp implements the OAuth flow and the callbacks of a
+// Pipedrive Marketplace app.
+type MarketplaceApp struct {
+	ClientID     string
+	ClientSecret string
+
+	// RedirectURL is the callback URL registered for the app.
+	RedirectURL string
+
+	// OAuthURL is the base URL of the OAuth server,
+	// https://oauth.pipedrive.com/ when empty.
+	OAuthURL string
+
+	// HTTPClient sends the token requests, http.DefaultClient when nil.
+	HTTPClient *http.Client
+}
+
+// OAuthToken represents the tokens granted to an installation of an app.
+type OAuthToken struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	RefreshToken string `json:"refresh_token"`
+	Scope        string `json:"scope"`
+	ExpiresIn    int    `json:"expires_in"`
+
+	// APIDomain is the URL of the company the app was installed for, for
+	// example https://example.pipedrive.com.
+	APIDomain string `json:"api_domain"`
+
+	// Expiry is when the access token expires, set from ExpiresIn when
+	// the token is received.
+	Expiry time.Time `json:"-"`
+}
+
+// Expired reports whether the access token has expired or expires within
+// the next minute.
+func (t *OAuthToken) Expired() bool {
+	return !t.Expiry.IsZero() && time.Now().Add(time.Minute).After(t.Expiry)
+}
+
+// OAuthError is returned when the OAuth server rejects a token request or
+// the user denies the installation.
+type OAuthError struct {
+	StatusCode  int
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (e *OAuthError) Error() string {
+	if e.Description == "" {
+		return fmt.Sprintf("oauth: %v", e.Code)
+	}
+
+	return fmt.Sprintf("oauth: %v: %v", e.Code, e.Description)
+}
+
+// AuthorizeURL returns the URL to send users to for installing the app.
+// The state is passed back to the redirect URL and must be checked there.
+func (a *MarketplaceApp) AuthorizeURL(state string) string {
+	parameters := url.Values{}
+	parameters.Set("client_id", a.ClientID)
+	parameters.Set("redirect_uri", a.RedirectURL)
+
+	if state != "" {
+		parameters.Set("state", state)
+	}
+
+	return a.oauthURL() + "oauth/authorize?" + parameters.Encode()
+}
+
+// HandleInstall handles the redirect after a user installed the app. It
+// checks the state, which must not be empty, then exchanges the
+// authorization code for tokens.
+func (a *MarketplaceApp) HandleInstall(ctx context.Context, r *http.Request, state string) (*OAuthToken, error) {
+	if state == "" {
+		return nil, errors.New("oauth: no state to check")
+	}
+
+	query := r.URL.Query()
+
+	if code := query.Get("error"); code != "" {
+		return nil, &OAuthError{Code: code, Description: query.Get("error_description")}
+	}
+
+	if subtle.ConstantTimeCompare([]byte(query.Get("state")), []byte(state)) != 1 {
+		return nil, errors.New("oauth: state does not match")
+	}
+
+	code := query.Get("code")
+
+	if code == "" {
+		return nil, errors.New("oauth: no authorization code")
+	}
+
+	return a.Exchange(ctx, code)
+}
+
+// Exchange exchanges an authorization code for tokens.
+func (a *MarketplaceApp) Exchange(ctx context.Context, code string) (*OAuthToken, error) {
+	return a.token(ctx, url.Values{
+		"grant_type":   {"authorization_code"},
+		"code":         {code},
+		"redirect_uri": {a.RedirectURL},
+	})
+}
+
+// Refresh returns new tokens for a refresh token.
+func (a *MarketplaceApp) Refresh(ctx context.Context, refreshToken string) (*OAuthToken, error) {
+	return a.token(ctx, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {refreshToken},
+	})
+}
+
+func (a *MarketplaceApp) token(ctx context.Context, form url.Values) (*OAuthToken, error) {
+	req, err := http.NewRequest(http.MethodPost, a.oauthURL()+"oauth/token", strings.NewReader(form.Encode()))
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.SetBasicAuth(a.ClientID, a.ClientSecret)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("User-Agent", defaultUserAgent)
+
+	httpClient := a.HTTPClient
+
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req.WithContext(ctx))
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		oauthErr := &OAuthError{StatusCode: resp.StatusCode}
+
+		if json.Unmarshal(data, oauthErr) != nil || oauthErr.Code == "" {
+			oauthErr.Code = http.StatusText(resp.StatusCode)
+		}
+
+		return nil, oauthErr
+	}
+
+	var token OAuthToken
+
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, err
+	}
+
+	if token.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+
+	return &token, nil
+}
+
+// NewClient returns a client acting for the installation the token was
+// granted to.
+func (a *MarketplaceApp) NewClient(token *OAuthToken) *Client {
+	c := NewClient(&Config{APIKey: token.AccessToken, TokenAuth: TokenOAuth})
+
+	if domain := strings.TrimPrefix(token.APIDomain, hostProtocol+"://"); domain != "" {
+		c.BaseURL = &url.URL{Path: strings.TrimSuffix(domain, "/") + "/"}
+	}
+
+	return c
+}
+
+// AppUninstall represents the callback Pipedrive sends when a user
+// uninstalls the app.
+type AppUninstall struct {
+	ClientID  string `json:"client_id"`
+	CompanyID int    `json:"company_id"`
+	UserID    int    `json:"user_id"`
+	Timestamp string `json:"timestamp"`
+}
+
+// ParseUninstall parses the uninstall callback, a DELETE request to the
+// redirect URL authenticated with the client ID and secret of the app.
+func (a *MarketplaceApp) ParseUninstall(r *http.Request) (*AppUninstall, error) {
+	if r.Method != http.MethodDelete {
+		return nil, fmt.Errorf("uninstall callback: unexpected method %v", r.Method)
+	}
+
+	user, password, ok := r.BasicAuth()
+
+	userMatches := subtle.ConstantTimeCompare([]byte(user), []byte(a.ClientID)) == 1
+	passwordMatches := subtle.ConstantTimeCompare([]byte(password), []byte(a.ClientSecret)) == 1
+
+	if !ok || !userMatches || !passwordMatches {
+		return nil, errors.New("uninstall callback: invalid credentials")
+	}
+
+	var uninstall AppUninstall
+
+	if err := json.NewDecoder(r.Body).Decode(&uninstall); err != nil {
+		return nil, fmt.Errorf("uninstall callback: %v", err)
+	}
+
+	return &uninstall, nil
+}
+
+// UninstallHandler returns a handler for the uninstall callback, calling
+// onUninstall with every valid callback. The handler answers with 401 when
+// the callback cannot be authenticated and 500 when onUninstall fails, so
+// Pipedrive retries it.
+func (a *MarketplaceApp) UninstallHandler(onUninstall func(context.Context, *AppUninstall) error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uninstall, err := a.ParseUninstall(r)
+
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		if err := onUninstall(r.Context(), uninstall); err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// oauthURL returns the base URL of the OAuth server with a trailing slash.
+func (a *MarketplaceApp) oauthURL() string {
+	if a.OAuthURL == "" {
+		return defaultOAuthURL
+	}
+
+	return strings.TrimSuffix(a.OAuthURL, "/") + "/"
+}
+
+// DeleteBySubscriptionURL deletes the webhooks whose subscription URL
+// starts with prefix, for example all webhooks of an app when it is
+// uninstalled. It returns the number of deleted webhooks. The prefix
+// must not be empty, which would delete the webhooks of every app.
+func (s *WebhooksService) DeleteBySubscriptionURL(ctx context.Context, prefix string) (int, *Response, error) {
+	if prefix == "" {
+		return 0, nil, requiredError("prefix")
+	}
+
+	webhooks, resp, err := s.List(ctx)
+
+	if err != nil {
+		return 0, resp, err
+	}
+
+	deleted := 0
+
+	for _, webhook := range webhooks.Data {
+		if !strings.HasPrefix(webhook.SubscriptionURL, prefix) {
+			continue
+		}
+
+		if resp, err = s.Delete(ctx, webhook.ID); err != nil {
+			return deleted, resp, err
+		}
+
+		deleted++
+	}
+
+	return deleted, resp, nil
+}
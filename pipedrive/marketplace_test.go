@@ -0,0 +1,37 @@
+package pipedrive
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMarketplaceApp_HandleInstall_emptyState(t *testing.T) {
+	app := &MarketplaceApp{ClientID: "id", ClientSecret: "secret"}
+	r := httptest.NewRequest(http.MethodGet, "/callback?code=code", nil)
+
+	if _, err := app.HandleInstall(context.Background(), r, ""); err == nil {
+		t.Error("HandleInstall with an empty state returned no error")
+	}
+}
+
+func TestWebhooksService_DeleteBySubscriptionURL_emptyPrefix(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/webhooks", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("DeleteBySubscriptionURL with an empty prefix listed the webhooks")
+		writeJSON(w, http.StatusOK, `{"success": true, "data": [{"id": 1, "subscription_url": "https://example.com/"}]}`)
+	})
+
+	deleted, _, err := client.Webhooks.DeleteBySubscriptionURL(context.Background(), "")
+
+	if err == nil {
+		t.Error("DeleteBySubscriptionURL with an empty prefix returned no error")
+	}
+
+	if deleted != 0 {
+		t.Errorf("DeleteBySubscriptionURL with an empty prefix deleted %v webhooks, want 0", deleted)
+	}
+}
@@ -0,0 +1,75 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// MeetingsService handles video call integration related
+// methods of the Pipedrive API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Meetings
+type MeetingsService service
+
+// UserProviderLinkResponse represents user provider link response.
+type UserProviderLinkResponse struct {
+	Success bool `json:"success"`
+	Data    struct {
+		Message string `json:"message"`
+	} `json:"data"`
+}
+
+// UserProviderLinkCreateOptions specifices the parameters to the
+// MeetingsService.CreateUserProviderLink method.
+type UserProviderLinkCreateOptions struct {
+	UserProviderID      string `json:"user_provider_id"`
+	UserID              uint   `json:"user_id"`
+	CompanyID           uint   `json:"company_id"`
+	MarketplaceClientID string `json:"marketplace_client_id"`
+}
+
+// CreateUserProviderLink links a user with the installed video call
+// integration, so meetings of the user can be attached to activities.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Meetings/post_meetings_userProviderLinks
+func (s *MeetingsService) CreateUserProviderLink(ctx context.Context, opt *UserProviderLinkCreateOptions) (*UserProviderLinkResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "/meetings/userProviderLinks", nil, opt)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *UserProviderLinkResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// DeleteUserProviderLink removes the link between a user and the
+// installed video call integration.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Meetings/delete_meetings_userProviderLinks_id
+func (s *MeetingsService) DeleteUserProviderLink(ctx context.Context, id string) (*UserProviderLinkResponse, *Response, error) {
+	uri := fmt.Sprintf("/meetings/userProviderLinks/%v", id)
+	req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *UserProviderLinkResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
@@ -8,10 +8,10 @@ import (
 // NoteFieldsService handles note field related
 // methods of the Pipedrive API.
 //
-// Pipedrive API dcos: https://developers.pipedrive.com/docs/api/v1/#!/ActivityTypes
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/NoteFields
 type NoteFieldsService service
 
-// Option represents a Pipedrive option for note field.
+// Option represents an option of an enum or set field.
 type Option struct {
 	ID    int    `json:"id,omitempty"`
 	Label string `json:"label,omitempty"`
@@ -19,22 +19,22 @@ type Option struct {
 
 // NoteField represents a Pipedrive note field.
 type NoteField struct {
-	ID                   int      `json:"id,omitempty"`
-	Key                  string   `json:"key,omitempty"`
-	Name                 string   `json:"name,omitempty"`
-	ActiveFlag           bool     `json:"active_flag,omitempty"`
-	FieldType            string   `json:"field_type,omitempty"`
-	EditFlag             int      `json:"edit_flag,omitempty"`
-	MandatoryFlag        bool     `json:"mandatory_flag,omitempty"`
-	VisibleInExportsFlag bool     `json:"visible_in_exports_flag,omitempty"`
-	Options              []Option `json:"options,omitempty"`
+	ID                   int       `json:"id,omitempty"`
+	Key                  string    `json:"key,omitempty"`
+	Name                 string    `json:"name,omitempty"`
+	ActiveFlag           bool      `json:"active_flag,omitempty"`
+	FieldType            FieldType `json:"field_type,omitempty"`
+	EditFlag             bool      `json:"edit_flag,omitempty"`
+	MandatoryFlag        bool      `json:"mandatory_flag,omitempty"`
+	VisibleInExportsFlag bool      `json:"visible_in_exports_flag,omitempty"`
+	Options              []Option  `json:"options,omitempty"`
 }
 
 func (nf NoteField) String() string {
 	return Stringify(nf)
 }
 
-// NoteFieldsResponse represents multiple note fields esponse.
+// NoteFieldsResponse represents multiple note fields response.
 type NoteFieldsResponse struct {
 	Success        bool           `json:"success,omitempty"`
 	Data           []NoteField    `json:"data,omitempty"`
@@ -61,3 +61,14 @@ func (s *NoteFieldsService) List(ctx context.Context) (*NoteFieldsResponse, *Res
 
 	return record, resp, nil
 }
+
+// ByKey returns note fields keyed by their API key.
+func (r *NoteFieldsResponse) ByKey() map[string]NoteField {
+	fields := make(map[string]NoteField, len(r.Data))
+
+	for _, field := range r.Data {
+		fields[field.Key] = field
+	}
+
+	return fields
+}
@@ -2,6 +2,7 @@ package pipedrive
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 )
@@ -19,21 +20,46 @@ type Note struct {
 	DealID                   int    `json:"deal_id,omitempty"`
 	PersonID                 int    `json:"person_id,omitempty"`
 	OrgID                    int    `json:"org_id,omitempty"`
-	LeadID                   int    `json:"lead_id,omitempty"`
+	LeadID                   string `json:"lead_id,omitempty"`
 	Content                  string `json:"content,omitempty"`
 	AddTime                  string `json:"add_time,omitempty"`
 	UpdateTime               string `json:"update_time,omitempty"`
 	ActiveFlag               bool   `json:"active_flag,omitempty"`
+	PinnedToLeadFlag         bool   `json:"pinned_to_lead_flag,omitempty"`
 	PinnedToDealFlag         bool   `json:"pinned_to_deal_flag,omitempty"`
 	PinnedToPersonFlag       bool   `json:"pinned_to_person_flag,omitempty"`
 	PinnedToOrganizationFlag bool   `json:"pinned_to_organization_flag,omitempty"`
 	LastUpdateUserID         int    `json:"last_update_user_id,omitempty"`
+
+	// Raw holds the JSON the note was decoded from.
+	Raw json.RawMessage `json:"-"`
 }
 
 func (n Note) String() string {
 	return Stringify(n)
 }
 
+// UnmarshalJSON decodes a note and keeps its JSON.
+func (n *Note) UnmarshalJSON(data []byte) error {
+	return n.decode(Codec{}, data)
+}
+
+// decode implements modelDecoder.
+func (n *Note) decode(codec Codec, data []byte) error {
+	type note Note
+
+	var v note
+
+	if err := codec.unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*n = Note(v)
+	n.Raw = copyRaw(data)
+
+	return nil
+}
+
 // NotesResponse represents multiple notes response.
 type NotesResponse struct {
 	Success        bool           `json:"success,omitempty"`
@@ -47,22 +73,44 @@ type NoteResponse struct {
 	Data    Note `json:"data,omitempty"`
 }
 
+// NotesListOptions specifices the optional parameters to the
+// NotesService.ListWithOptions method. Dates are expected in YYYY-MM-DD format.
+type NotesListOptions struct {
+	UserID                   uint   `url:"user_id,omitempty"`
+	LeadID                   string `url:"lead_id,omitempty"`
+	DealID                   uint   `url:"deal_id,omitempty"`
+	PersonID                 uint   `url:"person_id,omitempty"`
+	OrgID                    uint   `url:"org_id,omitempty"`
+	Start                    uint   `url:"start,omitempty"`
+	Limit                    uint   `url:"limit,omitempty"`
+	Sort                     Sort   `url:"sort,omitempty"`
+	StartDate                string `url:"start_date,omitempty"`
+	EndDate                  string `url:"end_date,omitempty"`
+	PinnedToLeadFlag         uint8  `url:"pinned_to_lead_flag,omitempty"`
+	PinnedToDealFlag         uint8  `url:"pinned_to_deal_flag,omitempty"`
+	PinnedToOrganizationFlag uint8  `url:"pinned_to_organization_flag,omitempty"`
+	PinnedToPersonFlag       uint8  `url:"pinned_to_person_flag,omitempty"`
+}
+
 // List returns notes.
 //
+// Deprecated: use ListWithOptions instead, which also filters the notes.
+//
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Notes/get_notes
 func (s *NotesService) List(ctx context.Context, opts PaginationParameters) (*NotesResponse, *Response, error) {
+	return s.ListWithOptions(ctx, &NotesListOptions{
+		Start: uint(opts.Start),
+		Limit: uint(opts.Limit),
+	})
+}
 
-	var (
-		err error
-		req *http.Request
-	)
+// ListWithOptions returns notes, optionally filtered by the item they are
+// attached to and by the date range they were added in.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Notes/get_notes
+func (s *NotesService) ListWithOptions(ctx context.Context, opt *NotesListOptions) (*NotesResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "/notes", opt, nil)
 
-	switch {
-	case opts.Start > 0 || opts.Limit > 0:
-		req, err = s.client.NewRequest(http.MethodGet, "/notes", &opts, nil)
-	default:
-		req, err = s.client.NewRequest(http.MethodGet, "/notes", nil, nil)
-	}
 	if err != nil {
 		return nil, nil, err
 	}
@@ -103,18 +151,37 @@ func (s *NotesService) GetByID(ctx context.Context, id int) (*NoteResponse, *Res
 // NoteCreateOptions specifices the optional parameters to the
 // NotesService.Create method.
 type NoteCreateOptions struct {
-	DealID                   uint   `json:"deal_id"`
 	Content                  string `json:"content"`
-	PersonID                 uint   `json:"person_id"`
-	OrgID                    uint   `json:"org_id"`
-	PinnedToDealFlag         uint8  `json:"pinned_to_deal_flag"`
-	PinnedToOrganizationFlag uint8  `json:"pinned_to_organization_flag"`
-	PinnedToPersonFlag       uint8  `json:"pinned_to_person_flag"`
+	UserID                   uint   `json:"user_id,omitempty"`
+	LeadID                   string `json:"lead_id,omitempty"`
+	DealID                   uint   `json:"deal_id,omitempty"`
+	PersonID                 uint   `json:"person_id,omitempty"`
+	OrgID                    uint   `json:"org_id,omitempty"`
+	AddTime                  string `json:"add_time,omitempty"`
+	PinnedToLeadFlag         uint8  `json:"pinned_to_lead_flag,omitempty"`
+	PinnedToDealFlag         uint8  `json:"pinned_to_deal_flag,omitempty"`
+	PinnedToOrganizationFlag uint8  `json:"pinned_to_organization_flag,omitempty"`
+	PinnedToPersonFlag       uint8  `json:"pinned_to_person_flag,omitempty"`
 }
 
-// Create a note.
+// Validate checks the required content and that the note is attached
+// to an item.
+func (o NoteCreateOptions) Validate() error {
+	if o.Content == "" {
+		return requiredError("content")
+	}
+
+	if o.DealID == 0 && o.PersonID == 0 && o.OrgID == 0 && o.LeadID == "" {
+		return requiredError("deal_id, person_id, org_id or lead_id")
+	}
+
+	return nil
+}
+
+// Create a note. Content and at least one of DealID, PersonID, OrgID
+// or LeadID are required.
 //
-// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Notes/get_notes_id
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Notes/post_notes
 func (s *NotesService) Create(ctx context.Context, opt *NoteCreateOptions) (*NoteResponse, *Response, error) {
 	req, err := s.client.NewRequest(http.MethodPost, "/notes", nil, opt)
 
@@ -136,13 +203,17 @@ func (s *NotesService) Create(ctx context.Context, opt *NoteCreateOptions) (*Not
 // NoteUpdateOptions specifices the optional parameters to the
 // NotesService.Update method.
 type NoteUpdateOptions struct {
-	Content                  string `url:"content"`
-	DealID                   uint   `url:"deal_id"`
-	PersonID                 uint   `url:"person_id"`
-	OrgID                    uint   `url:"org_id"`
-	PinnedToDealFlag         uint8  `url:"pinned_to_deal_flag"`
-	PinnedToOrganizationFlag uint8  `url:"pinned_to_organization_flag"`
-	PinnedToPersonFlag       uint8  `url:"pinned_to_person_flag"`
+	Content                  string `json:"content,omitempty"`
+	UserID                   uint   `json:"user_id,omitempty"`
+	LeadID                   string `json:"lead_id,omitempty"`
+	DealID                   uint   `json:"deal_id,omitempty"`
+	PersonID                 uint   `json:"person_id,omitempty"`
+	OrgID                    uint   `json:"org_id,omitempty"`
+	AddTime                  string `json:"add_time,omitempty"`
+	PinnedToLeadFlag         *uint8 `json:"pinned_to_lead_flag,omitempty"`
+	PinnedToDealFlag         *uint8 `json:"pinned_to_deal_flag,omitempty"`
+	PinnedToOrganizationFlag *uint8 `json:"pinned_to_organization_flag,omitempty"`
+	PinnedToPersonFlag       *uint8 `json:"pinned_to_person_flag,omitempty"`
 }
 
 // Update a specific note.
@@ -0,0 +1,45 @@
+package pipedrive
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestNotesService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/notes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		if got, want := r.URL.Query().Encode(), "limit=10&start=20"; got != want {
+			t.Errorf("Request query: %v, want %v", got, want)
+		}
+
+		writeJSON(w, http.StatusOK, `{"success": true, "data": [{"id": 1}]}`)
+	})
+
+	notes, _, err := client.Notes.List(context.Background(), PaginationParameters{Start: 20, Limit: 10})
+
+	if err != nil || len(notes.Data) != 1 {
+		t.Errorf("List returned %+v, %v", notes, err)
+	}
+}
+
+func TestNotesService_ListWithOptions(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/notes", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("deal_id"); got != "5" {
+			t.Errorf("Request deal_id: %v, want 5", got)
+		}
+
+		writeJSON(w, http.StatusOK, `{"success": true, "data": []}`)
+	})
+
+	if _, _, err := client.Notes.ListWithOptions(context.Background(), &NotesListOptions{DealID: 5}); err != nil {
+		t.Errorf("ListWithOptions returned error: %v", err)
+	}
+}
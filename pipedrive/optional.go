@@ -0,0 +1,79 @@
+package pipedrive
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// OptionalID represents the ID of an associated entity in update options.
+// A nil *OptionalID is not sent at all, the zero ID is sent as null and
+// removes the association.
+type OptionalID uint
+
+// ID returns an OptionalID that associates the entity with the given ID.
+func ID(id uint) *OptionalID {
+	v := OptionalID(id)
+
+	return &v
+}
+
+// NoID returns an OptionalID that removes the association.
+func NoID() *OptionalID {
+	return ID(0)
+}
+
+// IsSet reports whether the ID refers to an entity.
+func (id OptionalID) IsSet() bool {
+	return id != 0
+}
+
+// MarshalJSON encodes the zero ID as null.
+func (id OptionalID) MarshalJSON() ([]byte, error) {
+	if id == 0 {
+		return []byte("null"), nil
+	}
+
+	return []byte(strconv.FormatUint(uint64(id), 10)), nil
+}
+
+// UnmarshalJSON decodes an ID, null decodes as the zero ID.
+func (id *OptionalID) UnmarshalJSON(data []byte) error {
+	var v *uint
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*id = 0
+
+	if v != nil {
+		*id = OptionalID(*v)
+	}
+
+	return nil
+}
+
+// NullFields holds the keys of fields that are cleared by sending them as
+// null in update options, which omitempty otherwise makes impossible. Keys
+// of custom fields are accepted too.
+type NullFields []string
+
+// apply sets the fields to null in the JSON object data. A field both set
+// and listed is sent as null.
+func (n NullFields) apply(data json.RawMessage) (json.RawMessage, error) {
+	if len(n) == 0 {
+		return data, nil
+	}
+
+	var object map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+
+	for _, key := range n {
+		object[key] = json.RawMessage("null")
+	}
+
+	return json.Marshal(object)
+}
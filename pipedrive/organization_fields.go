@@ -165,7 +165,7 @@ func (s *OrganizationFieldsService) Update(ctx context.Context, id int, opt *Org
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/OrganizationFields/delete_organizationFields
 func (s *OrganizationFieldsService) DeleteMultiple(ctx context.Context, ids []int) (*Response, error) {
 	req, err := s.client.NewRequest(http.MethodDelete, "/organizationFields", &DeleteMultipleOptions{
-		Ids: arrayToString(ids, ","),
+		Ids: ids,
 	}, nil)
 
 	if err != nil {
@@ -2,6 +2,7 @@ package pipedrive
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 )
@@ -44,13 +45,14 @@ type Organization struct {
 	LostDealsCount                  int         `json:"lost_deals_count"`
 	RelatedLostDealsCount           int         `json:"related_lost_deals_count"`
 	ActiveFlag                      bool        `json:"active_flag"`
+	Label                           LabelID     `json:"label"`
 	CategoryID                      interface{} `json:"category_id"`
 	PictureID                       interface{} `json:"picture_id"`
 	CountryCode                     interface{} `json:"country_code"`
 	FirstChar                       string      `json:"first_char"`
 	UpdateTime                      string      `json:"update_time"`
 	AddTime                         string      `json:"add_time"`
-	VisibleTo                       string      `json:"visible_to"`
+	VisibleTo                       VisibleTo   `json:"visible_to"`
 	NextActivityDate                string      `json:"next_activity_date"`
 	NextActivityTime                interface{} `json:"next_activity_time"`
 	NextActivityID                  int         `json:"next_activity_id"`
@@ -58,26 +60,65 @@ type Organization struct {
 	LastActivityDate                string      `json:"last_activity_date"`
 	TimelineLastActivityTime        interface{} `json:"timeline_last_activity_time"`
 	TimelineLastActivityTimeByOwner interface{} `json:"timeline_last_activity_time_by_owner"`
-	Address                         string      `json:"address"`
-	AddressSubpremise               string      `json:"address_subpremise"`
-	AddressStreetNumber             string      `json:"address_street_number"`
-	AddressRoute                    string      `json:"address_route"`
-	AddressSublocality              string      `json:"address_sublocality"`
-	AddressLocality                 string      `json:"address_locality"`
-	AddressAdminAreaLevel1          string      `json:"address_admin_area_level_1"`
-	AddressAdminAreaLevel2          string      `json:"address_admin_area_level_2"`
-	AddressCountry                  string      `json:"address_country"`
-	AddressPostalCode               string      `json:"address_postal_code"`
-	AddressFormattedAddress         string      `json:"address_formatted_address"`
 	OwnerName                       string      `json:"owner_name"`
 	CcEmail                         string      `json:"cc_email"`
 	Phone                           string      `json:"3eb8874b7a3c9f3fe4f5b6435d4d009b15ec0c77"`
+
+	// Address holds the address and its geocoded components.
+	Address Address `json:"-"`
+
+	// CustomFields holds the values of all custom fields by field key.
+	CustomFields CustomFields `json:"-"`
+
+	// Raw holds the JSON the organization was decoded from.
+	Raw json.RawMessage `json:"-"`
 }
 
 func (o Organization) String() string {
 	return Stringify(o)
 }
 
+// UnmarshalJSON decodes a organization, grouping its address and
+// collecting its custom fields.
+func (o *Organization) UnmarshalJSON(data []byte) error {
+	return o.decode(Codec{}, data)
+}
+
+// decode implements modelDecoder.
+func (o *Organization) decode(codec Codec, data []byte) error {
+	type organization Organization
+
+	var v organization
+
+	if err := codec.unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	address, err := decodeAddress(data, "address")
+
+	if err != nil {
+		return err
+	}
+
+	fields, err := decodeCustomFields(data)
+
+	if err != nil {
+		return err
+	}
+
+	*o = Organization(v)
+	o.Address = address
+	o.CustomFields = fields
+	o.Raw = copyRaw(data)
+
+	return nil
+}
+
+// knownFieldPrefixes implements fieldPrefixer.
+func (o *Organization) knownFieldPrefixes() []string {
+	return []string{"address"}
+}
+
 // OrganizationsResponse represents multiple organizations response.
 type OrganizationsResponse struct {
 	Success        bool           `json:"success"`
@@ -111,42 +152,157 @@ func (s *OrganizationsService) Summary(ctx context.Context) (*Summary, *Response
 	return record, resp, nil
 }
 
+// OrganizationsListOptions specifices the optional parameters to the
+// OrganizationsService.ListWithOptions method.
+//
+// UserID is ignored when FilterID is set. FirstChar filters by the first
+// letter of the name.
+type OrganizationsListOptions struct {
+	UserID    uint   `url:"user_id,omitempty"`
+	FilterID  uint   `url:"filter_id,omitempty"`
+	FirstChar string `url:"first_char,omitempty"`
+	Start     uint   `url:"start,omitempty"`
+	Limit     uint   `url:"limit,omitempty"`
+	Sort      Sort   `url:"sort,omitempty"`
+}
+
 // List all organizations.
 //
+// Deprecated: use ListWithOptions instead, which also filters and sorts the
+// organizations and returns them typed.
+//
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/get_organizations
 func (s *OrganizationsService) List(ctx context.Context, opts PaginationParameters) (map[string]interface{}, *Response, error) {
-	var (
-		err error
-		req *http.Request
-		f   interface{}
-	)
-	switch {
-	case opts.Start > 0 || opts.Limit > 0:
-		req, err = s.client.NewRequest(http.MethodGet, "/organizations", &opts, nil)
-	default:
-		req, err = s.client.NewRequest(http.MethodGet, "/organizations", nil, nil)
+	req, err := s.client.NewRequest(http.MethodGet, "/organizations", &OrganizationsListOptions{
+		Start: uint(opts.Start),
+		Limit: uint(opts.Limit),
+	}, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record map[string]interface{}
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
 	}
 
+	return record, resp, nil
+}
+
+// ListWithOptions returns all organizations, optionally filtered and sorted.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/get_organizations
+func (s *OrganizationsService) ListWithOptions(ctx context.Context, opt *OrganizationsListOptions) (*OrganizationsResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "/organizations", opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *OrganizationsResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// OrganizationsSearchOptions specifices the parameters to the
+// OrganizationsService.Search method.
+//
+// Fields restricts the fields searched in: "address", "custom_fields",
+// "notes" and "name".
+type OrganizationsSearchOptions struct {
+	Term       string `url:"term"`
+	Fields     Fields `url:"fields,omitempty,comma"`
+	ExactMatch bool   `url:"exact_match,omitempty"`
+	Start      uint   `url:"start,omitempty"`
+	Limit      uint   `url:"limit,omitempty"`
+}
+
+// Search organizations by name, address, notes and custom fields.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/get_organizations_search
+func (s *OrganizationsService) Search(ctx context.Context, opt *OrganizationsSearchOptions) (*ItemSearchResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "/organizations/search", opt, nil)
+
 	if err != nil {
 		return nil, nil, err
 	}
 
-	resp, err := s.client.Do(ctx, req, &f)
+	var record *ItemSearchResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
 	if err != nil {
 		return nil, resp, err
 	}
 
-	return f.(map[string]interface{}), resp, nil
+	return record, resp, nil
+}
+
+// SearchAll returns every organization matching the search, fetching all pages.
+// Organizations found on several pages are returned once. When more than
+// maxResults match, the first maxResults are returned with ErrSearchLimit.
+func (s *OrganizationsService) SearchAll(ctx context.Context, opt *OrganizationsSearchOptions, maxResults int) ([]SearchItem, *Response, error) {
+	var page OrganizationsSearchOptions
+
+	if opt != nil {
+		page = *opt
+	}
+
+	if page.Limit == 0 {
+		page.Limit = searchPageLimit
+	}
+
+	return searchAll(ctx, s.client.NewPager("/organizations/search", page.Start), maxResults, func(start uint) (*ItemSearchResponse, *Response, error) {
+		page.Start = start
+
+		return s.Search(ctx, &page)
+	})
 }
 
 // OrganizationUpdateOptions specifices the optional parameters to the
 // OrganizationUpdateOptions.Update method.
 type OrganizationUpdateOptions struct {
-	Name      string    `json:"name,omitempty"`
-	OwnerID   uint      `json:"owner_id,omitempty"`
-	VisibleTo VisibleTo `json:"visible_to,omitempty"`
-	Address   string    `json:"address,omitempty"`
-	Phone     string    `json:"3eb8874b7a3c9f3fe4f5b6435d4d009b15ec0c77,omitempty"`
+	Name      *string    `json:"name,omitempty"`
+	OwnerID   *uint      `json:"owner_id,omitempty"`
+	VisibleTo *VisibleTo `json:"visible_to,omitempty"`
+	Label     *uint      `json:"label,omitempty"`
+	Address   *string    `json:"address,omitempty"`
+	Phone     *string    `json:"3eb8874b7a3c9f3fe4f5b6435d4d009b15ec0c77,omitempty"`
+
+	// CustomFields are sent along with the other fields.
+	CustomFields CustomFields `json:"-"`
+
+	// Null holds the keys of fields to clear.
+	Null NullFields `json:"-"`
+}
+
+// Validate checks the visibility value.
+func (o OrganizationUpdateOptions) Validate() error {
+	return validateVisibleTo(visibleToValue(o.VisibleTo))
+}
+
+// MarshalJSON encodes the options together with the custom fields and
+// the cleared fields.
+func (o OrganizationUpdateOptions) MarshalJSON() ([]byte, error) {
+	type options OrganizationUpdateOptions
+
+	data, err := withCustomFields(options(o), o.CustomFields)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return o.Null.apply(data)
 }
 
 // Update a specific person.
@@ -200,14 +356,7 @@ func (s *OrganizationsService) Merge(ctx context.Context, id int, mergeWithID in
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/delete_organizations_id_followers_follower_id
 func (s *OrganizationsService) DeleteFollower(ctx context.Context, id int, followerID int) (*Response, error) {
-	uri := fmt.Sprintf("/organizations/%v/followers/%v", id, followerID)
-	req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
-
-	if err != nil {
-		return nil, err
-	}
-
-	return s.client.Do(ctx, req, nil)
+	return s.client.Followers.Remove(ctx, FollowedOrganization, id, followerID)
 }
 
 // Delete marks an organization as deleted.
@@ -229,7 +378,7 @@ func (s *OrganizationsService) Delete(ctx context.Context, id int) (*Response, e
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/delete_organizations
 func (s *OrganizationsService) DeleteMultiple(ctx context.Context, ids []int) (*Response, error) {
 	req, err := s.client.NewRequest(http.MethodDelete, "/organizations", &DeleteMultipleOptions{
-		Ids: arrayToString(ids, ","),
+		Ids: ids,
 	}, nil)
 
 	if err != nil {
@@ -247,25 +396,47 @@ type OrganizationCreateOptions struct {
 	VisibleTo VisibleTo `json:"visible_to"`
 	AddTime   Timestamp `json:"add_time"`
 	Label     uint      `json:"label"`
+
+	// CustomFields are sent along with the other fields.
+	CustomFields CustomFields `json:"-"`
 }
 
-// Create a new organizations.
-//
-// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/post_organizations
-func (s *OrganizationsService) Create(ctx context.Context, opt *OrganizationCreateOptions) (*OrganizationResponse, *Response, error) {
-	req, err := s.client.NewRequest(http.MethodPost, "/organizations", nil, struct {
+// Validate checks the required name and the visibility value.
+func (o OrganizationCreateOptions) Validate() error {
+	if o.Name == "" {
+		return requiredError("name")
+	}
+
+	return validateVisibleTo(o.VisibleTo)
+}
+
+// MarshalJSON encodes the options together with the custom fields, with
+// the times formatted as the API expects them.
+func (o OrganizationCreateOptions) MarshalJSON() ([]byte, error) {
+	return withCustomFields(struct {
 		Name      string    `json:"name"`
 		OwnerID   uint      `json:"owner_id"`
 		Label     uint      `json:"label"`
 		VisibleTo VisibleTo `json:"visible_to"`
 		AddTime   string    `json:"add_time"`
 	}{
-		opt.Name,
-		opt.OwnerID,
-		opt.Label,
-		opt.VisibleTo,
-		opt.AddTime.FormatFull(),
-	})
+		o.Name,
+		o.OwnerID,
+		o.Label,
+		o.VisibleTo,
+		o.AddTime.FormatFull(),
+	}, o.CustomFields)
+}
+
+// Create a new organizations.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Organizations/post_organizations
+func (s *OrganizationsService) Create(ctx context.Context, opt *OrganizationCreateOptions) (*OrganizationResponse, *Response, error) {
+	if opt == nil {
+		return nil, nil, requiredError("name")
+	}
+
+	req, err := s.client.NewRequest(http.MethodPost, "/organizations", nil, opt)
 
 	if err != nil {
 		return nil, nil, err
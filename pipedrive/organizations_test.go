@@ -0,0 +1,47 @@
+package pipedrive
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestOrganizationsService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/organizations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		if got, want := r.URL.Query().Encode(), "limit=10&start=20"; got != want {
+			t.Errorf("Request query: %v, want %v", got, want)
+		}
+
+		writeJSON(w, http.StatusOK, `{"success": true, "data": [{"id": 1}]}`)
+	})
+
+	organizations, _, err := client.Organizations.List(context.Background(), PaginationParameters{Start: 20, Limit: 10})
+
+	if err != nil || organizations["success"] != true {
+		t.Errorf("List returned %v, %v", organizations, err)
+	}
+}
+
+func TestOrganizationsService_ListWithOptions(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/organizations", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("first_char"); got != "a" {
+			t.Errorf("Request first_char: %v, want a", got)
+		}
+
+		writeJSON(w, http.StatusOK, `{"success": true, "data": [{"id": 1, "name": "Acme"}]}`)
+	})
+
+	organizations, _, err := client.Organizations.ListWithOptions(context.Background(), &OrganizationsListOptions{FirstChar: "a"})
+
+	if err != nil || organizations.Data[0].Name != "Acme" {
+		t.Errorf("ListWithOptions returned %+v, %v", organizations, err)
+	}
+}
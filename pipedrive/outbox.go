@@ -0,0 +1,261 @@
+package pipedrive
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const defaultOutboxRetryInterval = 5 * time.Second
+
+// Mutation represents a create, update or delete request held by an
+// Outbox until it has been sent.
+type Mutation struct {
+	// Seq orders the mutations, it is assigned by the Outbox.
+	Seq    uint64          `json:"seq"`
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Body   json.RawMessage `json:"body,omitempty"`
+	Added  time.Time       `json:"added"`
+}
+
+// OutboxStore persists the pending mutations of an Outbox. It must be safe
+// for concurrent use.
+type OutboxStore interface {
+	// Append persists a mutation before it is sent.
+	Append(m Mutation) error
+
+	// Pending returns the mutations not marked done, ordered by Seq.
+	Pending() ([]Mutation, error)
+
+	// Done marks a mutation as sent.
+	Done(seq uint64) error
+}
+
+// Outbox is a write-ahead queue for mutations. Mutations are persisted in
+// the store before they are sent, one at a time in the order they were
+// enqueued, so after a crash or an outage of the API the pending
+// mutations are replayed in order once Run is called again.
+//
+// A mutation that fails with a network error, a server error, the rate
+// limit or an authentication error blocks the queue and is retried, so
+// that an expired token or a missing permission does not lose mutations.
+// Mutations the API rejects as invalid, with 400 or 422, and updates and
+// deletes of records that no longer exist, with 404 or 410, are reported
+// to OnFailure and dropped, as retrying would not help. Mutations are
+// sent at least once: a create that failed after reaching the API can be
+// applied twice.
+type Outbox struct {
+	client *Client
+	store  OutboxStore
+
+	// RetryInterval is the time between attempts of a failed mutation,
+	// 5 seconds when zero. Rate limited mutations wait for the limit to
+	// reset instead.
+	RetryInterval time.Duration
+
+	// OnFailure, if set, is called with the mutations that are dropped.
+	OnFailure func(Mutation, error)
+
+	mu        sync.Mutex
+	seq       uint64
+	wake      chan struct{}
+	lifecycle LifecycleRunner
+}
+
+// NewOutbox returns an Outbox persisting to the store. Mutations pending
+// in the store are sent once Run is called.
+func (c *Client) NewOutbox(store OutboxStore) (*Outbox, error) {
+	pending, err := store.Pending()
+
+	if err != nil {
+		return nil, err
+	}
+
+	o := &Outbox{client: c, store: store, wake: make(chan struct{}, 1)}
+
+	if len(pending) > 0 {
+		o.seq = pending[len(pending)-1].Seq
+	}
+
+	return o, nil
+}
+
+// Enqueue persists a mutation with a body that is encoded to JSON, nil for
+// none. The path is that of Client.NewRequest, such as /deals/5.
+func (o *Outbox) Enqueue(method, path string, body interface{}) (Mutation, error) {
+	m := Mutation{Method: method, Path: path, Added: time.Now()}
+
+	if body != nil {
+		data, err := o.client.Codec.marshal(body)
+
+		if err != nil {
+			return Mutation{}, err
+		}
+
+		m.Body = data
+	}
+
+	o.mu.Lock()
+	o.seq++
+	m.Seq = o.seq
+	err := o.store.Append(m)
+	o.mu.Unlock()
+
+	if err != nil {
+		return Mutation{}, err
+	}
+
+	select {
+	case o.wake <- struct{}{}:
+	default:
+	}
+
+	return m, nil
+}
+
+// Create enqueues a POST request.
+func (o *Outbox) Create(path string, body interface{}) (Mutation, error) {
+	return o.Enqueue(http.MethodPost, path, body)
+}
+
+// Update enqueues a PUT request.
+func (o *Outbox) Update(path string, body interface{}) (Mutation, error) {
+	return o.Enqueue(http.MethodPut, path, body)
+}
+
+// Delete enqueues a DELETE request.
+func (o *Outbox) Delete(path string) (Mutation, error) {
+	return o.Enqueue(http.MethodDelete, path, nil)
+}
+
+// Start implements Lifecycle, it calls Run in the background.
+func (o *Outbox) Start(ctx context.Context) error {
+	return o.lifecycle.Start(ctx, o.Run)
+}
+
+// Shutdown implements Lifecycle. Run sends the pending mutations, including
+// those enqueued meanwhile, and returns nil once none is left. Mutations
+// still pending when ctx is done stay in the store and are sent by the
+// next Run.
+func (o *Outbox) Shutdown(ctx context.Context) error {
+	return o.lifecycle.Shutdown(ctx)
+}
+
+// Run sends the pending mutations and those enqueued later, until ctx is
+// done or the store fails. After Shutdown, it returns nil once no mutation
+// is pending.
+func (o *Outbox) Run(ctx context.Context) error {
+	drain := o.lifecycle.Draining()
+	draining := false
+
+	for {
+		pending, err := o.store.Pending()
+
+		if err != nil {
+			return err
+		}
+
+		if len(pending) == 0 {
+			if draining {
+				return nil
+			}
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-o.wake:
+			case <-drain:
+				draining = true
+			}
+
+			continue
+		}
+
+		for _, m := range pending {
+			err := o.send(ctx, m)
+
+			if err != nil && retryableMutationError(m, err) {
+				if !o.wait(ctx, err) {
+					return ctx.Err()
+				}
+
+				break
+			}
+
+			if err != nil && o.OnFailure != nil {
+				o.OnFailure(m, err)
+			}
+
+			if err := o.store.Done(m.Seq); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (o *Outbox) send(ctx context.Context, m Mutation) error {
+	var body interface{}
+
+	if len(m.Body) > 0 {
+		body = m.Body
+	}
+
+	req, err := o.client.NewRequest(m.Method, m.Path, nil, body)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = o.client.Do(ctx, req, nil)
+
+	return err
+}
+
+// wait waits before a failed mutation is retried and reports whether ctx
+// is still running.
+func (o *Outbox) wait(ctx context.Context, err error) bool {
+	if _, ok := err.(*RateLimitError); ok {
+		return waitForRateLimit(ctx, err)
+	}
+
+	interval := o.RetryInterval
+
+	if interval <= 0 {
+		interval = defaultOutboxRetryInterval
+	}
+
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// retryableMutationError reports whether m may succeed when it is sent
+// again.
+func retryableMutationError(m Mutation, err error) bool {
+	switch err := err.(type) {
+	case *RateLimitError:
+		return true
+	case *ValidationError:
+		return false
+	case *ErrorResponse:
+		switch err.Response.StatusCode {
+		case http.StatusBadRequest, http.StatusUnprocessableEntity:
+			return false
+		case http.StatusNotFound, http.StatusGone:
+			// Updates and deletes of a record that is gone can not
+			// succeed.
+			return m.Method == http.MethodPost
+		}
+	}
+
+	return true
+}
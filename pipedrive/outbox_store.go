@@ -0,0 +1,237 @@
+package pipedrive
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"sort"
+	"sync"
+)
+
+// MemoryOutboxStore is an OutboxStore in memory. It keeps mutations in
+// order while the process runs, but does not survive a restart.
+type MemoryOutboxStore struct {
+	mu        sync.Mutex
+	mutations []Mutation
+}
+
+// NewMemoryOutboxStore returns an empty MemoryOutboxStore.
+func NewMemoryOutboxStore() *MemoryOutboxStore {
+	return &MemoryOutboxStore{}
+}
+
+// Append implements OutboxStore.
+func (s *MemoryOutboxStore) Append(m Mutation) error {
+	s.mu.Lock()
+	s.mutations = append(s.mutations, m)
+	s.mu.Unlock()
+
+	return nil
+}
+
+// Pending implements OutboxStore.
+func (s *MemoryOutboxStore) Pending() ([]Mutation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Mutation(nil), s.mutations...), nil
+}
+
+// Done implements OutboxStore.
+func (s *MemoryOutboxStore) Done(seq uint64) error {
+	s.mu.Lock()
+	s.mutations = removeMutation(s.mutations, seq)
+	s.mu.Unlock()
+
+	return nil
+}
+
+// FileOutboxStore is an OutboxStore in a file. Mutations and their
+// completion are appended to the file and synced to disk before they are
+// acknowledged. The file is compacted to the pending mutations when it is
+// opened.
+type FileOutboxStore struct {
+	mu      sync.Mutex
+	file    *os.File
+	pending []Mutation
+}
+
+// outboxRecord is a line of the file of a FileOutboxStore.
+type outboxRecord struct {
+	Mutation *Mutation `json:"mutation,omitempty"`
+	Done     uint64    `json:"done,omitempty"`
+}
+
+// OpenFileOutboxStore opens the store in the file at path, creating it
+// when it does not exist. A last line that was not written completely
+// before a crash is ignored.
+func OpenFileOutboxStore(path string) (*FileOutboxStore, error) {
+	pending, err := readOutboxFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := writeOutboxFile(path, pending); err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileOutboxStore{file: file, pending: pending}, nil
+}
+
+func readOutboxFile(path string) ([]Mutation, error) {
+	file, err := os.Open(path)
+
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	var pending []Mutation
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 16<<20)
+
+	for scanner.Scan() {
+		var record outboxRecord
+
+		if json.Unmarshal(scanner.Bytes(), &record) != nil {
+			continue
+		}
+
+		if record.Mutation != nil {
+			pending = append(pending, *record.Mutation)
+		} else if record.Done != 0 {
+			pending = removeMutation(pending, record.Done)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Sort(bySeq(pending))
+
+	return pending, nil
+}
+
+// writeOutboxFile replaces the file with one holding the mutations.
+func writeOutboxFile(path string, mutations []Mutation) error {
+	tmp := path + ".tmp"
+	file, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+
+	if err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriter(file)
+	encoder := json.NewEncoder(writer)
+
+	for i := range mutations {
+		if err := encoder.Encode(outboxRecord{Mutation: &mutations[i]}); err != nil {
+			file.Close()
+			return err
+		}
+	}
+
+	if err := writer.Flush(); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// Append implements OutboxStore.
+func (s *FileOutboxStore) Append(m Mutation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.write(outboxRecord{Mutation: &m}); err != nil {
+		return err
+	}
+
+	s.pending = append(s.pending, m)
+
+	return nil
+}
+
+// Pending implements OutboxStore.
+func (s *FileOutboxStore) Pending() ([]Mutation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Mutation(nil), s.pending...), nil
+}
+
+// Done implements OutboxStore.
+func (s *FileOutboxStore) Done(seq uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.write(outboxRecord{Done: seq}); err != nil {
+		return err
+	}
+
+	s.pending = removeMutation(s.pending, seq)
+
+	return nil
+}
+
+// Close closes the file.
+func (s *FileOutboxStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.file.Close()
+}
+
+func (s *FileOutboxStore) write(record outboxRecord) error {
+	data, err := json.Marshal(record)
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.file.Write(append(data, '\n')); err != nil {
+		return err
+	}
+
+	return s.file.Sync()
+}
+
+func removeMutation(mutations []Mutation, seq uint64) []Mutation {
+	for i, m := range mutations {
+		if m.Seq == seq {
+			return append(mutations[:i:i], mutations[i+1:]...)
+		}
+	}
+
+	return mutations
+}
+
+type bySeq []Mutation
+
+func (s bySeq) Len() int           { return len(s) }
+func (s bySeq) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s bySeq) Less(i, j int) bool { return s[i].Seq < s[j].Seq }
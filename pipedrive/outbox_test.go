@@ -0,0 +1,160 @@
+package pipedrive
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+// runOutbox runs o until no mutation is pending, or fails t after a while.
+func runOutbox(t *testing.T, o *Outbox) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := o.Start(ctx); err != nil {
+		t.Fatalf("Outbox.Start returned error: %v", err)
+	}
+
+	if err := o.Shutdown(ctx); err != nil {
+		t.Fatalf("Outbox.Shutdown returned error: %v", err)
+	}
+}
+
+func TestOutbox_Run_replaysInOrder(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var (
+		mu   sync.Mutex
+		sent []string
+	)
+
+	mux.HandleFunc("/v1/", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		sent = append(sent, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+
+		writeJSON(w, http.StatusOK, `{"success": true}`)
+	})
+
+	// The mutations of an earlier process are pending in the store.
+	store := NewMemoryOutboxStore()
+	store.Append(Mutation{Seq: 1, Method: http.MethodPost, Path: "/deals"})
+	store.Append(Mutation{Seq: 2, Method: http.MethodPut, Path: "/deals/1"})
+
+	o, err := client.NewOutbox(store)
+
+	if err != nil {
+		t.Fatalf("NewOutbox returned error: %v", err)
+	}
+
+	if _, err := o.Delete("/deals/1"); err != nil {
+		t.Fatalf("Outbox.Delete returned error: %v", err)
+	}
+
+	runOutbox(t, o)
+
+	want := []string{"POST /v1/deals", "PUT /v1/deals/1", "DELETE /v1/deals/1"}
+
+	if len(sent) != len(want) {
+		t.Fatalf("Outbox sent %v, want %v", sent, want)
+	}
+
+	for i := range want {
+		if sent[i] != want[i] {
+			t.Errorf("Outbox sent %v, want %v", sent, want)
+			break
+		}
+	}
+
+	if pending, _ := store.Pending(); len(pending) != 0 {
+		t.Errorf("Outbox left %v mutations pending, want 0", len(pending))
+	}
+}
+
+// testOutboxRetry sends a mutation failing once with the status code and
+// reports the requests sent and the mutations dropped.
+func testOutboxRetry(t *testing.T, method string, status int) (requests int, dropped []Mutation) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+
+	mux.HandleFunc("/v1/deals/1", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		first := requests == 1
+		mu.Unlock()
+
+		if first {
+			writeJSON(w, status, `{"success": false, "error": "failed"}`)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, `{"success": true}`)
+	})
+
+	o, err := client.NewOutbox(NewMemoryOutboxStore())
+
+	if err != nil {
+		t.Fatalf("NewOutbox returned error: %v", err)
+	}
+
+	o.RetryInterval = time.Millisecond
+	o.OnFailure = func(m Mutation, err error) {
+		dropped = append(dropped, m)
+	}
+
+	if _, err := o.Enqueue(method, "/deals/1", nil); err != nil {
+		t.Fatalf("Outbox.Enqueue returned error: %v", err)
+	}
+
+	runOutbox(t, o)
+
+	return requests, dropped
+}
+
+func TestOutbox_Run_retries(t *testing.T) {
+	tests := []struct {
+		method string
+		status int
+	}{
+		{http.MethodPut, http.StatusInternalServerError},
+		{http.MethodPut, http.StatusServiceUnavailable},
+		{http.MethodPut, http.StatusUnauthorized},
+		{http.MethodDelete, http.StatusForbidden},
+		{http.MethodPost, http.StatusNotFound},
+	}
+
+	for _, test := range tests {
+		requests, dropped := testOutboxRetry(t, test.method, test.status)
+
+		if requests != 2 || len(dropped) != 0 {
+			t.Errorf("%v failing with %v: sent %v times and dropped %v, want sent 2 times and not dropped",
+				test.method, test.status, requests, len(dropped))
+		}
+	}
+}
+
+func TestOutbox_Run_drops(t *testing.T) {
+	tests := []struct {
+		method string
+		status int
+	}{
+		{http.MethodPost, http.StatusBadRequest},
+		{http.MethodPut, http.StatusUnprocessableEntity},
+		{http.MethodPut, http.StatusNotFound},
+		{http.MethodDelete, http.StatusGone},
+	}
+
+	for _, test := range tests {
+		requests, dropped := testOutboxRetry(t, test.method, test.status)
+
+		if requests != 1 || len(dropped) != 1 {
+			t.Errorf("%v failing with %v: sent %v times and dropped %v, want sent once and dropped",
+				test.method, test.status, requests, len(dropped))
+		}
+	}
+}
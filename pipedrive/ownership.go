@@ -0,0 +1,168 @@
+package pipedrive
+
+import (
+	"context"
+	"errors"
+)
+
+// OwnershipTransferOptions specifices the parameters to the
+// PersonsService.TransferOwnership method.
+type OwnershipTransferOptions struct {
+	FromUserID uint
+	ToUserID   uint
+
+	// Deals also reassigns the open deals of the user.
+	Deals bool
+
+	// Activities also reassigns the activities of the user that are not
+	// done.
+	Activities bool
+
+	// Concurrency and MaxRetries configure the updates, see BulkUpdater.
+	Concurrency int
+	MaxRetries  int
+}
+
+// OwnershipTransferResult holds the results of the updates of an
+// ownership transfer, in the order the entities were listed.
+type OwnershipTransferResult struct {
+	Persons    BulkUpdateResults
+	Deals      BulkUpdateResults
+	Activities BulkUpdateResults
+}
+
+// Err returns the first *BatchError of the persons, deals and activities,
+// nil when all updates succeeded.
+func (r *OwnershipTransferResult) Err() error {
+	for _, results := range []BulkUpdateResults{r.Persons, r.Deals, r.Activities} {
+		if err := results.Err(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TransferOwnership reassigns all persons owned by one user to another,
+// for example when an employee leaves, and optionally their open deals
+// and activities. Every kind of entity is listed completely before it is
+// updated. Failed updates are reported in the result and do not stop the
+// others, see OwnershipTransferResult.Err.
+func (s *PersonsService) TransferOwnership(ctx context.Context, opt *OwnershipTransferOptions) (*OwnershipTransferResult, error) {
+	if opt.FromUserID == 0 {
+		return nil, requiredError("from_user_id")
+	}
+
+	if opt.ToUserID == 0 {
+		return nil, requiredError("to_user_id")
+	}
+
+	if opt.FromUserID == opt.ToUserID {
+		return nil, errors.New("pipedrive: ownership transfer to the same user")
+	}
+
+	var result OwnershipTransferResult
+
+	persons, err := s.listOwned(ctx, opt.FromUserID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make([]BulkUpdate, len(persons))
+
+	for i, person := range persons {
+		updates[i] = BulkUpdate{ID: person.ID, Options: &PersonUpdateOptions{OwnerID: Uint(opt.ToUserID)}}
+	}
+
+	result.Persons = s.client.transferUpdater("persons", opt).Run(ctx, updates)
+
+	if opt.Deals {
+		deals, _, err := s.client.Deals.ListAll(ctx, &DealsListOptions{UserID: opt.FromUserID, Status: DealStatusOpen})
+
+		if err != nil {
+			return &result, err
+		}
+
+		updates := make([]BulkUpdate, len(deals))
+
+		for i, deal := range deals {
+			updates[i] = BulkUpdate{ID: deal.ID, Options: &DealsUpdateOptions{UserID: Uint(opt.ToUserID)}}
+		}
+
+		result.Deals = s.client.transferUpdater("deals", opt).Run(ctx, updates)
+	}
+
+	if opt.Activities {
+		activities, err := s.client.Activities.listOwned(ctx, opt.FromUserID)
+
+		if err != nil {
+			return &result, err
+		}
+
+		updates := make([]BulkUpdate, len(activities))
+
+		for i, activity := range activities {
+			updates[i] = BulkUpdate{ID: activity.Id, Options: &ActivitiesUpdateOptions{UserID: Uint(opt.ToUserID)}}
+		}
+
+		result.Activities = s.client.transferUpdater("activities", opt).Run(ctx, updates)
+	}
+
+	return &result, nil
+}
+
+func (c *Client) transferUpdater(resource string, opt *OwnershipTransferOptions) *BulkUpdater {
+	updater := c.NewBulkUpdater(resource)
+	updater.Concurrency = opt.Concurrency
+	updater.MaxRetries = opt.MaxRetries
+
+	return updater
+}
+
+// listOwned returns all persons owned by the user.
+func (s *PersonsService) listOwned(ctx context.Context, userID uint) ([]Person, error) {
+	opt := &PersonsListOptions{UserID: userID, Limit: listAllPageLimit}
+	pager := s.client.NewPager("/persons", 0)
+
+	var persons []Person
+
+	for {
+		opt.Start = pager.Start
+		page, _, err := s.ListWithOptions(ctx, opt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		persons = append(persons, page.Data...)
+
+		if more, err := pager.Next(page.AdditionalData.Pagination, len(page.Data)); !more {
+			return persons, err
+		}
+	}
+}
+
+// listOwned returns all activities of the user that are not done.
+func (s *ActivitiesService) listOwned(ctx context.Context, userID uint) ([]Activity, error) {
+	done := ActivityNotDone
+	opt := &ActivitiesListOptions{UserID: userID, Done: &done, Limit: listAllPageLimit}
+	pager := s.client.NewPager("/activities", 0)
+
+	var activities []Activity
+
+	for {
+		opt.Start = pager.Start
+		page, _, err := s.List(ctx, opt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		activities = append(activities, page.Data...)
+
+		if more, err := pager.Next(page.AdditionalData.Pagination, len(page.Data)); !more {
+			return activities, err
+		}
+	}
+}
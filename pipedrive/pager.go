@@ -0,0 +1,74 @@
+package pipedrive
+
+import "fmt"
+
+// PageProgress describes the progress of fetching all pages of a list.
+type PageProgress struct {
+	// Path is the path of the list, such as /deals.
+	Path string
+
+	// Pages and Items count the pages and items fetched so far.
+	Pages int
+	Items int
+}
+
+// PageProgressFunc is called after every page fetched by the helpers
+// returning all pages of a list, see Client.OnPage.
+type PageProgressFunc func(PageProgress)
+
+// PaginationLoopError is returned when a list names a next page that does
+// not come after the current one, which would fetch the same pages over
+// and over.
+type PaginationLoopError struct {
+	Path      string
+	Start     uint
+	NextStart int
+}
+
+func (e *PaginationLoopError) Error() string {
+	return fmt.Sprintf("pipedrive: %v returned next start %v for the page at %v, stopped to avoid fetching pages again", e.Path, e.NextStart, e.Start)
+}
+
+// Pager follows the offset pagination of a list across pages.
+type Pager struct {
+	client *Client
+
+	// Path is the path of the list, reported in the progress and errors.
+	Path string
+
+	// Start is the start of the page to fetch next.
+	Start uint
+
+	Pages int
+	Items int
+}
+
+// NewPager returns a Pager for the list at path, beginning at start.
+func (c *Client) NewPager(path string, start uint) *Pager {
+	return &Pager{client: c, Path: path, Start: start}
+}
+
+// Next records a fetched page with its pagination and number of items,
+// reports the progress to Client.OnPage and advances Start. It returns
+// false after the last page, together with a *PaginationLoopError when
+// the next start does not advance.
+func (p *Pager) Next(pagination Pagination, items int) (bool, error) {
+	p.Pages++
+	p.Items += items
+
+	if p.client != nil && p.client.OnPage != nil {
+		p.client.OnPage(PageProgress{Path: p.Path, Pages: p.Pages, Items: p.Items})
+	}
+
+	if !pagination.MoreItemsInCollection {
+		return false, nil
+	}
+
+	if pagination.NextStart < 0 || uint(pagination.NextStart) <= p.Start {
+		return false, &PaginationLoopError{Path: p.Path, Start: p.Start, NextStart: pagination.NextStart}
+	}
+
+	p.Start = uint(pagination.NextStart)
+
+	return true, nil
+}
@@ -2,6 +2,7 @@ package pipedrive
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 )
@@ -12,12 +13,43 @@ import (
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Persons
 type PersonsService service
 
-type PhoneEmail struct {
-	Label   string `json:"label"`
+// ContactValue represents one of the labeled email addresses or phone
+// numbers of a person.
+type ContactValue struct {
 	Value   string `json:"value"`
+	Label   string `json:"label,omitempty"`
 	Primary bool   `json:"primary"`
 }
 
+// primaryContactValue returns the primary value, falling back to the first
+// value when none is marked as primary.
+func primaryContactValue(values []ContactValue) string {
+	for _, v := range values {
+		if v.Primary && v.Value != "" {
+			return v.Value
+		}
+	}
+
+	for _, v := range values {
+		if v.Value != "" {
+			return v.Value
+		}
+	}
+
+	return ""
+}
+
+// validateContactValues checks that every value is set.
+func validateContactValues(field string, values []ContactValue) error {
+	for _, v := range values {
+		if v.Value == "" {
+			return &ValidationError{Field: field, Message: "has an entry without a value"}
+		}
+	}
+
+	return nil
+}
+
 // Person represents a Pipedrive person.
 type Person struct {
 	ID        int `json:"id"`
@@ -34,54 +66,100 @@ type Person struct {
 	OrgID struct {
 		Value int `json:"value"`
 	} `json:"org_id"`
-	Name                            string       `json:"name"`
-	FirstName                       string       `json:"first_name"`
-	LastName                        string       `json:"last_name"`
-	OpenDealsCount                  int          `json:"open_deals_count"`
-	RelatedOpenDealsCount           int          `json:"related_open_deals_count"`
-	ClosedDealsCount                int          `json:"closed_deals_count"`
-	RelatedClosedDealsCount         int          `json:"related_closed_deals_count"`
-	ParticipantOpenDealsCount       int          `json:"participant_open_deals_count"`
-	ParticipantClosedDealsCount     int          `json:"participant_closed_deals_count"`
-	EmailMessagesCount              int          `json:"email_messages_count"`
-	ActivitiesCount                 int          `json:"activities_count"`
-	DoneActivitiesCount             int          `json:"done_activities_count"`
-	UndoneActivitiesCount           int          `json:"undone_activities_count"`
-	ReferenceActivitiesCount        int          `json:"reference_activities_count"`
-	FilesCount                      int          `json:"files_count"`
-	NotesCount                      int          `json:"notes_count"`
-	FollowersCount                  int          `json:"followers_count"`
-	WonDealsCount                   int          `json:"won_deals_count"`
-	RelatedWonDealsCount            int          `json:"related_won_deals_count"`
-	LostDealsCount                  int          `json:"lost_deals_count"`
-	RelatedLostDealsCount           int          `json:"related_lost_deals_count"`
-	ActiveFlag                      bool         `json:"active_flag"`
-	Phone                           []PhoneEmail `json:"phone"`
-	Email                           []PhoneEmail `json:"email"`
-	FirstChar                       string       `json:"first_char"`
-	UpdateTime                      string       `json:"update_time"`
-	AddTime                         string       `json:"add_time"`
-	VisibleTo                       string       `json:"visible_to"`
-	PictureID                       interface{}  `json:"picture_id"`
-	NextActivityDate                interface{}  `json:"next_activity_date"`
-	NextActivityTime                interface{}  `json:"next_activity_time"`
-	NextActivityID                  interface{}  `json:"next_activity_id"`
-	LastActivityID                  int          `json:"last_activity_id"`
-	LastActivityDate                string       `json:"last_activity_date"`
-	TimelineLastActivityTime        interface{}  `json:"timeline_last_activity_time"`
-	TimelineLastActivityTimeByOwner interface{}  `json:"timeline_last_activity_time_by_owner"`
-	LastIncomingMailTime            interface{}  `json:"last_incoming_mail_time"`
-	LastOutgoingMailTime            interface{}  `json:"last_outgoing_mail_time"`
-	OrgName                         interface{}  `json:"org_name"`
-	OwnerName                       string       `json:"owner_name"`
-	CcEmail                         string       `json:"cc_email"`
-	Label                           uint         `json:"label"`
+	Name                            string         `json:"name"`
+	FirstName                       string         `json:"first_name"`
+	LastName                        string         `json:"last_name"`
+	OpenDealsCount                  int            `json:"open_deals_count"`
+	RelatedOpenDealsCount           int            `json:"related_open_deals_count"`
+	ClosedDealsCount                int            `json:"closed_deals_count"`
+	RelatedClosedDealsCount         int            `json:"related_closed_deals_count"`
+	ParticipantOpenDealsCount       int            `json:"participant_open_deals_count"`
+	ParticipantClosedDealsCount     int            `json:"participant_closed_deals_count"`
+	EmailMessagesCount              int            `json:"email_messages_count"`
+	ActivitiesCount                 int            `json:"activities_count"`
+	DoneActivitiesCount             int            `json:"done_activities_count"`
+	UndoneActivitiesCount           int            `json:"undone_activities_count"`
+	ReferenceActivitiesCount        int            `json:"reference_activities_count"`
+	FilesCount                      int            `json:"files_count"`
+	NotesCount                      int            `json:"notes_count"`
+	FollowersCount                  int            `json:"followers_count"`
+	WonDealsCount                   int            `json:"won_deals_count"`
+	RelatedWonDealsCount            int            `json:"related_won_deals_count"`
+	LostDealsCount                  int            `json:"lost_deals_count"`
+	RelatedLostDealsCount           int            `json:"related_lost_deals_count"`
+	ActiveFlag                      bool           `json:"active_flag"`
+	Phone                           []ContactValue `json:"phone"`
+	Email                           []ContactValue `json:"email"`
+	FirstChar                       string         `json:"first_char"`
+	UpdateTime                      string         `json:"update_time"`
+	AddTime                         string         `json:"add_time"`
+	VisibleTo                       VisibleTo      `json:"visible_to"`
+	PictureID                       interface{}    `json:"picture_id"`
+	NextActivityDate                interface{}    `json:"next_activity_date"`
+	NextActivityTime                interface{}    `json:"next_activity_time"`
+	NextActivityID                  interface{}    `json:"next_activity_id"`
+	LastActivityID                  int            `json:"last_activity_id"`
+	LastActivityDate                string         `json:"last_activity_date"`
+	TimelineLastActivityTime        interface{}    `json:"timeline_last_activity_time"`
+	TimelineLastActivityTimeByOwner interface{}    `json:"timeline_last_activity_time_by_owner"`
+	LastIncomingMailTime            interface{}    `json:"last_incoming_mail_time"`
+	LastOutgoingMailTime            interface{}    `json:"last_outgoing_mail_time"`
+	OrgName                         interface{}    `json:"org_name"`
+	OwnerName                       string         `json:"owner_name"`
+	CcEmail                         string         `json:"cc_email"`
+	Label                           LabelID        `json:"label"`
+
+	// CustomFields holds the values of all custom fields by field key.
+	CustomFields CustomFields `json:"-"`
+
+	// Raw holds the JSON the person was decoded from.
+	Raw json.RawMessage `json:"-"`
 }
 
 func (p Person) String() string {
 	return Stringify(p)
 }
 
+// PrimaryEmail returns the primary email address of the person, or the
+// first one when none is marked as primary.
+func (p Person) PrimaryEmail() string {
+	return primaryContactValue(p.Email)
+}
+
+// PrimaryPhone returns the primary phone number of the person, or the
+// first one when none is marked as primary.
+func (p Person) PrimaryPhone() string {
+	return primaryContactValue(p.Phone)
+}
+
+// UnmarshalJSON decodes a person and collects its custom fields.
+func (p *Person) UnmarshalJSON(data []byte) error {
+	return p.decode(Codec{}, data)
+}
+
+// decode implements modelDecoder.
+func (p *Person) decode(codec Codec, data []byte) error {
+	type person Person
+
+	var v person
+
+	if err := codec.unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	fields, err := decodeCustomFields(data)
+
+	if err != nil {
+		return err
+	}
+
+	*p = Person(v)
+	p.CustomFields = fields
+	p.Raw = copyRaw(data)
+
+	return nil
+}
+
 // PersonsRespose represents multiple persons response.
 type PersonsRespose struct {
 	Success        bool           `json:"success"`
@@ -126,33 +204,66 @@ func (s *PersonsService) Summary(ctx context.Context) (*Summary, *Response, erro
 	return record, resp, nil
 }
 
+// PersonsListOptions specifices the optional parameters to the
+// PersonsService.ListWithOptions method.
+//
+// UserID is ignored when FilterID is set. FirstChar filters by the first
+// letter of the name.
+type PersonsListOptions struct {
+	UserID    uint   `url:"user_id,omitempty"`
+	FilterID  uint   `url:"filter_id,omitempty"`
+	FirstChar string `url:"first_char,omitempty"`
+	Start     uint   `url:"start,omitempty"`
+	Limit     uint   `url:"limit,omitempty"`
+	Sort      Sort   `url:"sort,omitempty"`
+}
+
 // List all persons.
 //
+// Deprecated: use ListWithOptions instead, which also filters and sorts the
+// persons and returns them typed.
+//
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Persons/get_persons
 func (s *PersonsService) List(ctx context.Context, opts PaginationParameters) (map[string]interface{}, *Response, error) {
-	var (
-		err error
-		req *http.Request
-		f   interface{}
-	)
+	req, err := s.client.NewRequest(http.MethodGet, "/persons", &PersonsListOptions{
+		Start: uint(opts.Start),
+		Limit: uint(opts.Limit),
+	}, nil)
 
-	switch {
-	case opts.Start > 0 || opts.Limit > 0:
-		req, err = s.client.NewRequest(http.MethodGet, "/persons", &opts, nil)
-	default:
-		req, err = s.client.NewRequest(http.MethodGet, "/persons", nil, nil)
+	if err != nil {
+		return nil, nil, err
 	}
 
+	var record map[string]interface{}
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// ListWithOptions returns all persons, optionally filtered and sorted.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Persons/get_persons
+func (s *PersonsService) ListWithOptions(ctx context.Context, opt *PersonsListOptions) (*PersonsRespose, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "/persons", opt, nil)
+
 	if err != nil {
 		return nil, nil, err
 	}
 
-	resp, err := s.client.Do(ctx, req, &f)
+	var record *PersonsRespose
+
+	resp, err := s.client.Do(ctx, req, &record)
+
 	if err != nil {
 		return nil, resp, err
 	}
 
-	return f.(map[string]interface{}), resp, nil
+	return record, resp, nil
 }
 
 // AddFollower adds a follower to person.
@@ -181,42 +292,130 @@ func (s *PersonsService) AddFollower(ctx context.Context, id int, userID int) (*
 	return record, resp, nil
 }
 
+// PersonsSearchOptions specifices the parameters to the
+// PersonsService.Search method.
+//
+// Fields restricts the fields searched in: "custom_fields", "email",
+// "notes", "phone" and "name". IncludeFields accepts "person.picture".
+type PersonsSearchOptions struct {
+	Term           string `url:"term"`
+	Fields         Fields `url:"fields,omitempty,comma"`
+	ExactMatch     bool   `url:"exact_match,omitempty"`
+	OrganizationID uint   `url:"organization_id,omitempty"`
+	IncludeFields  Fields `url:"include_fields,omitempty,comma"`
+	Start          uint   `url:"start,omitempty"`
+	Limit          uint   `url:"limit,omitempty"`
+}
+
+// Search persons by name, email, phone, notes and custom fields.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Persons/get_persons_search
+func (s *PersonsService) Search(ctx context.Context, opt *PersonsSearchOptions) (*ItemSearchResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "/persons/search", opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *ItemSearchResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// SearchAll returns every person matching the search, fetching all pages.
+// Persons found on several pages are returned once. When more than
+// maxResults match, the first maxResults are returned with ErrSearchLimit.
+func (s *PersonsService) SearchAll(ctx context.Context, opt *PersonsSearchOptions, maxResults int) ([]SearchItem, *Response, error) {
+	var page PersonsSearchOptions
+
+	if opt != nil {
+		page = *opt
+	}
+
+	if page.Limit == 0 {
+		page.Limit = searchPageLimit
+	}
+
+	return searchAll(ctx, s.client.NewPager("/persons/search", page.Start), maxResults, func(start uint) (*ItemSearchResponse, *Response, error) {
+		page.Start = start
+
+		return s.Search(ctx, &page)
+	})
+}
+
 // PersonCreateOptions specifices the optional parameters to the
 // PersonsService.Create method.
 type PersonCreateOptions struct {
-	Name      string    `json:"name"`
-	OwnerID   uint      `json:"owner_id"`
-	OrgID     uint      `json:"org_id"`
-	Email     string    `json:"email"`
-	Phone     string    `json:"phone"`
-	VisibleTo VisibleTo `json:"visible_to"`
-	AddTime   Timestamp `json:"add_time"`
-	Label     uint      `json:"label"`
+	Name      string         `json:"name"`
+	OwnerID   uint           `json:"owner_id"`
+	OrgID     uint           `json:"org_id"`
+	Email     []ContactValue `json:"email,omitempty"`
+	Phone     []ContactValue `json:"phone,omitempty"`
+	VisibleTo VisibleTo      `json:"visible_to"`
+	AddTime   Timestamp      `json:"add_time"`
+	Label     uint           `json:"label"`
+
+	// CustomFields are sent along with the other fields.
+	CustomFields CustomFields `json:"-"`
+}
+
+// Validate checks the required name, the email addresses, the phone
+// numbers and the visibility value.
+func (o PersonCreateOptions) Validate() error {
+	if o.Name == "" {
+		return requiredError("name")
+	}
+
+	if err := validateContactValues("email", o.Email); err != nil {
+		return err
+	}
+
+	if err := validateContactValues("phone", o.Phone); err != nil {
+		return err
+	}
+
+	return validateVisibleTo(o.VisibleTo)
+}
+
+// MarshalJSON encodes the options together with the custom fields, with
+// the times formatted as the API expects them.
+func (o PersonCreateOptions) MarshalJSON() ([]byte, error) {
+	return withCustomFields(struct {
+		Name      string         `json:"name"`
+		OwnerID   uint           `json:"owner_id"`
+		OrgID     uint           `json:"org_id"`
+		Email     []ContactValue `json:"email,omitempty"`
+		Phone     []ContactValue `json:"phone,omitempty"`
+		Label     uint           `json:"label"`
+		VisibleTo VisibleTo      `json:"visible_to"`
+		AddTime   string         `json:"add_time"`
+	}{
+		o.Name,
+		o.OwnerID,
+		o.OrgID,
+		o.Email,
+		o.Phone,
+		o.Label,
+		o.VisibleTo,
+		o.AddTime.FormatFull(),
+	}, o.CustomFields)
 }
 
 // Create a new person.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Persons/post_persons
 func (s *PersonsService) Create(ctx context.Context, opt *PersonCreateOptions) (*PersonResponse, *Response, error) {
-	req, err := s.client.NewRequest(http.MethodPost, "/persons", nil, struct {
-		Name      string    `json:"name"`
-		OwnerID   uint      `json:"owner_id"`
-		OrgID     uint      `json:"org_id"`
-		Email     string    `json:"email"`
-		Phone     string    `json:"phone"`
-		Label     uint      `json:"label"`
-		VisibleTo VisibleTo `json:"visible_to"`
-		AddTime   string    `json:"add_time"`
-	}{
-		opt.Name,
-		opt.OwnerID,
-		opt.OrgID,
-		opt.Email,
-		opt.Phone,
-		opt.Label,
-		opt.VisibleTo,
-		opt.AddTime.FormatFull(),
-	})
+	if opt == nil {
+		return nil, nil, requiredError("name")
+	}
+
+	req, err := s.client.NewRequest(http.MethodPost, "/persons", nil, opt)
 
 	if err != nil {
 		return nil, nil, err
@@ -235,15 +434,52 @@ func (s *PersonsService) Create(ctx context.Context, opt *PersonCreateOptions) (
 
 // PersonUpdateOptions specifices the optional parameters to the
 // PersonUpdateOptions.Update method.
+//
+// Set OrgID to NoID() to remove the person from its organization.
 type PersonUpdateOptions struct {
-	Name            string       `json:"name,omitempty"`
-	OwnerID         uint         `json:"owner_id,omitempty"`
-	OrgID           uint         `json:"org_id,omitempty"`
-	Email           []PhoneEmail `json:"email,omitempty"`
-	Phone           string       `json:"phone,omitempty"`
-	VisibleTo       VisibleTo    `json:"visible_to,omitempty"`
-	BillingAddress  string       `json:"d5d6ecba25dd34146d3b9d0f1bb34dedf384143a,omitempty"`
-	DeliveryAddress string       `json:"fb3875ae1de17d63a1a0a9a7643bb677b95ae7fb,omitempty"`
+	Name            *string        `json:"name,omitempty"`
+	OwnerID         *uint          `json:"owner_id,omitempty"`
+	OrgID           *OptionalID    `json:"org_id,omitempty"`
+	Email           []ContactValue `json:"email,omitempty"`
+	Phone           []ContactValue `json:"phone,omitempty"`
+	VisibleTo       *VisibleTo     `json:"visible_to,omitempty"`
+	Label           *uint          `json:"label,omitempty"`
+	BillingAddress  *string        `json:"d5d6ecba25dd34146d3b9d0f1bb34dedf384143a,omitempty"`
+	DeliveryAddress *string        `json:"fb3875ae1de17d63a1a0a9a7643bb677b95ae7fb,omitempty"`
+
+	// CustomFields are sent along with the other fields.
+	CustomFields CustomFields `json:"-"`
+
+	// Null holds the keys of fields to clear.
+	Null NullFields `json:"-"`
+}
+
+// Validate checks the email addresses, the phone numbers and the
+// visibility value.
+func (o PersonUpdateOptions) Validate() error {
+	if err := validateContactValues("email", o.Email); err != nil {
+		return err
+	}
+
+	if err := validateContactValues("phone", o.Phone); err != nil {
+		return err
+	}
+
+	return validateVisibleTo(visibleToValue(o.VisibleTo))
+}
+
+// MarshalJSON encodes the options together with the custom fields and
+// the cleared fields.
+func (o PersonUpdateOptions) MarshalJSON() ([]byte, error) {
+	type options PersonUpdateOptions
+
+	data, err := withCustomFields(options(o), o.CustomFields)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return o.Null.apply(data)
 }
 
 // Update a specific person.
@@ -298,14 +534,7 @@ func (s *PersonsService) Merge(ctx context.Context, id int, mergeWithID int) (*P
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Persons/delete_persons_id_followers_follower_id
 func (s *PersonsService) DeleteFollower(ctx context.Context, id int, followerID int) (*Response, error) {
-	uri := fmt.Sprintf("/persons/%v/followers/%v", id, followerID)
-	req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
-
-	if err != nil {
-		return nil, err
-	}
-
-	return s.client.Do(ctx, req, nil)
+	return s.client.Followers.Remove(ctx, FollowedPerson, id, followerID)
 }
 
 // Delete marks person as deleted.
@@ -341,7 +570,7 @@ func (s *PersonsService) DeletePicture(ctx context.Context, id int) (*Response,
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Persons/delete_persons
 func (s *PersonsService) DeleteMultiple(ctx context.Context, ids []int) (*Response, error) {
 	req, err := s.client.NewRequest(http.MethodDelete, "/persons", &DeleteMultipleOptions{
-		Ids: arrayToString(ids, ","),
+		Ids: ids,
 	}, nil)
 
 	if err != nil {
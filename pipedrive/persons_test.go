@@ -0,0 +1,47 @@
+package pipedrive
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestPersonsService_List(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/persons", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		if got, want := r.URL.Query().Encode(), "limit=10&start=20"; got != want {
+			t.Errorf("Request query: %v, want %v", got, want)
+		}
+
+		writeJSON(w, http.StatusOK, `{"success": true, "data": [{"id": 1}]}`)
+	})
+
+	persons, _, err := client.Persons.List(context.Background(), PaginationParameters{Start: 20, Limit: 10})
+
+	if err != nil || persons["success"] != true {
+		t.Errorf("List returned %v, %v", persons, err)
+	}
+}
+
+func TestPersonsService_ListWithOptions(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/persons", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("first_char"); got != "a" {
+			t.Errorf("Request first_char: %v, want a", got)
+		}
+
+		writeJSON(w, http.StatusOK, `{"success": true, "data": [{"id": 1, "name": "Ann"}]}`)
+	})
+
+	persons, _, err := client.Persons.ListWithOptions(context.Background(), &PersonsListOptions{FirstChar: "a"})
+
+	if err != nil || persons.Data[0].Name != "Ann" {
+		t.Errorf("ListWithOptions returned %+v, %v", persons, err)
+	}
+}
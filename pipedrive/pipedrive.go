@@ -3,7 +3,6 @@ package pipedrive
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -23,6 +22,10 @@ const (
 
 	libraryVersion = "1"
 
+	// Path prefixes of the API versions.
+	apiVersion1 = "v" + libraryVersion
+	apiVersion2 = "api/v2"
+
 	hostProtocol = "https"
 
 	// The amount of requests current API token can perform for the 10 seconds window.
@@ -45,9 +48,66 @@ type Client struct {
 	BaseURL *url.URL
 	ApiKey  string
 
+	// TokenAuth selects how ApiKey is sent, in a header by default.
+	TokenAuth TokenAuth
+
+	// UserAgent identifies the service making the requests, it is sent in
+	// the User-Agent header followed by the version of the package and of
+	// Go. See WithUserAgent.
+	UserAgent string
+
+	// ClientName, if set, is sent in the X-Client-Name header. See
+	// WithClientName.
+	ClientName string
+
 	rateMutex   sync.Mutex
 	currentRate Rate
 
+	// StrictDecoding makes Do report response fields the models do not
+	// declare with an *UnknownFieldsError, and decoding errors that are
+	// otherwise ignored. It helps to keep the models current with the API.
+	StrictDecoding bool
+
+	// CoalesceGETs makes concurrent identical GET requests share one call
+	// to the API, see Do. Set it before making requests.
+	CoalesceGETs bool
+	flights      flightGroup
+
+	// APIVersions routes the requests for a resource, the first segment
+	// of the request path such as "deals", to a version of the API.
+	// Resources without an entry use version 1. It allows moving to newer
+	// versions one resource at a time, for resources whose responses in
+	// the new version still decode into the models. Set it before making
+	// requests.
+	APIVersions map[string]APIVersion
+
+	// Codec encodes request bodies and decodes response bodies, with
+	// encoding/json when zero. Set it before making requests.
+	Codec Codec
+
+	// FieldValidation, if set, checks the bodies of requests creating and
+	// updating deals, persons, organizations, products, activities and
+	// notes against their field definitions before they are sent, see
+	// FieldRegistry.ValidatePayload. Set it before making requests.
+	FieldValidation *FieldRegistry
+
+	// OnRequest, if set, is called after every request with a log entry
+	// whose URL is redacted by DefaultRedactor. Set it before making
+	// requests.
+	OnRequest RequestLogger
+
+	// OnDeprecation, if set, is called whenever a response marks the
+	// requested endpoint as deprecated. Set it before making requests.
+	OnDeprecation DeprecationHandler
+
+	// OnPage, if set, is called after every page fetched by the helpers
+	// returning all pages of a list, such as DealService.ListAll.
+	OnPage PageProgressFunc
+
+	// Usage, if set, counts the requests per feature and endpoint, see
+	// UsageTracker. Set it before making requests.
+	Usage *UsageTracker
+
 	// Reuse a single struct instead of allocating one for each service.
 	common service
 
@@ -77,6 +137,21 @@ type Client struct {
 	DealFields        *DealFieldsService
 	Persons           *PersonsService
 	Organizations     *OrganizationsService
+	Roles             *RolesService
+	LegacyTeams       *LegacyTeamsService
+	Subscriptions     *SubscriptionsService
+	CallLogs          *CallLogsService
+	MailThreads       *MailThreadsService
+	MailMessages      *MailMessagesService
+	Projects          *ProjectsService
+	ProjectTemplates  *ProjectTemplatesService
+	Tasks             *TasksService
+	Channels          *ChannelsService
+	Meetings          *MeetingsService
+	Billing           *BillingService
+	Search            *SearchService
+	LostReasons       *LostReasonsService
+	Followers         *FollowersService
 }
 
 type service struct {
@@ -86,6 +161,7 @@ type service struct {
 type Config struct {
 	APIKey        string
 	CompanyDomain string
+	TokenAuth     TokenAuth
 }
 
 type Rate struct {
@@ -101,6 +177,9 @@ func (r Rate) String() string {
 type Response struct {
 	*http.Response
 	Rate
+
+	// Deprecation is set when the requested endpoint is deprecated.
+	Deprecation *Deprecation
 }
 
 // Parse the rate from response headers.
@@ -117,35 +196,50 @@ func parseRateFromResponse(r *http.Response) Rate {
 
 	if reset := r.Header.Get(headerRateReset); reset != "" {
 		if value, _ := strconv.ParseInt(reset, 10, 64); value != 0 {
-			rate.Reset = Timestamp{time.Unix(value, 0)}
+			rate.Reset = Timestamp{time.Now().Add(time.Duration(value) * time.Second)}
 		}
 	}
 
 	return rate
 }
 
+// NewRequest creates an API request. The API version is chosen by the
+// APIVersions routing table.
 func (c *Client) NewRequest(method, url string, opt interface{}, body interface{}) (*http.Request, error) {
+	return c.newVersionedRequest(c.versionPath(url), method, url, opt, body)
+}
+
+// newVersionedRequest creates an API request against the given API version,
+// for the endpoints which are only available in newer versions of the API.
+func (c *Client) newVersionedRequest(version, method, url string, opt interface{}, body interface{}) (*http.Request, error) {
 	if !strings.HasSuffix(c.BaseURL.Path, "/") {
 		return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", c.BaseURL)
 	}
 
-	u, err := c.createRequestUrl(url, opt)
+	if err := validate(opt); err != nil {
+		return nil, err
+	}
+
+	if err := validate(body); err != nil {
+		return nil, err
+	}
+
+	u, err := c.createVersionedRequestUrl(version, url, opt)
 
 	if err != nil {
 		return nil, err
 	}
 
-	var buf io.ReadWriter
+	var buf io.Reader
 
 	if body != nil {
-		buf = new(bytes.Buffer)
-		enc := json.NewEncoder(buf)
-		enc.SetEscapeHTML(false)
-		err := enc.Encode(body)
+		data, err := c.Codec.marshal(body)
 
 		if err != nil {
 			return nil, err
 		}
+
+		buf = bytes.NewReader(data)
 	}
 
 	request, err := http.NewRequest(method, u, buf)
@@ -154,6 +248,9 @@ func (c *Client) NewRequest(method, url string, opt interface{}, body interface{
 		return nil, err
 	}
 
+	c.authorize(request)
+	c.identify(request)
+
 	if body != nil {
 		request.Header.Set("Content-Type", "application/json")
 	}
@@ -161,12 +258,39 @@ func (c *Client) NewRequest(method, url string, opt interface{}, body interface{
 	return request, nil
 }
 
+// NewUploadRequest creates an upload request. The body is sent as is with the
+// given content type, which is typically a multipart/form-data with boundary.
+func (c *Client) NewUploadRequest(method, url string, opt interface{}, body io.Reader, contentType string) (*http.Request, error) {
+	if !strings.HasSuffix(c.BaseURL.Path, "/") {
+		return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", c.BaseURL)
+	}
+
+	u, err := c.createRequestUrl(url, opt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest(method, u, body)
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.authorize(request)
+	c.identify(request)
+
+	request.Header.Set("Content-Type", contentType)
+
+	return request, nil
+}
+
 func (c *Client) checkRateLimitBeforeDo(req *http.Request) *RateLimitError {
 	c.rateMutex.Lock()
 	rate := c.currentRate
 	c.rateMutex.Unlock()
 
-	if !rate.Reset.Time.IsZero() && rate.Remaining == 0 {
+	if !rate.Reset.Time.IsZero() && rate.Remaining == 0 && time.Now().Before(rate.Reset.Time) {
 		resp := &http.Response{
 			Status:     http.StatusText(http.StatusForbidden),
 			StatusCode: http.StatusForbidden,
@@ -185,6 +309,32 @@ func (c *Client) checkRateLimitBeforeDo(req *http.Request) *RateLimitError {
 	return nil
 }
 
+// waitForRateLimit blocks until the rate limit window of err resets. It
+// returns false when err is not a rate limit error or ctx is done first.
+func waitForRateLimit(ctx context.Context, err error) bool {
+	rateLimitErr, ok := err.(*RateLimitError)
+
+	if !ok {
+		return false
+	}
+
+	wait := rateLimitErr.Rate.Reset.Time.Sub(time.Now())
+
+	if wait <= 0 {
+		wait = time.Second
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
 func (c *Client) checkResponse(r *http.Response) error {
 	if code := r.StatusCode; 200 <= code && code <= 299 {
 		return nil
@@ -194,11 +344,12 @@ func (c *Client) checkResponse(r *http.Response) error {
 	errorResponse := &ErrorResponse{Response: r}
 
 	if err == nil && data != nil {
-		json.Unmarshal(data, errorResponse)
+		c.Codec.unmarshal(data, errorResponse)
 	}
 
 	switch {
-	case r.StatusCode == http.StatusForbidden && r.Header.Get(headerRateRemaining) == "0":
+	case r.StatusCode == http.StatusTooManyRequests,
+		r.StatusCode == http.StatusForbidden && r.Header.Get(headerRateRemaining) == "0":
 		return &RateLimitError{
 			Rate:     parseRateFromResponse(r),
 			Response: errorResponse.Response,
@@ -209,57 +360,172 @@ func (c *Client) checkResponse(r *http.Response) error {
 	}
 }
 
-// Do sends an API request and returns the API response. T
+// Do sends an API request and returns the API response. The API response is
+// JSON decoded and stored in the value pointed to by v, or returned as an
+// error if an API error has occurred. If v implements the io.Writer
+// interface, the raw response body will be written to v, without attempting to
+// first decode it.
 //
 // The provided ctx must be non-nil. If it is canceled or times out,
 // ctx.Err() will be returned.
+//
+// With CoalesceGETs set, a GET request for the same URL as one still in
+// flight waits for that call and gets a copy of its response instead of
+// sending its own. When the ctx of the request that sent the call is done,
+// the waiting requests send the call again. Requests writing the body to
+// an io.Writer, such as downloads, are not coalesced, so the body is
+// streamed instead of held in memory.
 func (c *Client) Do(ctx context.Context, request *http.Request, v interface{}) (*Response, error) {
+	if _, ok := v.(io.Writer); c.CoalesceGETs && request.Method == http.MethodGet && !ok {
+		return c.doCoalesced(ctx, request, v)
+	}
+
+	response, err := c.send(ctx, request)
+
+	if err != nil {
+		return response, err
+	}
+
+	resp := response.Response
+
+	defer func() {
+		io.CopyN(ioutil.Discard, resp.Body, 512)
+		resp.Body.Close()
+	}()
+
+	return response, c.decode(resp.Body, v)
+}
+
+// decode decodes a response body into v, see Do.
+func (c *Client) decode(body io.Reader, v interface{}) error {
+	if w, ok := v.(io.Writer); ok {
+		_, err := io.Copy(w, body)
+
+		return err
+	}
+
+	if c.StrictDecoding && v != nil {
+		return c.decodeStrict(body, v)
+	}
+
+	if v == nil {
+		return nil
+	}
+
+	data, err := ioutil.ReadAll(body)
+
+	if err != nil || len(bytes.TrimSpace(data)) == 0 {
+		return err
+	}
+
+	c.unmarshal(data, v)
+
+	return nil
+}
+
+// send sends an API request and checks the API response. On success the
+// caller must close the response body, on error it is already closed.
+func (c *Client) send(ctx context.Context, request *http.Request) (*Response, error) {
+	if c.FieldValidation != nil {
+		if err := c.validateRequestFields(ctx, request); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := c.checkRateLimitBeforeDo(request); err != nil {
 		return &Response{
 			Response: err.Response,
 		}, err
 	}
 
-	resp, err := c.client.Do(request)
+	started := time.Now()
+	resp, err := c.client.Do(request.WithContext(ctx))
 
 	if err != nil {
 		select {
 		case <-ctx.Done():
-			return nil, ctx.Err()
+			err = ctx.Err()
 		default:
+			// The error of the HTTP client holds the URL with the API token.
+			if urlErr, ok := err.(*url.Error); ok {
+				urlErr.URL = DefaultRedactor.String(urlErr.URL)
+			}
 		}
 
+		c.logRequest(request, nil, started, err)
+		c.Usage.record(ctx, request, nil, started, err)
+
 		return nil, err
 	}
 
-	defer func() {
-		io.CopyN(ioutil.Discard, resp.Body, 512)
-		resp.Body.Close()
-	}()
-
 	response := newResponse(resp)
 
 	c.rateMutex.Lock()
 	c.currentRate = response.Rate
 	c.rateMutex.Unlock()
 
+	if response.Deprecation != nil && c.OnDeprecation != nil {
+		c.OnDeprecation(request, response.Deprecation)
+	}
+
 	err = c.checkResponse(response.Response)
+	c.logRequest(request, resp, started, err)
+	c.Usage.record(ctx, request, response, started, err)
 
 	if err != nil {
+		resp.Body.Close()
+
 		return response, err
 	}
 
-	err = json.NewDecoder(resp.Body).Decode(v)
+	return response, nil
+}
 
-	if err == io.EOF {
-		return response, nil
+func (c *Client) logRequest(request *http.Request, resp *http.Response, started time.Time, err error) {
+	if c.OnRequest == nil {
+		return
 	}
 
-	return response, nil
+	entry := RequestLog{
+		Method:   request.Method,
+		URL:      DefaultRedactor.URL(request.URL),
+		Duration: time.Since(started),
+		Err:      err,
+	}
+
+	if resp != nil {
+		entry.StatusCode = resp.StatusCode
+	}
+
+	c.OnRequest(entry)
+}
+
+// decodeStrict decodes the JSON in r into v, then reports the fields v
+// does not declare.
+func (c *Client) decodeStrict(r io.Reader, v interface{}) error {
+	data, err := ioutil.ReadAll(r)
+
+	if err != nil || len(bytes.TrimSpace(data)) == 0 {
+		return err
+	}
+
+	if err := c.unmarshal(data, v); err != nil {
+		return err
+	}
+
+	if fields := unknownFields(data, reflect.TypeOf(v)); len(fields) > 0 {
+		return &UnknownFieldsError{Fields: fields}
+	}
+
+	return nil
 }
 
 func (c *Client) createRequestUrl(path string, opt interface{}) (string, error) {
-	uri, err := c.BaseURL.Parse(hostProtocol + "://" + c.BaseURL.Path + "v" + libraryVersion)
+	return c.createVersionedRequestUrl(c.versionPath(path), path, opt)
+}
+
+func (c *Client) createVersionedRequestUrl(version, path string, opt interface{}) (string, error) {
+	uri, err := c.BaseURL.Parse(hostProtocol + "://" + c.BaseURL.Path + version)
 
 	if err != nil {
 		return path, err
@@ -269,23 +535,18 @@ func (c *Client) createRequestUrl(path string, opt interface{}) (string, error)
 
 	v := reflect.ValueOf(opt)
 
-	if v.Kind() == reflect.Ptr && v.IsNil() {
-		parameters := url.Values{}
-		parameters.Add("api_token", c.ApiKey)
+	qs := url.Values{}
 
-		uri.RawQuery = parameters.Encode()
-
-		return uri.String(), nil
+	if v.Kind() != reflect.Ptr || !v.IsNil() {
+		if qs, err = query.Values(opt); err != nil {
+			return path, err
+		}
 	}
 
-	qs, err := query.Values(opt)
-
-	if err != nil {
-		return path, err
+	if c.TokenAuth == TokenInQuery {
+		qs.Add("api_token", c.ApiKey)
 	}
 
-	qs.Add("api_token", c.ApiKey)
-
 	uri.RawQuery = qs.Encode()
 
 	return uri.String(), nil
@@ -311,9 +572,10 @@ func NewClient(options *Config) *Client {
 	}
 
 	c := &Client{
-		client:  http.DefaultClient,
-		BaseURL: baseURL,
-		ApiKey:  options.APIKey,
+		client:    http.DefaultClient,
+		BaseURL:   baseURL,
+		ApiKey:    options.APIKey,
+		TokenAuth: options.TokenAuth,
 	}
 
 	c.common.client = c
@@ -344,6 +606,21 @@ func NewClient(options *Config) *Client {
 	c.DealFields = (*DealFieldsService)(&c.common)
 	c.Persons = (*PersonsService)(&c.common)
 	c.Organizations = (*OrganizationsService)(&c.common)
+	c.Roles = (*RolesService)(&c.common)
+	c.LegacyTeams = (*LegacyTeamsService)(&c.common)
+	c.Subscriptions = (*SubscriptionsService)(&c.common)
+	c.CallLogs = (*CallLogsService)(&c.common)
+	c.MailThreads = (*MailThreadsService)(&c.common)
+	c.MailMessages = (*MailMessagesService)(&c.common)
+	c.Projects = (*ProjectsService)(&c.common)
+	c.ProjectTemplates = (*ProjectTemplatesService)(&c.common)
+	c.Tasks = (*TasksService)(&c.common)
+	c.Channels = (*ChannelsService)(&c.common)
+	c.Meetings = (*MeetingsService)(&c.common)
+	c.Billing = (*BillingService)(&c.common)
+	c.Search = (*SearchService)(&c.common)
+	c.LostReasons = (*LostReasonsService)(&c.common)
+	c.Followers = (*FollowersService)(&c.common)
 
 	return c
 }
@@ -351,17 +628,7 @@ func NewClient(options *Config) *Client {
 func newResponse(r *http.Response) *Response {
 	response := &Response{Response: r}
 	response.Rate = parseRateFromResponse(r)
+	response.Deprecation = parseDeprecationFromResponse(r)
 
 	return response
 }
-
-// Converts []int to string by separator.
-func arrayToString(a []int, sep string) string {
-	b := make([]string, len(a))
-
-	for i, v := range a {
-		b[i] = strconv.Itoa(v)
-	}
-
-	return strings.Join(b, sep)
-}
@@ -0,0 +1,41 @@
+package pipedrive
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// setup starts a test server answering the requests of the returned
+// client with mux. The paths of the handlers include the API version,
+// such as /v1/deals.
+func setup() (client *Client, mux *http.ServeMux, teardown func()) {
+	mux = http.NewServeMux()
+	server := httptest.NewTLSServer(mux)
+
+	client = NewClient(&Config{APIKey: "token"})
+	client.BaseURL = &url.URL{Path: strings.TrimPrefix(server.URL, "https://") + "/"}
+	client.client = &http.Client{
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+	}
+
+	return client, mux, server.Close
+}
+
+// testMethod reports a request with another method than want.
+func testMethod(t *testing.T, r *http.Request, want string) {
+	if got := r.Method; got != want {
+		t.Errorf("Request method: %v, want %v", got, want)
+	}
+}
+
+// writeJSON writes the JSON body with the status code.
+func writeJSON(w http.ResponseWriter, status int, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	fmt.Fprint(w, body)
+}
@@ -114,6 +114,69 @@ type PipelineDealsMovementResponse struct {
 	} `json:"data"`
 }
 
+// PipelineDealsResponse represents deals in a pipeline response.
+type PipelineDealsResponse struct {
+	Success        bool           `json:"success"`
+	Data           []Deal         `json:"data"`
+	AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// PipelineStageConversion represents conversion rate between two stages.
+type PipelineStageConversion struct {
+	FromStageID    int     `json:"from_stage_id"`
+	ToStageID      int     `json:"to_stage_id"`
+	ConversionRate float64 `json:"conversion_rate"`
+}
+
+// PipelineConversionStatistics represents a Pipedrive pipeline conversion statistics.
+type PipelineConversionStatistics struct {
+	StageConversions []PipelineStageConversion `json:"stage_conversions"`
+	WonConversion    float64                   `json:"won_conversion"`
+	LostConversion   float64                   `json:"lost_conversion"`
+}
+
+// PipelineConversionStatisticsResponse represents conversion statistics response.
+type PipelineConversionStatisticsResponse struct {
+	Success bool                         `json:"success"`
+	Data    PipelineConversionStatistics `json:"data"`
+}
+
+// PipelineMovementDeals represents a group of deals in movement statistics.
+// Values and formatted values are keyed by currency code.
+type PipelineMovementDeals struct {
+	Count           int                `json:"count"`
+	DealIds         []int              `json:"deal_ids"`
+	Values          map[string]float64 `json:"values"`
+	FormattedValues map[string]string  `json:"formatted_values"`
+}
+
+// PipelineStageAge represents average age of deals in a stage.
+type PipelineStageAge struct {
+	StageID int     `json:"stage_id"`
+	Value   float64 `json:"value"`
+}
+
+// PipelineMovementStatistics represents a Pipedrive pipeline movement statistics.
+type PipelineMovementStatistics struct {
+	MovementsBetweenStages struct {
+		Count int `json:"count"`
+	} `json:"movements_between_stages"`
+	NewDeals         PipelineMovementDeals `json:"new_deals"`
+	DealsLeftOpen    PipelineMovementDeals `json:"deals_left_open"`
+	WonDeals         PipelineMovementDeals `json:"won_deals"`
+	LostDeals        PipelineMovementDeals `json:"lost_deals"`
+	AverageAgeInDays struct {
+		AcrossAllStages float64            `json:"across_all_stages"`
+		ByStages        []PipelineStageAge `json:"by_stages"`
+	} `json:"average_age_in_days"`
+}
+
+// PipelineMovementStatisticsResponse represents movement statistics response.
+type PipelineMovementStatisticsResponse struct {
+	Success bool                       `json:"success"`
+	Data    PipelineMovementStatistics `json:"data"`
+}
+
 // List returns data about all pipelines.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Pipelines/get_pipelines
@@ -159,6 +222,8 @@ func (s *PipelinesService) GetByID(ctx context.Context, id int) (*PipelineRespon
 
 // GetDeals returns deal in a specific pipeline.
 //
+// Deprecated: use ListDeals instead.
+//
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Pipelines/get_pipelines_id_deals
 func (s *PipelinesService) GetDeals(ctx context.Context, id int) (*PipelinesResponse, *Response, error) {
 	uri := fmt.Sprintf("/pipelines/%v/deals", id)
@@ -181,6 +246,8 @@ func (s *PipelinesService) GetDeals(ctx context.Context, id int) (*PipelinesResp
 
 // GetDealsConversionRate returns deals conversion rate in a specific pipeline.
 //
+// Deprecated: use ConversionStatistics instead.
+//
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Pipelines/get_pipelines_id_conversion_statistics
 func (s *PipelinesService) GetDealsConversionRate(ctx context.Context, id int, startDate Timestamp, endDate Timestamp) (*PipelineDealsConversionRateResponse, *Response, error) {
 	uri := fmt.Sprintf("/pipelines/%v/conversion_statistics", id)
@@ -209,6 +276,8 @@ func (s *PipelinesService) GetDealsConversionRate(ctx context.Context, id int, s
 
 // GetDealsMovement returns deals movement in a specific pipeline.
 //
+// Deprecated: use MovementStatistics instead.
+//
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Pipelines/get_pipelines_id_movement_statistics
 func (s *PipelinesService) GetDealsMovement(ctx context.Context, id int, startDate Timestamp, endDate Timestamp) (*PipelineDealsMovementResponse, *Response, error) {
 	uri := fmt.Sprintf("/pipelines/%v/movement_statistics", id)
@@ -235,6 +304,94 @@ func (s *PipelinesService) GetDealsMovement(ctx context.Context, id int, startDa
 	return record, resp, nil
 }
 
+// PipelineListDealsOptions specifices the optional parameters to the
+// PipelinesService.ListDeals method.
+type PipelineListDealsOptions struct {
+	FilterID              uint   `url:"filter_id,omitempty"`
+	UserID                uint   `url:"user_id,omitempty"`
+	Everyone              uint8  `url:"everyone,omitempty"`
+	StageID               uint   `url:"stage_id,omitempty"`
+	Start                 uint   `url:"start,omitempty"`
+	Limit                 uint   `url:"limit,omitempty"`
+	GetSummary            uint8  `url:"get_summary,omitempty"`
+	TotalsConvertCurrency string `url:"totals_convert_currency,omitempty"`
+}
+
+// ListDeals lists deals in a specific pipeline across all its stages.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Pipelines/get_pipelines_id_deals
+func (s *PipelinesService) ListDeals(ctx context.Context, id int, opt *PipelineListDealsOptions) (*PipelineDealsResponse, *Response, error) {
+	uri := fmt.Sprintf("/pipelines/%v/deals", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *PipelineDealsResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// PipelineStatisticsOptions specifices the parameters to the
+// PipelinesService.ConversionStatistics and PipelinesService.MovementStatistics methods.
+// Dates are expected in YYYY-MM-DD format.
+type PipelineStatisticsOptions struct {
+	StartDate string `url:"start_date"`
+	EndDate   string `url:"end_date"`
+	UserID    uint   `url:"user_id,omitempty"`
+}
+
+// ConversionStatistics returns stage-to-stage conversion and pipeline-to-close rates for the given time period.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Pipelines/get_pipelines_id_conversion_statistics
+func (s *PipelinesService) ConversionStatistics(ctx context.Context, id int, opt *PipelineStatisticsOptions) (*PipelineConversionStatisticsResponse, *Response, error) {
+	uri := fmt.Sprintf("/pipelines/%v/conversion_statistics", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *PipelineConversionStatisticsResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// MovementStatistics returns statistics for deals movements for the given time period.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Pipelines/get_pipelines_id_movement_statistics
+func (s *PipelinesService) MovementStatistics(ctx context.Context, id int, opt *PipelineStatisticsOptions) (*PipelineMovementStatisticsResponse, *Response, error) {
+	uri := fmt.Sprintf("/pipelines/%v/movement_statistics", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *PipelineMovementStatisticsResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
 // PipelineCreateOptions specifices the optional parameters to the
 // PipelineCreateOptions.Create method.
 type PipelineCreateOptions struct {
@@ -267,11 +424,13 @@ func (s *PipelinesService) Create(ctx context.Context, opt *PipelineCreateOption
 
 // PipelineUpdateOptions specifices the optional parameters to the
 // PipelinesService.Update method.
+//
+// Only the fields that are set are sent.
 type PipelineUpdateOptions struct {
-	Name            string          `url:"name"`
-	DealProbability DealProbability `url:"deal_probability"`
-	OrderNr         int             `url:"order_nr"`
-	Active          ActiveFlag      `url:"active"`
+	Name            *string          `json:"name,omitempty"`
+	DealProbability *DealProbability `json:"deal_probability,omitempty"`
+	OrderNr         *int             `json:"order_nr,omitempty"`
+	Active          *ActiveFlag      `json:"active,omitempty"`
 }
 
 // Update a specific pipeline.
@@ -279,7 +438,7 @@ type PipelineUpdateOptions struct {
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Pipelines/put_pipelines_id
 func (s *PipelinesService) Update(ctx context.Context, id int, opt *PipelineUpdateOptions) (*PipelineResponse, *Response, error) {
 	uri := fmt.Sprintf("/pipelines/%v", id)
-	req, err := s.client.NewRequest(http.MethodPost, uri, nil, opt)
+	req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
 
 	if err != nil {
 		return nil, nil, err
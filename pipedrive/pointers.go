@@ -0,0 +1,21 @@
+package pipedrive
+
+// String allocates a new string value to store v and returns a pointer
+// to it. It is meant for the pointer fields of update options.
+func String(v string) *string { return &v }
+
+// Int allocates a new int value to store v and returns a pointer to it.
+func Int(v int) *int { return &v }
+
+// Uint allocates a new uint value to store v and returns a pointer to it.
+func Uint(v uint) *uint { return &v }
+
+// Uint8 allocates a new uint8 value to store v and returns a pointer to it.
+func Uint8(v uint8) *uint8 { return &v }
+
+// Float64 allocates a new float64 value to store v and returns a pointer
+// to it.
+func Float64(v float64) *float64 { return &v }
+
+// Bool allocates a new bool value to store v and returns a pointer to it.
+func Bool(v bool) *bool { return &v }
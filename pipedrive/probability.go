@@ -0,0 +1,35 @@
+package pipedrive
+
+// EffectiveProbability returns the probability in percent that the deal is
+// won, as Pipedrive uses it to weight the deal value. The probability of
+// the deal overrides the deal probability of its stage. Won deals count
+// 100 and lost or deleted deals 0. When the pipeline has deal probability
+// turned off, open deals count 100. The pipeline may be nil.
+func (d Deal) EffectiveProbability(stage *Stage, pipeline *Pipeline) float64 {
+	switch {
+	case d.IsDeleted() || d.Status == DealStatusLost:
+		return 0
+	case d.Status == DealStatusWon:
+		return 100
+	case pipeline != nil && !pipeline.DealProbability:
+		return 100
+	case d.Probability != nil:
+		return *d.Probability
+	case stage != nil:
+		return float64(stage.DealProbability)
+	}
+
+	return 100
+}
+
+// WeightedValueFor returns the value of the deal weighted by its
+// EffectiveProbability, in the currency of the deal.
+func (d Deal) WeightedValueFor(stage *Stage, pipeline *Pipeline) float64 {
+	return d.Value * d.EffectiveProbability(stage, pipeline) / 100
+}
+
+// WeightedValue returns the value weighted by the deal probability of the
+// stage, for deals without a probability of their own.
+func (s Stage) WeightedValue(value float64) float64 {
+	return value * float64(s.DealProbability) / 100
+}
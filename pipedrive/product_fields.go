@@ -6,7 +6,7 @@ import (
 	"net/http"
 )
 
-// ProductFieldsService handles pipelines related
+// ProductFieldsService handles product fields related
 // methods of the Pipedrive API.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ProductFields
@@ -19,7 +19,7 @@ type ProductField struct {
 	Name               string      `json:"name"`
 	OrderNr            int         `json:"order_nr"`
 	PicklistData       interface{} `json:"picklist_data,omitempty"`
-	FieldType          string      `json:"field_type"`
+	FieldType          FieldType   `json:"field_type"`
 	AddTime            string      `json:"add_time"`
 	UpdateTime         string      `json:"update_time"`
 	ActiveFlag         bool        `json:"active_flag"`
@@ -36,10 +36,7 @@ type ProductField struct {
 	Link               string      `json:"link,omitempty"`
 	MandatoryFlag      bool        `json:"mandatory_flag"`
 	DisplayField       string      `json:"display_field,omitempty"`
-	Options            []struct {
-		ID    int    `json:"id"`
-		Label string `json:"label"`
-	} `json:"options,omitempty"`
+	Options            []Option    `json:"options,omitempty"`
 }
 
 func (p ProductField) String() string {
@@ -105,10 +102,18 @@ func (s *ProductFieldsService) GetByID(ctx context.Context, id int) (*ProductFie
 
 // ProductFieldCreateOptions specifices the optional parameters to the
 // ProductFieldsService.Create method.
+//
+// Options are required for enum and set fields, only labels need to be given.
 type ProductFieldCreateOptions struct {
-	Name      string    `url:"name"`
-	FieldType FieldType `url:"field_type"`
-	Options   string    `url:"options"`
+	Name      string    `json:"name"`
+	FieldType FieldType `json:"field_type"`
+	Options   []Option  `json:"options,omitempty"`
+}
+
+// Validate checks the required name and field type, and that options
+// are given for enum and set fields.
+func (o ProductFieldCreateOptions) Validate() error {
+	return validateFieldCreate(o.Name, o.FieldType, o.Options)
 }
 
 // Create a new product field.
@@ -134,9 +139,13 @@ func (s *ProductFieldsService) Create(ctx context.Context, opt *ProductFieldCrea
 
 // ProductFieldUpdateOptions specifices the optional parameters to the
 // ProductFieldsService.Update method.
+//
+// When Options are given, they replace the existing option list. Existing
+// options must be given with their ID, options without ID are added and
+// options left out are removed.
 type ProductFieldUpdateOptions struct {
-	Name    string `url:"name"`
-	Options string `url:"options"`
+	Name    string   `json:"name,omitempty"`
+	Options []Option `json:"options,omitempty"`
 }
 
 // Update a specific product field.
@@ -166,7 +175,7 @@ func (s *ProductFieldsService) Update(ctx context.Context, id int, opt *ProductF
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ProductFields/delete_productFields
 func (s *ProductFieldsService) DeleteMultiple(ctx context.Context, ids []int) (*Response, error) {
 	req, err := s.client.NewRequest(http.MethodDelete, "/productFields", &DeleteMultipleOptions{
-		Ids: arrayToString(ids, ","),
+		Ids: ids,
 	}, nil)
 
 	if err != nil {
@@ -189,3 +198,62 @@ func (s *ProductFieldsService) Delete(ctx context.Context, id int) (*Response, e
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// AddOptions appends options with the given labels to an enum or set
+// product field, keeping the existing ones.
+func (s *ProductFieldsService) AddOptions(ctx context.Context, id int, labels ...string) (*ProductFieldResponse, *Response, error) {
+	field, resp, err := s.GetByID(ctx, id)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	options := field.Data.Options
+
+	for _, label := range labels {
+		options = append(options, Option{Label: label})
+	}
+
+	return s.Update(ctx, id, &ProductFieldUpdateOptions{
+		Options: options,
+	})
+}
+
+// RemoveOptions removes the options with the given IDs from an enum or set
+// product field.
+func (s *ProductFieldsService) RemoveOptions(ctx context.Context, id int, optionIDs ...int) (*ProductFieldResponse, *Response, error) {
+	field, resp, err := s.GetByID(ctx, id)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	remove := make(map[int]bool, len(optionIDs))
+
+	for _, optionID := range optionIDs {
+		remove[optionID] = true
+	}
+
+	options := make([]Option, 0, len(field.Data.Options))
+
+	for _, option := range field.Data.Options {
+		if !remove[option.ID] {
+			options = append(options, option)
+		}
+	}
+
+	return s.Update(ctx, id, &ProductFieldUpdateOptions{
+		Options: options,
+	})
+}
+
+// ByKey returns product fields keyed by their API key.
+func (r *ProductFieldsResponse) ByKey() map[string]ProductField {
+	fields := make(map[string]ProductField, len(r.Data))
+
+	for _, field := range r.Data {
+		fields[field.Key] = field
+	}
+
+	return fields
+}
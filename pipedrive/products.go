@@ -2,6 +2,7 @@ package pipedrive
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 )
@@ -22,7 +23,7 @@ type Product struct {
 	ActiveFlag bool        `json:"active_flag"`
 	Selectable bool        `json:"selectable"`
 	FirstChar  string      `json:"first_char"`
-	VisibleTo  string      `json:"visible_to"`
+	VisibleTo  VisibleTo   `json:"visible_to"`
 	OwnerID    struct {
 		ID         int    `json:"id"`
 		Name       string `json:"name"`
@@ -44,12 +45,36 @@ type Product struct {
 		Cost         int    `json:"cost"`
 		OverheadCost int    `json:"overhead_cost"`
 	} `json:"prices"`
+
+	// Raw holds the JSON the product was decoded from.
+	Raw json.RawMessage `json:"-"`
 }
 
 func (p Product) String() string {
 	return Stringify(p)
 }
 
+// UnmarshalJSON decodes a product and keeps its JSON.
+func (p *Product) UnmarshalJSON(data []byte) error {
+	return p.decode(Codec{}, data)
+}
+
+// decode implements modelDecoder.
+func (p *Product) decode(codec Codec, data []byte) error {
+	type product Product
+
+	var v product
+
+	if err := codec.unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*p = Product(v)
+	p.Raw = copyRaw(data)
+
+	return nil
+}
+
 // ProductsResponse represents multiple products response.
 type ProductsResponse struct {
 	Success        bool           `json:"success"`
@@ -92,10 +117,27 @@ func (s *ProductsService) Summary(ctx context.Context) (*Summary, *Response, err
 
 // GetAttachedDeals returns product attached deals.
 //
+// Deprecated: use ListDeals instead, which supports pagination and status filtering.
+//
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Products/get_products_id_deals
 func (s *ProductsService) GetAttachedDeals(ctx context.Context, id int) (*ProductAttachedDealsResponse, *Response, error) {
+	return s.ListDeals(ctx, id, nil)
+}
+
+// ProductListDealsOptions specifices the optional parameters to the
+// ProductsService.ListDeals method.
+type ProductListDealsOptions struct {
+	Start  uint   `url:"start,omitempty"`
+	Limit  uint   `url:"limit,omitempty"`
+	Status string `url:"status,omitempty"`
+}
+
+// ListDeals returns deals where a specific product is attached to.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Products/get_products_id_deals
+func (s *ProductsService) ListDeals(ctx context.Context, id int, opt *ProductListDealsOptions) (*ProductAttachedDealsResponse, *Response, error) {
 	uri := fmt.Sprintf("/products/%v/deals", id)
-	req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+	req, err := s.client.NewRequest(http.MethodGet, uri, opt, nil)
 
 	if err != nil {
 		return nil, nil, err
@@ -112,6 +154,37 @@ func (s *ProductsService) GetAttachedDeals(ctx context.Context, id int) (*Produc
 	return record, resp, nil
 }
 
+// ProductListFilesOptions specifices the optional parameters to the
+// ProductsService.ListFiles method.
+type ProductListFilesOptions struct {
+	Start               uint  `url:"start,omitempty"`
+	Limit               uint  `url:"limit,omitempty"`
+	IncludeDeletedFiles uint8 `url:"include_deleted_files,omitempty"`
+	Sort                Sort  `url:"sort,omitempty"`
+}
+
+// ListFiles returns files attached to a specific product.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Products/get_products_id_files
+func (s *ProductsService) ListFiles(ctx context.Context, id int, opt *ProductListFilesOptions) (*FilesResponse, *Response, error) {
+	uri := fmt.Sprintf("/products/%v/files", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *FilesResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
 // List returns all data about products.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Products/get_products
@@ -220,15 +293,41 @@ func (s *ProductsService) Create(ctx context.Context, opt *ProductCreateOptions)
 
 // ProductUpdateOptions specifices the optional parameters to the
 // ProductsService.Update method.
+//
+// Only the fields that are set are sent.
 type ProductUpdateOptions struct {
-	Name       string     `url:"name"`
-	Code       string     `url:"code"`
-	Unit       string     `url:"unit"`
-	Tax        int        `url:"tax"`
-	ActiveFlag ActiveFlag `url:"active_flag"`
-	VisibleTo  VisibleTo  `url:"visible_to"`
-	OwnerID    int        `url:"owner_id"`
-	Prices     string     `url:"prices"`
+	Name       *string               `json:"name,omitempty"`
+	Code       *string               `json:"code,omitempty"`
+	Unit       *string               `json:"unit,omitempty"`
+	Tax        *float64              `json:"tax,omitempty"`
+	ActiveFlag *ActiveFlag           `json:"active_flag,omitempty"`
+	VisibleTo  *VisibleTo            `json:"visible_to,omitempty"`
+	OwnerID    *uint                 `json:"owner_id,omitempty"`
+	Prices     []ProductPriceOptions `json:"prices,omitempty"`
+
+	// Null holds the keys of fields to clear.
+	Null NullFields `json:"-"`
+}
+
+// MarshalJSON encodes the options together with the cleared fields.
+func (o ProductUpdateOptions) MarshalJSON() ([]byte, error) {
+	type options ProductUpdateOptions
+
+	data, err := json.Marshal(options(o))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return o.Null.apply(data)
+}
+
+// ProductPriceOptions represents the price of a product in one currency.
+type ProductPriceOptions struct {
+	Price        float64 `json:"price"`
+	Currency     string  `json:"currency"`
+	Cost         float64 `json:"cost,omitempty"`
+	OverheadCost float64 `json:"overhead_cost,omitempty"`
 }
 
 // Update a specific product.
@@ -271,12 +370,5 @@ func (s *ProductsService) Delete(ctx context.Context, id int) (*Response, error)
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Products/delete_products_id_followers_follower_id
 func (s *ProductsService) DeleteFollower(ctx context.Context, id int, followerID int) (*Response, error) {
-	uri := fmt.Sprintf("/products/%v/followers/%v", id, followerID)
-	req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
-
-	if err != nil {
-		return nil, err
-	}
-
-	return s.client.Do(ctx, req, nil)
+	return s.client.Followers.Remove(ctx, FollowedProduct, id, followerID)
 }
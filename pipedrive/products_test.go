@@ -0,0 +1,47 @@
+package pipedrive
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestProductsService_ListDeals(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/products/1/deals", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		if got := r.URL.Query().Get("status"); got != "won" {
+			t.Errorf("Request status: %v, want won", got)
+		}
+
+		writeJSON(w, http.StatusOK, `{"success": true, "data": [{"id": 2}]}`)
+	})
+
+	deals, _, err := client.Products.ListDeals(context.Background(), 1, &ProductListDealsOptions{Status: "won"})
+
+	if err != nil || len(deals.Data) != 1 {
+		t.Errorf("ListDeals returned %+v, %v", deals, err)
+	}
+}
+
+func TestProductsService_GetAttachedDeals(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/products/1/deals", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Errorf("Request query: %v, want none", r.URL.RawQuery)
+		}
+
+		writeJSON(w, http.StatusOK, `{"success": true, "data": [{"id": 2}]}`)
+	})
+
+	deals, _, err := client.Products.GetAttachedDeals(context.Background(), 1)
+
+	if err != nil || len(deals.Data) != 1 {
+		t.Errorf("GetAttachedDeals returned %+v, %v", deals, err)
+	}
+}
@@ -0,0 +1,92 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ProjectTemplatesService handles project templates related
+// methods of the Pipedrive API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ProjectTemplates
+type ProjectTemplatesService service
+
+// ProjectTemplate represents a Pipedrive project template.
+type ProjectTemplate struct {
+	ID              int    `json:"id"`
+	Title           string `json:"title"`
+	Description     string `json:"description"`
+	ProjectsBoardID int    `json:"projects_board_id"`
+	OwnerID         int    `json:"owner_id"`
+	AddTime         string `json:"add_time"`
+	UpdateTime      string `json:"update_time"`
+}
+
+func (p ProjectTemplate) String() string {
+	return Stringify(p)
+}
+
+// ProjectTemplatesResponse represents multiple project templates response.
+type ProjectTemplatesResponse struct {
+	Success        bool              `json:"success"`
+	Data           []ProjectTemplate `json:"data"`
+	AdditionalData AdditionalData    `json:"additional_data"`
+}
+
+// ProjectTemplateResponse represents single project template response.
+type ProjectTemplateResponse struct {
+	Success bool            `json:"success"`
+	Data    ProjectTemplate `json:"data"`
+}
+
+// ProjectTemplatesListOptions specifices the optional parameters to the
+// ProjectTemplatesService.List method.
+type ProjectTemplatesListOptions struct {
+	Cursor string `url:"cursor,omitempty"`
+	Limit  uint   `url:"limit,omitempty"`
+}
+
+// List returns all project templates. Results are paginated with a cursor,
+// use AdditionalData.NextCursor to fetch the next page.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ProjectTemplates/get_projectTemplates
+func (s *ProjectTemplatesService) List(ctx context.Context, opt *ProjectTemplatesListOptions) (*ProjectTemplatesResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "/projectTemplates", opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *ProjectTemplatesResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// GetByID returns a specific project template.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ProjectTemplates/get_projectTemplates_id
+func (s *ProjectTemplatesService) GetByID(ctx context.Context, id int) (*ProjectTemplateResponse, *Response, error) {
+	uri := fmt.Sprintf("/projectTemplates/%v", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *ProjectTemplateResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
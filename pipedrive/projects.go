@@ -0,0 +1,473 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ProjectsService handles projects related
+// methods of the Pipedrive API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Projects
+type ProjectsService service
+
+// Project represents a Pipedrive project.
+type Project struct {
+	ID               int    `json:"id"`
+	Title            string `json:"title"`
+	BoardID          int    `json:"board_id"`
+	PhaseID          int    `json:"phase_id"`
+	Description      string `json:"description"`
+	Status           string `json:"status"`
+	OwnerID          int    `json:"owner_id"`
+	StartDate        string `json:"start_date"`
+	EndDate          string `json:"end_date"`
+	DealIds          []int  `json:"deal_ids"`
+	OrgID            int    `json:"org_id"`
+	PersonID         int    `json:"person_id"`
+	Labels           []int  `json:"labels"`
+	AddTime          string `json:"add_time"`
+	UpdateTime       string `json:"update_time"`
+	StatusChangeTime string `json:"status_change_time"`
+	ArchiveTime      string `json:"archive_time"`
+}
+
+func (p Project) String() string {
+	return Stringify(p)
+}
+
+// ProjectBoard represents a Pipedrive project board.
+type ProjectBoard struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	OrderNr    int    `json:"order_nr"`
+	AddTime    string `json:"add_time"`
+	UpdateTime string `json:"update_time"`
+}
+
+func (b ProjectBoard) String() string {
+	return Stringify(b)
+}
+
+// ProjectPhase represents a phase of a project board.
+type ProjectPhase struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	BoardID    int    `json:"board_id"`
+	OrderNr    int    `json:"order_nr"`
+	AddTime    string `json:"add_time"`
+	UpdateTime string `json:"update_time"`
+}
+
+func (p ProjectPhase) String() string {
+	return Stringify(p)
+}
+
+// ProjectGroup represents a group of tasks and activities within a project.
+type ProjectGroup struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	OrderNr int    `json:"order_nr"`
+}
+
+func (g ProjectGroup) String() string {
+	return Stringify(g)
+}
+
+// ProjectsResponse represents multiple projects response.
+type ProjectsResponse struct {
+	Success        bool           `json:"success"`
+	Data           []Project      `json:"data"`
+	AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// ProjectResponse represents single project response.
+type ProjectResponse struct {
+	Success bool    `json:"success"`
+	Data    Project `json:"data"`
+}
+
+// ProjectBoardsResponse represents multiple project boards response.
+type ProjectBoardsResponse struct {
+	Success bool           `json:"success"`
+	Data    []ProjectBoard `json:"data"`
+}
+
+// ProjectBoardResponse represents single project board response.
+type ProjectBoardResponse struct {
+	Success bool         `json:"success"`
+	Data    ProjectBoard `json:"data"`
+}
+
+// ProjectPhasesResponse represents multiple project phases response.
+type ProjectPhasesResponse struct {
+	Success bool           `json:"success"`
+	Data    []ProjectPhase `json:"data"`
+}
+
+// ProjectPhaseResponse represents single project phase response.
+type ProjectPhaseResponse struct {
+	Success bool         `json:"success"`
+	Data    ProjectPhase `json:"data"`
+}
+
+// ProjectGroupsResponse represents multiple project groups response.
+type ProjectGroupsResponse struct {
+	Success bool           `json:"success"`
+	Data    []ProjectGroup `json:"data"`
+}
+
+// ProjectsListOptions specifices the optional parameters to the
+// ProjectsService.List method.
+type ProjectsListOptions struct {
+	Cursor          string `url:"cursor,omitempty"`
+	Limit           uint   `url:"limit,omitempty"`
+	FilterID        uint   `url:"filter_id,omitempty"`
+	Status          string `url:"status,omitempty"`
+	PhaseID         uint   `url:"phase_id,omitempty"`
+	IncludeArchived bool   `url:"include_archived,omitempty"`
+}
+
+// List returns all projects. Results are paginated with a cursor, use
+// AdditionalData.NextCursor to fetch the next page.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Projects/get_projects
+func (s *ProjectsService) List(ctx context.Context, opt *ProjectsListOptions) (*ProjectsResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "/projects", opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *ProjectsResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// GetByID returns a specific project.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Projects/get_projects_id
+func (s *ProjectsService) GetByID(ctx context.Context, id int) (*ProjectResponse, *Response, error) {
+	uri := fmt.Sprintf("/projects/%v", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *ProjectResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// ProjectCreateOptions specifices the parameters to the
+// ProjectsService.Create method.
+//
+// When TemplateID is given, the project is created from the
+// project template.
+type ProjectCreateOptions struct {
+	Title       string `json:"title"`
+	BoardID     uint   `json:"board_id"`
+	PhaseID     uint   `json:"phase_id"`
+	Description string `json:"description,omitempty"`
+	Status      string `json:"status,omitempty"`
+	OwnerID     uint   `json:"owner_id,omitempty"`
+	StartDate   string `json:"start_date,omitempty"`
+	EndDate     string `json:"end_date,omitempty"`
+	DealIds     []int  `json:"deal_ids,omitempty"`
+	OrgID       uint   `json:"org_id,omitempty"`
+	PersonID    uint   `json:"person_id,omitempty"`
+	Labels      []int  `json:"labels,omitempty"`
+	TemplateID  uint   `json:"template_id,omitempty"`
+}
+
+// Create a new project.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Projects/post_projects
+func (s *ProjectsService) Create(ctx context.Context, opt *ProjectCreateOptions) (*ProjectResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "/projects", nil, opt)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *ProjectResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// ListGroups returns all groups of a specific project.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Projects/get_projects_id_groups
+func (s *ProjectsService) ListGroups(ctx context.Context, id int) (*ProjectGroupsResponse, *Response, error) {
+	uri := fmt.Sprintf("/projects/%v/groups", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *ProjectGroupsResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// ListBoards returns all project boards.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Projects/get_projects_boards
+func (s *ProjectsService) ListBoards(ctx context.Context) (*ProjectBoardsResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "/projects/boards", nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *ProjectBoardsResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// GetBoard returns a specific project board.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Projects/get_projects_boards_id
+func (s *ProjectsService) GetBoard(ctx context.Context, id int) (*ProjectBoardResponse, *Response, error) {
+	uri := fmt.Sprintf("/projects/boards/%v", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *ProjectBoardResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// ProjectPhasesListOptions specifices the parameters to the
+// ProjectsService.ListPhases method.
+type ProjectPhasesListOptions struct {
+	BoardID uint `url:"board_id"`
+}
+
+// ListPhases returns all phases of a specific project board.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Projects/get_projects_phases
+func (s *ProjectsService) ListPhases(ctx context.Context, opt *ProjectPhasesListOptions) (*ProjectPhasesResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "/projects/phases", opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *ProjectPhasesResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// GetPhase returns a specific project phase.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Projects/get_projects_phases_id
+func (s *ProjectsService) GetPhase(ctx context.Context, id int) (*ProjectPhaseResponse, *Response, error) {
+	uri := fmt.Sprintf("/projects/phases/%v", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *ProjectPhaseResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// ProjectPlanItem represents the placement of an activity or task within
+// the phases and groups of a project.
+type ProjectPlanItem struct {
+	ItemID   int    `json:"item_id"`
+	ItemType string `json:"item_type"`
+	PhaseID  int    `json:"phase_id"`
+	GroupID  int    `json:"group_id"`
+}
+
+func (p ProjectPlanItem) String() string {
+	return Stringify(p)
+}
+
+// ProjectPlanResponse represents project plan response.
+type ProjectPlanResponse struct {
+	Success bool              `json:"success"`
+	Data    []ProjectPlanItem `json:"data"`
+}
+
+// ProjectPlanItemResponse represents single project plan item response.
+type ProjectPlanItemResponse struct {
+	Success bool            `json:"success"`
+	Data    ProjectPlanItem `json:"data"`
+}
+
+// ListActivities returns all activities linked to a specific project.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Projects/get_projects_id_activities
+func (s *ProjectsService) ListActivities(ctx context.Context, id int) (*ActivitiesReponse, *Response, error) {
+	uri := fmt.Sprintf("/projects/%v/activities", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *ActivitiesReponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// ListTasks returns all tasks linked to a specific project.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Projects/get_projects_id_tasks
+func (s *ProjectsService) ListTasks(ctx context.Context, id int) (*TasksResponse, *Response, error) {
+	uri := fmt.Sprintf("/projects/%v/tasks", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *TasksResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// GetPlan returns the phase and group placement of all activities and
+// tasks of a specific project.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Projects/get_projects_id_plan
+func (s *ProjectsService) GetPlan(ctx context.Context, id int) (*ProjectPlanResponse, *Response, error) {
+	uri := fmt.Sprintf("/projects/%v/plan", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *ProjectPlanResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// ProjectPlanUpdateOptions specifices the optional parameters to the
+// ProjectsService.UpdateActivityPlan and ProjectsService.UpdateTaskPlan methods.
+type ProjectPlanUpdateOptions struct {
+	PhaseID uint `json:"phase_id,omitempty"`
+	GroupID uint `json:"group_id,omitempty"`
+}
+
+// UpdateActivityPlan moves an activity to a phase or group of a project.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Projects/put_projects_id_plan_activities_activityId
+func (s *ProjectsService) UpdateActivityPlan(ctx context.Context, id int, activityID int, opt *ProjectPlanUpdateOptions) (*ProjectPlanItemResponse, *Response, error) {
+	uri := fmt.Sprintf("/projects/%v/plan/activities/%v", id, activityID)
+	req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *ProjectPlanItemResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// UpdateTaskPlan moves a task to a phase or group of a project.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Projects/put_projects_id_plan_tasks_taskId
+func (s *ProjectsService) UpdateTaskPlan(ctx context.Context, id int, taskID int, opt *ProjectPlanUpdateOptions) (*ProjectPlanItemResponse, *Response, error) {
+	uri := fmt.Sprintf("/projects/%v/plan/tasks/%v", id, taskID)
+	req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *ProjectPlanItemResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
@@ -0,0 +1,335 @@
+package pipedrive
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	defaultQueueWorkers    = 5
+	defaultQueueRate       = 10
+	defaultQueueMaxRetries = 3
+)
+
+// ErrQueueStopped is returned for jobs that are enqueued after, or still
+// waiting when, the queue stopped running.
+var ErrQueueStopped = errors.New("queue stopped")
+
+// JobPriority represents the priority of a queued job. Jobs with a higher
+// priority always run before jobs with a lower one, jobs with the same
+// priority run in the order they were enqueued.
+type JobPriority int
+
+// JobPriority constants.
+const (
+	PriorityLow    JobPriority = -1
+	PriorityNormal JobPriority = 0
+	PriorityHigh   JobPriority = 1
+)
+
+// Job is a unit of API work, it should send a single request.
+type Job func(ctx context.Context, c *Client) error
+
+// Queue runs jobs on a pool of workers under a shared rate budget, so
+// interactive work submitted with a high priority is not held up by batch
+// work in the same process. When a job hits the rate limit, the whole
+// queue pauses until the limit resets and the job is retried.
+type Queue struct {
+	client *Client
+
+	// Workers is the number of jobs run at the same time, 5 when zero.
+	Workers int
+
+	// Rate is the number of jobs started per second, 10 when zero.
+	Rate float64
+
+	// MaxRetries is how often a rate limited job is retried, 3 when zero.
+	// Jobs are not retried when it is negative.
+	MaxRetries int
+
+	mu          sync.Mutex
+	jobs        jobHeap
+	seq         uint64
+	pausedUntil time.Time
+	running     int
+	stopped     bool
+	ready       chan struct{}
+	lifecycle   LifecycleRunner
+}
+
+// NewQueue returns a Queue running jobs with the client. Jobs are only run
+// while Run is running.
+func (c *Client) NewQueue() *Queue {
+	return &Queue{
+		client: c,
+		ready:  make(chan struct{}, 1),
+	}
+}
+
+type queuedJob struct {
+	ctx      context.Context
+	job      Job
+	priority JobPriority
+	seq      uint64
+	attempts int
+	done     chan error
+}
+
+// jobHeap orders jobs by priority, then by the order they were enqueued.
+type jobHeap []*queuedJob
+
+func (h jobHeap) Len() int { return len(h) }
+
+func (h jobHeap) Less(i, j int) bool {
+	if h[i].priority != h[j].priority {
+		return h[i].priority > h[j].priority
+	}
+
+	return h[i].seq < h[j].seq
+}
+
+func (h jobHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *jobHeap) Push(x interface{}) { *h = append(*h, x.(*queuedJob)) }
+
+func (h *jobHeap) Pop() interface{} {
+	old := *h
+	job := old[len(old)-1]
+	*h = old[:len(old)-1]
+
+	return job
+}
+
+// Enqueue adds a job and returns a channel receiving its error once it has
+// run. When ctx is done before the job runs, ctx.Err() is sent instead.
+// After Shutdown, ErrQueueStopped is sent.
+func (q *Queue) Enqueue(ctx context.Context, priority JobPriority, job Job) <-chan error {
+	done := make(chan error, 1)
+
+	q.mu.Lock()
+
+	if q.stopped || q.lifecycle.IsDraining() {
+		q.mu.Unlock()
+		done <- ErrQueueStopped
+
+		return done
+	}
+
+	q.seq++
+	heap.Push(&q.jobs, &queuedJob{ctx: ctx, job: job, priority: priority, seq: q.seq, done: done})
+	q.mu.Unlock()
+
+	q.signal()
+
+	return done
+}
+
+// Do enqueues a job and waits until it has run.
+func (q *Queue) Do(ctx context.Context, priority JobPriority, job Job) error {
+	select {
+	case err := <-q.Enqueue(ctx, priority, job):
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (q *Queue) signal() {
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+// Start implements Lifecycle, it calls Run in the background.
+func (q *Queue) Start(ctx context.Context) error {
+	return q.lifecycle.Start(ctx, q.Run)
+}
+
+// Shutdown implements Lifecycle. New jobs are rejected with
+// ErrQueueStopped, the queued jobs are run, including the retries of rate
+// limited ones, and Run returns nil once no job is left.
+func (q *Queue) Shutdown(ctx context.Context) error {
+	return q.lifecycle.Shutdown(ctx)
+}
+
+// Run runs the queued jobs until ctx is done, then fails the jobs still
+// waiting with ErrQueueStopped and returns ctx.Err(). Running jobs are
+// finished first, they are only canceled through their own context.
+// After Shutdown, Run returns nil once the queue drained.
+func (q *Queue) Run(ctx context.Context) error {
+	workers := q.Workers
+
+	if workers < 1 {
+		workers = defaultQueueWorkers
+	}
+
+	rate := q.Rate
+
+	if rate <= 0 {
+		rate = defaultQueueRate
+	}
+
+	interval := time.Duration(float64(time.Second) / rate)
+	work := make(chan *queuedJob)
+
+	var wg sync.WaitGroup
+
+	for worker := 0; worker < workers; worker++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for job := range work {
+				q.run(ctx, job)
+
+				q.mu.Lock()
+				q.running--
+				q.mu.Unlock()
+
+				q.signal()
+			}
+		}()
+	}
+
+	err := q.dispatch(ctx, work, interval)
+
+	close(work)
+	wg.Wait()
+
+	q.mu.Lock()
+	q.stopped = true
+	jobs := q.jobs
+	q.jobs = nil
+	q.mu.Unlock()
+
+	for _, job := range jobs {
+		job.done <- ErrQueueStopped
+	}
+
+	return err
+}
+
+// dispatch hands jobs to the workers, at most one per interval and none
+// while the queue is paused. While draining, it returns once no job is
+// queued or running.
+func (q *Queue) dispatch(ctx context.Context, work chan<- *queuedJob, interval time.Duration) error {
+	var next time.Time
+
+	drain := q.lifecycle.Draining()
+	draining := false
+
+	for {
+		q.mu.Lock()
+
+		if draining && len(q.jobs) == 0 && q.running == 0 {
+			q.mu.Unlock()
+			return nil
+		}
+
+		wait := next.Sub(time.Now())
+
+		if pause := q.pausedUntil.Sub(time.Now()); pause > wait {
+			wait = pause
+		}
+
+		var job *queuedJob
+
+		if wait <= 0 && len(q.jobs) > 0 {
+			job = heap.Pop(&q.jobs).(*queuedJob)
+			q.running++
+		}
+
+		q.mu.Unlock()
+
+		if job != nil {
+			select {
+			case work <- job:
+				next = time.Now().Add(interval)
+				continue
+			case <-ctx.Done():
+				job.done <- ErrQueueStopped
+				return ctx.Err()
+			}
+		}
+
+		if wait > 0 {
+			timer := time.NewTimer(wait)
+
+			select {
+			case <-timer.C:
+			case <-drain:
+				timer.Stop()
+				draining, drain = true, nil
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			}
+
+			continue
+		}
+
+		select {
+		case <-q.ready:
+		case <-drain:
+			draining, drain = true, nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// run runs a job, a rate limited job pauses the queue and is queued again.
+func (q *Queue) run(ctx context.Context, job *queuedJob) {
+	if err := job.ctx.Err(); err != nil {
+		job.done <- err
+		return
+	}
+
+	job.attempts++
+	err := job.job(job.ctx, q.client)
+
+	rateLimitErr, ok := err.(*RateLimitError)
+
+	if !ok {
+		job.done <- err
+		return
+	}
+
+	maxRetries := q.MaxRetries
+
+	switch {
+	case maxRetries == 0:
+		maxRetries = defaultQueueMaxRetries
+	case maxRetries < 0:
+		maxRetries = 0
+	}
+
+	q.mu.Lock()
+
+	reset := rateLimitErr.Rate.Reset.Time
+
+	if !reset.After(time.Now()) {
+		reset = time.Now().Add(time.Second)
+	}
+
+	if reset.After(q.pausedUntil) {
+		q.pausedUntil = reset
+	}
+
+	if job.attempts > maxRetries || q.stopped || ctx.Err() != nil {
+		q.mu.Unlock()
+		job.done <- err
+
+		return
+	}
+
+	heap.Push(&q.jobs, job)
+	q.mu.Unlock()
+
+	q.signal()
+}
@@ -0,0 +1,186 @@
+package pipedrive
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestQueue_Run_priorityOrder(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	q := client.NewQueue()
+	q.Workers = 1
+	q.Rate = 1000
+
+	var (
+		mu  sync.Mutex
+		ran []string
+	)
+
+	job := func(name string) Job {
+		return func(ctx context.Context, c *Client) error {
+			mu.Lock()
+			ran = append(ran, name)
+			mu.Unlock()
+
+			return nil
+		}
+	}
+
+	ctx := context.Background()
+
+	// The jobs are queued before Run, so the order is not left to the
+	// timing of the workers.
+	done := []<-chan error{
+		q.Enqueue(ctx, PriorityLow, job("low")),
+		q.Enqueue(ctx, PriorityNormal, job("normal 1")),
+		q.Enqueue(ctx, PriorityHigh, job("high 1")),
+		q.Enqueue(ctx, PriorityNormal, job("normal 2")),
+		q.Enqueue(ctx, PriorityHigh, job("high 2")),
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go q.Run(runCtx)
+
+	for _, d := range done {
+		if err := <-d; err != nil {
+			t.Fatalf("Job returned error: %v", err)
+		}
+	}
+
+	want := []string{"high 1", "high 2", "normal 1", "normal 2", "low"}
+
+	for i := range want {
+		if ran[i] != want[i] {
+			t.Fatalf("Queue ran %v, want %v", ran, want)
+		}
+	}
+}
+
+func TestQueue_Run_rateLimitPausesAndRetries(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var (
+		mu       sync.Mutex
+		requests []time.Time
+	)
+
+	mux.HandleFunc("/v1/deals/1/duplicate", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, time.Now())
+		first := len(requests) == 1
+		mu.Unlock()
+
+		if first {
+			w.Header().Set(headerRateLimit, "80")
+			w.Header().Set(headerRateRemaining, "0")
+			w.Header().Set(headerRateReset, "1")
+			writeJSON(w, http.StatusTooManyRequests, `{"success": false, "error": "Too many requests"}`)
+
+			return
+		}
+
+		writeJSON(w, http.StatusOK, `{"success": true, "data": {"id": 1}}`)
+	})
+
+	q := client.NewQueue()
+	q.Rate = 1000
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	go q.Run(ctx)
+
+	err := q.Do(ctx, PriorityNormal, func(ctx context.Context, c *Client) error {
+		_, _, err := c.Deals.Duplicate(ctx, 1)
+		return err
+	})
+
+	if err != nil {
+		t.Fatalf("Queue.Do returned error: %v", err)
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("Queue sent %v requests, want 2", len(requests))
+	}
+
+	// The queue pauses until the limit resets, a second later.
+	if pause := requests[1].Sub(requests[0]); pause < 900*time.Millisecond {
+		t.Errorf("Queue retried after %v, want it to wait for the reset", pause)
+	}
+}
+
+func TestQueue_Run_rateLimitWithoutRetries(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	q := client.NewQueue()
+	q.MaxRetries = -1
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	go q.Run(ctx)
+
+	attempts := 0
+
+	err := q.Do(ctx, PriorityNormal, func(ctx context.Context, c *Client) error {
+		attempts++
+		return &RateLimitError{}
+	})
+
+	if _, ok := err.(*RateLimitError); !ok || attempts != 1 {
+		t.Errorf("Queue.Do returned %v after %v attempts, want a *RateLimitError after 1", err, attempts)
+	}
+}
+
+func TestQueue_Shutdown_drains(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	q := client.NewQueue()
+	q.Workers = 2
+	q.Rate = 1000
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var done []<-chan error
+
+	for i := 0; i < 10; i++ {
+		done = append(done, q.Enqueue(ctx, PriorityNormal, func(ctx context.Context, c *Client) error {
+			time.Sleep(time.Millisecond)
+			return nil
+		}))
+	}
+
+	if err := q.Start(ctx); err != nil {
+		t.Fatalf("Queue.Start returned error: %v", err)
+	}
+
+	if err := q.Shutdown(ctx); err != nil {
+		t.Fatalf("Queue.Shutdown returned error: %v", err)
+	}
+
+	for i, d := range done {
+		select {
+		case err := <-d:
+			if err != nil {
+				t.Errorf("Job %v returned error: %v", i, err)
+			}
+		default:
+			t.Errorf("Job %v did not run before Shutdown returned", i)
+		}
+	}
+
+	if err := <-q.Enqueue(ctx, PriorityHigh, func(ctx context.Context, c *Client) error { return nil }); err != ErrQueueStopped {
+		t.Errorf("Enqueue after Shutdown returned %v, want %v", err, ErrQueueStopped)
+	}
+}
@@ -1,7 +1,9 @@
 package pipedrive
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"net/http"
 )
 
@@ -44,6 +46,31 @@ type RecentRecord struct {
 	Item string                `json:"item"`
 	ID   int                   `json:"id"`
 	Data []RecentRecordDetails `json:"data"`
+
+	// Object holds the JSON of the changed object, Data is only decoded
+	// for users.
+	Object json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes a recent record and keeps the JSON of its object.
+func (r *RecentRecord) UnmarshalJSON(data []byte) error {
+	var v struct {
+		Item string          `json:"item"`
+		ID   int             `json:"id"`
+		Data json.RawMessage `json:"data"`
+	}
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*r = RecentRecord{Item: v.Item, ID: v.ID, Object: copyRaw(v.Data)}
+
+	if trimmed := bytes.TrimSpace(v.Data); len(trimmed) > 0 && trimmed[0] == '[' {
+		return json.Unmarshal(trimmed, &r.Data)
+	}
+
+	return nil
 }
 
 // RecentsResponse represents multiple recents response.
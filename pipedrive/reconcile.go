@@ -0,0 +1,203 @@
+package pipedrive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	recentsTimeLayout = "2006-01-02 15:04:05"
+	recentsPageLimit  = 500
+)
+
+// RecentsReconciler finds changes that were not received as webhook
+// events, by comparing /recents with the events seen since a checkpoint.
+// Events for the missed changes are synthesized, so together with the
+// webhooks every change is delivered at least once.
+type RecentsReconciler struct {
+	client *Client
+
+	// Objects limits the reconciled objects, all objects when empty.
+	Objects []EventObject
+
+	mu    sync.Mutex
+	since time.Time
+	seen  map[string]time.Time
+}
+
+// NewRecentsReconciler returns a RecentsReconciler for changes after the
+// last processed time.
+func (c *Client) NewRecentsReconciler(since time.Time) *RecentsReconciler {
+	return &RecentsReconciler{
+		client: c,
+		since:  since.UTC(),
+		seen:   make(map[string]time.Time),
+	}
+}
+
+// Checkpoint returns the time up to which changes have been reconciled.
+// Persist it to resume after a restart.
+func (r *RecentsReconciler) Checkpoint() time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.since
+}
+
+// Seen records an event received as webhook, so the change is not
+// synthesized again.
+func (r *RecentsReconciler) Seen(event *Event) {
+	key := reconcileKey(string(event.Meta.Object), event.Meta.ID)
+	at := event.Meta.Time()
+
+	r.mu.Lock()
+
+	if at.After(r.seen[key]) {
+		r.seen[key] = at
+	}
+
+	r.mu.Unlock()
+}
+
+// Reconcile lists the changes since the checkpoint and returns events for
+// those that were not seen. The checkpoint only advances once all changes
+// have been listed, so a failed run is repeated in full.
+func (r *RecentsReconciler) Reconcile(ctx context.Context) ([]Event, *Response, error) {
+	opt := &RecentsListOptions{
+		SinceTimestamp: r.Checkpoint().Format(recentsTimeLayout),
+		Limit:          recentsPageLimit,
+	}
+
+	if len(r.Objects) > 0 {
+		items := make([]string, len(r.Objects))
+
+		for i, object := range r.Objects {
+			items[i] = string(object)
+		}
+
+		opt.Items = strings.Join(items, ",")
+	}
+
+	var (
+		events []Event
+		resp   *Response
+		last   time.Time
+	)
+
+	pager := r.client.NewPager("/recents", 0)
+
+	for {
+		opt.Start = pager.Start
+		page, pageResp, err := r.client.Recents.List(ctx, opt)
+		resp = pageResp
+
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, record := range page.Data {
+			event, updated, ok := r.missed(&record)
+
+			if updated.After(last) {
+				last = updated
+			}
+
+			if ok {
+				events = append(events, event)
+			}
+		}
+
+		if t, err := time.Parse(recentsTimeLayout, page.AdditionalData.LastTimestampOnPage); err == nil && t.After(last) {
+			last = t
+		}
+
+		more, err := pager.Next(page.AdditionalData.Pagination, len(page.Data))
+
+		if err != nil {
+			return nil, resp, err
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	r.mu.Lock()
+
+	if last.After(r.since) {
+		r.since = last
+	}
+
+	for key, at := range r.seen {
+		if !at.After(r.since) {
+			delete(r.seen, key)
+		}
+	}
+
+	r.mu.Unlock()
+
+	return events, resp, nil
+}
+
+// missed returns a synthesized event for a record whose change was not
+// seen, together with the time of the change.
+func (r *RecentsReconciler) missed(record *RecentRecord) (Event, time.Time, bool) {
+	var object struct {
+		AddTime    string `json:"add_time"`
+		UpdateTime string `json:"update_time"`
+	}
+
+	json.Unmarshal(record.Object, &object)
+
+	added, _ := time.Parse(recentsTimeLayout, object.AddTime)
+	updated, err := time.Parse(recentsTimeLayout, object.UpdateTime)
+
+	if err != nil {
+		updated = added
+	}
+
+	r.mu.Lock()
+	seen := r.seen[reconcileKey(record.Item, record.ID)]
+	r.mu.Unlock()
+
+	// Update times are truncated to seconds, webhook timestamps are not.
+	if !seen.IsZero() && !seen.Before(updated) {
+		return Event{}, updated, false
+	}
+
+	action := ACTION_UPDATED
+
+	switch {
+	case record.IsDeleted():
+		action = ACTION_DELETED
+	case !added.IsZero() && added.Equal(updated):
+		action = ACTION_ADDED
+	}
+
+	event := Event{
+		Version: "1",
+		Event:   fmt.Sprintf("%v.%v", action, record.Item),
+		Meta: EventMeta{
+			Action:    action,
+			Object:    EventObject(record.Item),
+			ID:        record.ID,
+			Timestamp: updated.Unix(),
+		},
+	}
+
+	if action == ACTION_DELETED {
+		event.Previous = record.Object
+	} else {
+		event.Current = record.Object
+	}
+
+	return event, updated, true
+}
+
+func reconcileKey(object string, id int) string {
+	return fmt.Sprintf("%v:%v", object, id)
+}
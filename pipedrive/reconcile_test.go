@@ -0,0 +1,161 @@
+package pipedrive
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func testReconcileTime(value string) time.Time {
+	t, _ := time.Parse(recentsTimeLayout, value)
+	return t
+}
+
+func TestRecentsReconciler_Reconcile(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/recents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		query := r.URL.Query()
+
+		if got := query.Get("since_timestamp"); got != "2020-01-01 00:00:00" {
+			t.Errorf("Request since_timestamp is %v, want 2020-01-01 00:00:00", got)
+		}
+
+		if got := query.Get("items"); got != "deal,person" {
+			t.Errorf("Request items is %v, want deal,person", got)
+		}
+
+		if query.Get("start") == "" {
+			writeJSON(w, http.StatusOK, `{"success": true, "data": [
+				{"item": "deal", "id": 1, "data": {"id": 1, "add_time": "2019-12-01 08:00:00", "update_time": "2020-01-01 10:00:00"}},
+				{"item": "deal", "id": 2, "data": {"id": 2, "add_time": "2020-01-01 11:00:00", "update_time": "2020-01-01 11:00:00"}}
+			], "additional_data": {"last_timestamp_on_page": "2020-01-01 11:00:00", "pagination": {"start": 0, "limit": 2, "more_items_in_collection": true, "next_start": 2}}}`)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, `{"success": true, "data": [
+			{"item": "person", "id": 3, "data": {"id": 3, "active_flag": false, "add_time": "2019-12-01 08:00:00", "update_time": "2020-01-01 12:00:00"}}
+		], "additional_data": {"last_timestamp_on_page": "2020-01-01 12:30:00", "pagination": {"start": 2, "limit": 2, "more_items_in_collection": false}}}`)
+	})
+
+	reconciler := client.NewRecentsReconciler(testReconcileTime("2020-01-01 00:00:00"))
+	reconciler.Objects = []EventObject{OBJECT_DEAL, OBJECT_PERSON}
+
+	seen := testReconcileTime("2020-01-01 10:00:00").Add(500 * time.Millisecond)
+	reconciler.Seen(&Event{Meta: EventMeta{Action: ACTION_UPDATED, Object: OBJECT_DEAL, ID: 1, TimestampMicro: seen.UnixNano() / int64(time.Microsecond)}})
+
+	events, _, err := reconciler.Reconcile(context.Background())
+
+	if err != nil {
+		t.Fatalf("Reconcile returned error: %v", err)
+	}
+
+	if len(events) != 2 {
+		t.Fatalf("Reconcile returned %v events, want 2", len(events))
+	}
+
+	if got := events[0]; got.Event != "added.deal" || got.Meta.ID != 2 || got.Current == nil {
+		t.Errorf("Reconcile returned %+v, want added.deal 2 with current", got)
+	}
+
+	if got := events[1]; got.Event != "deleted.person" || got.Meta.ID != 3 || got.Previous == nil || got.Current != nil {
+		t.Errorf("Reconcile returned %+v, want deleted.person 3 with previous", got)
+	}
+
+	if got, want := reconciler.Checkpoint(), testReconcileTime("2020-01-01 12:30:00"); !got.Equal(want) {
+		t.Errorf("Checkpoint is %v, want %v", got, want)
+	}
+
+	if len(reconciler.seen) != 0 {
+		t.Errorf("Reconciler remembers %v events before the checkpoint, want none", len(reconciler.seen))
+	}
+}
+
+func TestRecentsReconciler_Reconcile_error(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/recents", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("start") == "" {
+			writeJSON(w, http.StatusOK, `{"success": true, "data": [
+				{"item": "deal", "id": 1, "data": {"id": 1, "update_time": "2020-01-01 10:00:00"}}
+			], "additional_data": {"pagination": {"start": 0, "limit": 1, "more_items_in_collection": true, "next_start": 1}}}`)
+			return
+		}
+
+		writeJSON(w, http.StatusInternalServerError, `{"success": false, "error": "unavailable"}`)
+	})
+
+	since := testReconcileTime("2020-01-01 00:00:00")
+	reconciler := client.NewRecentsReconciler(since)
+
+	events, _, err := reconciler.Reconcile(context.Background())
+
+	if err == nil {
+		t.Fatal("Reconcile expected error on a failed page")
+	}
+
+	if events != nil {
+		t.Errorf("Reconcile returned %v events on error, want none", len(events))
+	}
+
+	if got := reconciler.Checkpoint(); !got.Equal(since) {
+		t.Errorf("Checkpoint advanced to %v on error, want %v", got, since)
+	}
+}
+
+func TestRecentsReconciler_concurrent(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/recents", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"success": true, "data": [
+			{"item": "deal", "id": 1, "data": {"id": 1, "update_time": "2020-01-01 10:00:00"}}
+		], "additional_data": {"pagination": {"start": 0, "limit": 1, "more_items_in_collection": false}}}`)
+	})
+
+	reconciler := client.NewRecentsReconciler(testReconcileTime("2020-01-01 00:00:00"))
+	at := testReconcileTime("2020-01-01 11:00:00")
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+
+		go func(id int) {
+			defer wg.Done()
+
+			for j := 0; j < 50; j++ {
+				reconciler.Seen(&Event{Meta: EventMeta{Object: OBJECT_DEAL, ID: id, Timestamp: at.Unix() + int64(j)}})
+				reconciler.Checkpoint()
+			}
+		}(i + 2)
+
+		go func() {
+			defer wg.Done()
+
+			if _, _, err := reconciler.Reconcile(context.Background()); err != nil {
+				t.Errorf("Reconcile returned error: %v", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if got, want := reconciler.Checkpoint(), testReconcileTime("2020-01-01 10:00:00"); !got.Equal(want) {
+		t.Errorf("Checkpoint is %v, want %v", got, want)
+	}
+
+	reconciler.mu.Lock()
+	remembered := len(reconciler.seen)
+	reconciler.mu.Unlock()
+
+	if remembered != 4 {
+		t.Errorf("Reconciler remembers %v objects, want the 4 changed after the checkpoint", remembered)
+	}
+}
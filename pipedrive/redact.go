@@ -0,0 +1,101 @@
+package pipedrive
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// redactedURLValue replaces secrets in URLs and text. Unlike redacted it
+// needs no escaping in URLs.
+const redactedURLValue = "REDACTED"
+
+// Redactor removes secrets from URLs and text, so they do not end up in
+// error messages and logs.
+type Redactor struct {
+	// Params are the names of the query parameters whose values are
+	// secret, compared case-insensitively.
+	Params []string
+}
+
+// DefaultRedactor redacts the API token and the OAuth parameters. It is
+// used by the errors and the request log of the client.
+var DefaultRedactor = Redactor{
+	Params: []string{"api_token", "access_token", "refresh_token", "client_secret", "code"},
+}
+
+// URL returns u as a string with the values of secret parameters and the
+// password of the user info replaced.
+func (r Redactor) URL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+
+	redactedURL := *u
+
+	if u.User != nil {
+		if _, ok := u.User.Password(); ok {
+			redactedURL.User = url.UserPassword(u.User.Username(), redactedURLValue)
+		}
+	}
+
+	if u.RawQuery != "" {
+		redactedURL.RawQuery = r.String(u.RawQuery)
+	}
+
+	return redactedURL.String()
+}
+
+// String returns s with the values of secret parameters and the passwords
+// of URLs replaced, for text that may contain URLs such as the message of
+// a wrapped error.
+func (r Redactor) String(s string) string {
+	if len(r.Params) > 0 {
+		s = r.paramsPattern().ReplaceAllString(s, "${1}"+redactedURLValue)
+	}
+
+	return userInfoPattern.ReplaceAllString(s, "${1}"+redactedURLValue+"@")
+}
+
+func (r Redactor) paramsPattern() *regexp.Regexp {
+	names := make([]string, len(r.Params))
+
+	for i, param := range r.Params {
+		names[i] = regexp.QuoteMeta(param)
+	}
+
+	return regexp.MustCompile(`(?i)((?:^|[?&;\s])(?:` + strings.Join(names, "|") + `)=)[^&;#\s"']*`)
+}
+
+// userInfoPattern matches the user and password of URLs, up to the @.
+var userInfoPattern = regexp.MustCompile(`(://[^/?#:@\s]*:)[^/?#@\s]*@`)
+
+// RequestLog describes a request sent by the client, with secrets
+// redacted.
+type RequestLog struct {
+	Method string
+	URL    string
+
+	// StatusCode is 0 when no response was received.
+	StatusCode int
+	Duration   time.Duration
+	Err        error
+}
+
+// RequestLogger is called after every request the client sent, see
+// Client.OnRequest.
+type RequestLogger func(RequestLog)
+
+// String returns a redacted summary of the response, for debugging.
+func (r *Response) String() string {
+	if r == nil || r.Response == nil {
+		return "<nil>"
+	}
+
+	if r.Request == nil {
+		return r.Status
+	}
+
+	return r.Request.Method + " " + DefaultRedactor.URL(r.Request.URL) + ": " + r.Status
+}
@@ -0,0 +1,337 @@
+package pipedrive
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+const defaultReferenceCacheTTL = 10 * time.Minute
+
+// Cache stores encoded values by key. It must be safe for concurrent use.
+// Back it with Redis or similar to share cached data between instances.
+type Cache interface {
+	// Get returns the value of the key and whether it was found.
+	Get(key string) ([]byte, bool, error)
+
+	// Set stores the value of the key until the TTL has passed.
+	Set(key string, value []byte, ttl time.Duration) error
+
+	// Delete removes the keys.
+	Delete(keys ...string) error
+}
+
+// ReferenceKind represents a kind of reference data.
+type ReferenceKind string
+
+// ReferenceKind constants.
+const (
+	ReferenceUsers         ReferenceKind = "users"
+	ReferencePipelines     ReferenceKind = "pipelines"
+	ReferenceStages        ReferenceKind = "stages"
+	ReferenceActivityTypes ReferenceKind = "activityTypes"
+	ReferenceCurrencies    ReferenceKind = "currencies"
+)
+
+var referenceKinds = []ReferenceKind{
+	ReferenceUsers,
+	ReferencePipelines,
+	ReferenceStages,
+	ReferenceActivityTypes,
+	ReferenceCurrencies,
+}
+
+// ReferenceCache is a read-through cache of the reference data most
+// requests need: users, pipelines, stages, activity types and currencies.
+// Each kind is loaded with one request when it is not cached, and kept
+// until the TTL passed or it is invalidated.
+type ReferenceCache struct {
+	client *Client
+	cache  Cache
+
+	// TTL is how long the data is cached, 10 minutes when zero.
+	TTL time.Duration
+
+	// Prefix is prepended to the cache keys, to tell apart the accounts
+	// sharing a cache.
+	Prefix string
+}
+
+// NewReferenceCache returns a ReferenceCache storing in the cache, in
+// memory when nil.
+func (c *Client) NewReferenceCache(cache Cache) *ReferenceCache {
+	if cache == nil {
+		cache = NewMemoryCache()
+	}
+
+	return &ReferenceCache{client: c, cache: cache}
+}
+
+// Users returns the users of the account.
+func (r *ReferenceCache) Users(ctx context.Context) ([]User, error) {
+	var users []User
+
+	err := r.get(ReferenceUsers, &users, func() (interface{}, error) {
+		result, _, err := r.client.Users.List(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return result.Data, nil
+	})
+
+	return users, err
+}
+
+// User returns the user with the ID, nil when there is none.
+func (r *ReferenceCache) User(ctx context.Context, id int) (*User, error) {
+	users, err := r.Users(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range users {
+		if users[i].ID == id {
+			return &users[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// Pipelines returns the pipelines of the account.
+func (r *ReferenceCache) Pipelines(ctx context.Context) ([]Pipeline, error) {
+	var pipelines []Pipeline
+
+	err := r.get(ReferencePipelines, &pipelines, func() (interface{}, error) {
+		result, _, err := r.client.PipelinesService.List(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return result.Data, nil
+	})
+
+	return pipelines, err
+}
+
+// Pipeline returns the pipeline with the ID, nil when there is none.
+func (r *ReferenceCache) Pipeline(ctx context.Context, id int) (*Pipeline, error) {
+	pipelines, err := r.Pipelines(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range pipelines {
+		if pipelines[i].ID == id {
+			return &pipelines[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// Stages returns the stages of all pipelines.
+func (r *ReferenceCache) Stages(ctx context.Context) ([]Stage, error) {
+	var stages []Stage
+
+	err := r.get(ReferenceStages, &stages, func() (interface{}, error) {
+		result, _, err := r.client.Stages.List(ctx, nil)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return result.Data, nil
+	})
+
+	return stages, err
+}
+
+// Stage returns the stage with the ID, nil when there is none.
+func (r *ReferenceCache) Stage(ctx context.Context, id int) (*Stage, error) {
+	stages, err := r.Stages(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range stages {
+		if stages[i].ID == id {
+			return &stages[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// ActivityTypes returns the activity types of the account.
+func (r *ReferenceCache) ActivityTypes(ctx context.Context) ([]ActivityType, error) {
+	var types []ActivityType
+
+	err := r.get(ReferenceActivityTypes, &types, func() (interface{}, error) {
+		result, _, err := r.client.ActivityTypes.List(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return result.Data, nil
+	})
+
+	return types, err
+}
+
+// Currencies returns the currencies supported by the account.
+func (r *ReferenceCache) Currencies(ctx context.Context) ([]Currency, error) {
+	var currencies []Currency
+
+	err := r.get(ReferenceCurrencies, &currencies, func() (interface{}, error) {
+		result, _, err := r.client.Currencies.List(ctx, nil)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return result.Data, nil
+	})
+
+	return currencies, err
+}
+
+// Invalidate removes the kinds of data from the cache, all kinds when
+// none are given, so they are loaded again on the next call.
+func (r *ReferenceCache) Invalidate(kinds ...ReferenceKind) error {
+	if len(kinds) == 0 {
+		kinds = referenceKinds
+	}
+
+	keys := make([]string, len(kinds))
+
+	for i, kind := range kinds {
+		keys[i] = r.key(kind)
+	}
+
+	return r.cache.Delete(keys...)
+}
+
+// InvalidateEvent invalidates the data changed by a webhook event. Events
+// on other objects are ignored.
+func (r *ReferenceCache) InvalidateEvent(event *Event) error {
+	switch event.Meta.Object {
+	case OBJECT_USER:
+		return r.Invalidate(ReferenceUsers)
+	case OBJECT_PIPELINE:
+		return r.Invalidate(ReferencePipelines, ReferenceStages)
+	case OBJECT_STAGE:
+		return r.Invalidate(ReferenceStages)
+	case OBJECT_ACTIVITY_TYPE:
+		return r.Invalidate(ReferenceActivityTypes)
+	}
+
+	return nil
+}
+
+// get decodes the cached data of the kind into v, loading and caching it
+// on a miss. Data that can not be decoded is loaded again.
+func (r *ReferenceCache) get(kind ReferenceKind, v interface{}, load func() (interface{}, error)) error {
+	key := r.key(kind)
+	data, ok, err := r.cache.Get(key)
+
+	if err != nil {
+		return err
+	}
+
+	if ok && json.Unmarshal(data, v) == nil {
+		return nil
+	}
+
+	value, err := load()
+
+	if err != nil {
+		return err
+	}
+
+	if data, err = json.Marshal(value); err != nil {
+		return err
+	}
+
+	ttl := r.TTL
+
+	if ttl <= 0 {
+		ttl = defaultReferenceCacheTTL
+	}
+
+	if err := r.cache.Set(key, data, ttl); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+func (r *ReferenceCache) key(kind ReferenceKind) string {
+	return r.Prefix + "pipedrive:" + string(kind)
+}
+
+// MemoryCache is a Cache in memory. Expired values are removed when they
+// are read.
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]memoryCacheEntry
+}
+
+type memoryCacheEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryCache returns an empty MemoryCache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: make(map[string]memoryCacheEntry)}
+}
+
+// Get implements Cache.
+func (c *MemoryCache) Get(key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+
+	if !ok {
+		return nil, false, nil
+	}
+
+	if time.Now().After(entry.expires) {
+		delete(c.entries, key)
+		return nil, false, nil
+	}
+
+	return entry.value, true, nil
+}
+
+// Set implements Cache.
+func (c *MemoryCache) Set(key string, value []byte, ttl time.Duration) error {
+	c.mu.Lock()
+	c.entries[key] = memoryCacheEntry{value: value, expires: time.Now().Add(ttl)}
+	c.mu.Unlock()
+
+	return nil
+}
+
+// Delete implements Cache.
+func (c *MemoryCache) Delete(keys ...string) error {
+	c.mu.Lock()
+
+	for _, key := range keys {
+		delete(c.entries, key)
+	}
+
+	c.mu.Unlock()
+
+	return nil
+}
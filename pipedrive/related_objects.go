@@ -0,0 +1,80 @@
+package pipedrive
+
+// RelatedUser represents a user in the related objects of a response.
+type RelatedUser struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	Email      string `json:"email"`
+	HasPic     bool   `json:"has_pic"`
+	PicHash    string `json:"pic_hash"`
+	ActiveFlag bool   `json:"active_flag"`
+}
+
+// RelatedPerson represents a person in the related objects of a response.
+type RelatedPerson struct {
+	ID         int            `json:"id"`
+	Name       string         `json:"name"`
+	Email      []ContactValue `json:"email"`
+	Phone      []ContactValue `json:"phone"`
+	ActiveFlag bool           `json:"active_flag"`
+}
+
+// RelatedOrganization represents an organization in the related objects
+// of a response.
+type RelatedOrganization struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	PeopleCount int    `json:"people_count"`
+	OwnerID     int    `json:"owner_id"`
+	Address     string `json:"address"`
+	CCEmail     string `json:"cc_email"`
+	ActiveFlag  bool   `json:"active_flag"`
+}
+
+// RelatedObjects are the users, persons and organizations referenced by
+// the records of a response, keyed by ID. They resolve the names of
+// owners and contacts without further requests.
+type RelatedObjects struct {
+	Users         map[int]RelatedUser         `json:"user"`
+	Persons       map[int]RelatedPerson       `json:"person"`
+	Organizations map[int]RelatedOrganization `json:"organization"`
+}
+
+// User returns the related user with the ID.
+func (r RelatedObjects) User(id int) (RelatedUser, bool) {
+	user, ok := r.Users[id]
+
+	return user, ok
+}
+
+// Person returns the related person with the ID.
+func (r RelatedObjects) Person(id int) (RelatedPerson, bool) {
+	person, ok := r.Persons[id]
+
+	return person, ok
+}
+
+// Organization returns the related organization with the ID.
+func (r RelatedObjects) Organization(id int) (RelatedOrganization, bool) {
+	organization, ok := r.Organizations[id]
+
+	return organization, ok
+}
+
+// UserName returns the name of the related user with the ID, empty when
+// it is not related.
+func (r RelatedObjects) UserName(id int) string {
+	return r.Users[id].Name
+}
+
+// PersonName returns the name of the related person with the ID, empty
+// when it is not related.
+func (r RelatedObjects) PersonName(id int) string {
+	return r.Persons[id].Name
+}
+
+// OrganizationName returns the name of the related organization with the
+// ID, empty when it is not related.
+func (r RelatedObjects) OrganizationName(id int) string {
+	return r.Organizations[id].Name
+}
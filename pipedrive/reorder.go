@@ -0,0 +1,101 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+)
+
+// UpdateOrder orders the pipelines as listed in ids, which must name every
+// pipeline once. Only the pipelines whose position changed are updated,
+// first to last.
+func (s *PipelinesService) UpdateOrder(ctx context.Context, ids []int) (*Response, error) {
+	pipelines, resp, err := s.List(ctx)
+
+	if err != nil {
+		return resp, err
+	}
+
+	current := make(map[int]int, len(pipelines.Data))
+
+	for _, pipeline := range pipelines.Data {
+		current[pipeline.ID] = pipeline.OrderNr
+	}
+
+	if err := validateOrder(current, ids); err != nil {
+		return nil, err
+	}
+
+	for i, id := range ids {
+		orderNr := i + 1
+
+		if current[id] == orderNr {
+			continue
+		}
+
+		if _, resp, err = s.Update(ctx, id, &PipelineUpdateOptions{OrderNr: &orderNr}); err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
+
+// UpdateOrder orders the stages of a pipeline as listed in ids, which must
+// name every stage of the pipeline once. Only the stages whose position
+// changed are updated, first to last.
+func (s *StagesService) UpdateOrder(ctx context.Context, pipelineID int, ids []int) (*Response, error) {
+	stages, resp, err := s.List(ctx, &StagesListOptions{PipelineID: uint(pipelineID)})
+
+	if err != nil {
+		return resp, err
+	}
+
+	current := make(map[int]int, len(stages.Data))
+
+	for _, stage := range stages.Data {
+		if stage.PipelineID == pipelineID {
+			current[stage.ID] = stage.OrderNr
+		}
+	}
+
+	if err := validateOrder(current, ids); err != nil {
+		return nil, err
+	}
+
+	for i, id := range ids {
+		orderNr := uint(i + 1)
+
+		if current[id] == int(orderNr) {
+			continue
+		}
+
+		if _, resp, err = s.Update(ctx, id, &StagesUpdateOptions{OrderNr: &orderNr}); err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
+
+// validateOrder checks that ids lists every key of current exactly once.
+func validateOrder(current map[int]int, ids []int) error {
+	seen := make(map[int]bool, len(ids))
+
+	for _, id := range ids {
+		if _, ok := current[id]; !ok {
+			return &ValidationError{Field: "ids", Message: fmt.Sprintf("%v is unknown", id)}
+		}
+
+		if seen[id] {
+			return &ValidationError{Field: "ids", Message: fmt.Sprintf("%v is listed twice", id)}
+		}
+
+		seen[id] = true
+	}
+
+	if len(ids) != len(current) {
+		return &ValidationError{Field: "ids", Message: fmt.Sprintf("lists %v of %v", len(ids), len(current))}
+	}
+
+	return nil
+}
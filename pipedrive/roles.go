@@ -0,0 +1,314 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// RolesService handles roles related
+// methods of the Pipedrive API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Roles
+type RolesService service
+
+// Role represents a Pipedrive role.
+type Role struct {
+	ID              int    `json:"id"`
+	ParentRoleID    int    `json:"parent_role_id"`
+	Name            string `json:"name"`
+	ActiveFlag      bool   `json:"active_flag"`
+	AssignmentCount string `json:"assignment_count"`
+	SubRoleCount    string `json:"sub_role_count"`
+	Level           int    `json:"level"`
+}
+
+func (r Role) String() string {
+	return Stringify(r)
+}
+
+// Roles represents multiple roles response.
+type Roles struct {
+	Success bool   `json:"success"`
+	Data    []Role `json:"data"`
+}
+
+// RolesResponse represents multiple roles response.
+type RolesResponse struct {
+	Success        bool           `json:"success"`
+	Data           []Role         `json:"data"`
+	AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// RoleResponse represents single role response.
+type RoleResponse struct {
+	Success bool `json:"success"`
+	Data    Role `json:"data"`
+}
+
+// RoleSettingsResponse represents role settings response.
+type RoleSettingsResponse struct {
+	Success bool         `json:"success"`
+	Data    RoleSettings `json:"data"`
+}
+
+// RolesListOptions specifices the optional parameters to the
+// RolesService.List, RolesService.ListSubRoles and
+// RolesService.ListAssignments methods.
+type RolesListOptions struct {
+	Start uint `url:"start,omitempty"`
+	Limit uint `url:"limit,omitempty"`
+}
+
+// List returns all roles within the company.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Roles/get_roles
+func (s *RolesService) List(ctx context.Context, opt *RolesListOptions) (*RolesResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "/roles", opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *RolesResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// GetByID returns a specific role.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Roles/get_roles_id
+func (s *RolesService) GetByID(ctx context.Context, id int) (*RoleResponse, *Response, error) {
+	uri := fmt.Sprintf("/roles/%v", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *RoleResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// RoleCreateOptions specifices the parameters to the
+// RolesService.Create method.
+type RoleCreateOptions struct {
+	Name         string `json:"name"`
+	ParentRoleID uint   `json:"parent_role_id,omitempty"`
+}
+
+// Create a new role.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Roles/post_roles
+func (s *RolesService) Create(ctx context.Context, opt *RoleCreateOptions) (*RoleResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "/roles", nil, opt)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *RoleResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// RoleUpdateOptions specifices the optional parameters to the
+// RolesService.Update method.
+type RoleUpdateOptions struct {
+	Name         string `json:"name,omitempty"`
+	ParentRoleID uint   `json:"parent_role_id,omitempty"`
+}
+
+// Update the parent role and/or the name of a specific role.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Roles/put_roles_id
+func (s *RolesService) Update(ctx context.Context, id int, opt *RoleUpdateOptions) (*RoleResponse, *Response, error) {
+	uri := fmt.Sprintf("/roles/%v", id)
+	req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *RoleResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// Delete marks a role as deleted.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Roles/delete_roles_id
+func (s *RolesService) Delete(ctx context.Context, id int) (*Response, error) {
+	uri := fmt.Sprintf("/roles/%v", id)
+	req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListAssignments lists assignments for a role.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Roles/get_roles_id_assignments
+func (s *RolesService) ListAssignments(ctx context.Context, id int, opt *RolesListOptions) (*RoleAssignmentsResponse, *Response, error) {
+	uri := fmt.Sprintf("/roles/%v/assignments", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *RoleAssignmentsResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// AddAssignment assigns a user to a role.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Roles/post_roles_id_assignments
+func (s *RolesService) AddAssignment(ctx context.Context, id int, userID int) (*RoleAssignmentResponse, *Response, error) {
+	uri := fmt.Sprintf("/roles/%v/assignments", id)
+	req, err := s.client.NewRequest(http.MethodPost, uri, nil, struct {
+		UserID int `json:"user_id"`
+	}{
+		userID,
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *RoleAssignmentResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// DeleteAssignment removes the assigned user from a role and adds to the default role.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Roles/delete_roles_id_assignments
+func (s *RolesService) DeleteAssignment(ctx context.Context, id int, userID int) (*Response, error) {
+	uri := fmt.Sprintf("/roles/%v/assignments", id)
+	req, err := s.client.NewRequest(http.MethodDelete, uri, nil, struct {
+		UserID int `json:"user_id"`
+	}{
+		userID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListSettings lists settings of a role.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Roles/get_roles_id_settings
+func (s *RolesService) ListSettings(ctx context.Context, id int) (*RoleSettingsResponse, *Response, error) {
+	uri := fmt.Sprintf("/roles/%v/settings", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *RoleSettingsResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// RoleSettingOptions specifices the parameters to the
+// RolesService.AddSetting method.
+type RoleSettingOptions struct {
+	SettingKey string `json:"setting_key"`
+	Value      int    `json:"value"`
+}
+
+// AddSetting adds or updates the setting for a role.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Roles/post_roles_id_settings
+func (s *RolesService) AddSetting(ctx context.Context, id int, opt *RoleSettingOptions) (*RoleSettingsResponse, *Response, error) {
+	uri := fmt.Sprintf("/roles/%v/settings", id)
+	req, err := s.client.NewRequest(http.MethodPost, uri, nil, opt)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *RoleSettingsResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// ListSubRoles lists the sub-roles of a role.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Roles/get_roles_id_roles
+func (s *RolesService) ListSubRoles(ctx context.Context, id int, opt *RolesListOptions) (*RolesResponse, *Response, error) {
+	uri := fmt.Sprintf("/roles/%v/roles", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *RolesResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
@@ -0,0 +1,236 @@
+package pipedrive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// SearchService handles item search related
+// methods of the Pipedrive API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ItemSearch
+type SearchService service
+
+// SearchFieldType represents the entity whose field is searched.
+type SearchFieldType string
+
+// SearchFieldType constants.
+const (
+	SearchFieldTypeDeal         SearchFieldType = "dealField"
+	SearchFieldTypeLead         SearchFieldType = "leadField"
+	SearchFieldTypePerson       SearchFieldType = "personField"
+	SearchFieldTypeOrganization SearchFieldType = "organizationField"
+	SearchFieldTypeProduct      SearchFieldType = "productField"
+	SearchFieldTypeProject      SearchFieldType = "projectField"
+)
+
+// SearchMatch represents how the search term is matched against values.
+type SearchMatch string
+
+// SearchMatch constants.
+const (
+	SearchMatchExact     SearchMatch = "exact"
+	SearchMatchBeginning SearchMatch = "beginning"
+	SearchMatchMiddle    SearchMatch = "middle"
+)
+
+// FieldSearchResponse represents field search response.
+//
+// Each result holds the item ID under "id" and the matched value under
+// the searched field key.
+type FieldSearchResponse struct {
+	Success        bool                     `json:"success"`
+	Data           []map[string]interface{} `json:"data"`
+	AdditionalData AdditionalData           `json:"additional_data"`
+}
+
+// SearchByFieldOptions specifices the parameters to the
+// SearchService.SearchByField method.
+//
+// Without ReturnItemIds only distinct values of the field are returned.
+type SearchByFieldOptions struct {
+	Term          string          `url:"term"`
+	FieldType     SearchFieldType `url:"field_type"`
+	FieldKey      string          `url:"field_key"`
+	Match         SearchMatch     `url:"match,omitempty"`
+	ReturnItemIds bool            `url:"return_item_ids,omitempty"`
+	Start         uint            `url:"start,omitempty"`
+	Limit         uint            `url:"limit,omitempty"`
+}
+
+// SearchByField searches the values of a specific field, including
+// custom fields.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ItemSearch/get_itemSearch_field
+func (s *SearchService) SearchByField(ctx context.Context, opt *SearchByFieldOptions) (*FieldSearchResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "/itemSearch/field", opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *FieldSearchResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// SearchItemType represents the type of an item found by a search.
+type SearchItemType string
+
+// SearchItemType constants.
+const (
+	SearchItemTypeDeal           SearchItemType = "deal"
+	SearchItemTypePerson         SearchItemType = "person"
+	SearchItemTypeOrganization   SearchItemType = "organization"
+	SearchItemTypeProduct        SearchItemType = "product"
+	SearchItemTypeLead           SearchItemType = "lead"
+	SearchItemTypeFile           SearchItemType = "file"
+	SearchItemTypeMailAttachment SearchItemType = "mail_attachment"
+	SearchItemTypeProject        SearchItemType = "project"
+)
+
+// SearchItem represents an item found by a search together with its
+// relevance. Item holds the fields of the item, including its "id" and
+// "type" and any fields requested with IncludeFields.
+type SearchItem struct {
+	ResultScore float64                `json:"result_score"`
+	Item        map[string]interface{} `json:"item"`
+}
+
+// ItemSearchResponse represents item search response.
+type ItemSearchResponse struct {
+	Success bool `json:"success"`
+	Data    struct {
+		Items        []SearchItem `json:"items"`
+		RelatedItems []SearchItem `json:"related_items,omitempty"`
+	} `json:"data"`
+	AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// ItemSearchOptions specifices the parameters to the
+// SearchService.Items method.
+//
+// Fields restricts the fields searched in, for example "name" or
+// "custom_fields". IncludeFields adds optional fields to the results,
+// for example "deal.cc_email" or "person.picture".
+type ItemSearchOptions struct {
+	Term                  string           `url:"term"`
+	ItemTypes             []SearchItemType `url:"item_types,omitempty,comma"`
+	Fields                Fields           `url:"fields,omitempty,comma"`
+	SearchForRelatedItems bool             `url:"search_for_related_items,omitempty"`
+	ExactMatch            bool             `url:"exact_match,omitempty"`
+	IncludeFields         Fields           `url:"include_fields,omitempty,comma"`
+	Start                 uint             `url:"start,omitempty"`
+	Limit                 uint             `url:"limit,omitempty"`
+}
+
+// Items searches deals, persons, organizations, products, leads, files
+// and projects at once.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/ItemSearch/get_itemSearch
+func (s *SearchService) Items(ctx context.Context, opt *ItemSearchOptions) (*ItemSearchResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "/itemSearch", opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *ItemSearchResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+const (
+	// searchPageLimit is the largest page search endpoints return.
+	searchPageLimit = 500
+
+	// defaultSearchMaxResults guards SearchAll methods called without a
+	// maximum.
+	defaultSearchMaxResults = 5000
+)
+
+// ErrSearchLimit is returned by SearchAll methods together with the items
+// found so far when a search has more results than the given maximum.
+var ErrSearchLimit = errors.New("pipedrive: search has more results than the maximum")
+
+// searchAll pages through the results of search starting at the start of
+// pager, skipping items seen on earlier pages. It stops with ErrSearchLimit
+// once more than maxResults items are found, maxResults below one uses the
+// default.
+func searchAll(ctx context.Context, pager *Pager, maxResults int, search func(start uint) (*ItemSearchResponse, *Response, error)) ([]SearchItem, *Response, error) {
+	if maxResults < 1 {
+		maxResults = defaultSearchMaxResults
+	}
+
+	var items []SearchItem
+
+	seen := make(map[string]bool)
+
+	for {
+		result, resp, err := search(pager.Start)
+
+		if err != nil {
+			return items, resp, err
+		}
+
+		for _, item := range result.Data.Items {
+			key := fmt.Sprint(item.Item["type"], ":", item.Item["id"])
+
+			if seen[key] {
+				continue
+			}
+
+			if len(items) == maxResults {
+				return items, resp, ErrSearchLimit
+			}
+
+			seen[key] = true
+			items = append(items, item)
+		}
+
+		if more, err := pager.Next(result.AdditionalData.Pagination, len(result.Data.Items)); !more {
+			return items, resp, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return items, resp, ctx.Err()
+		default:
+		}
+	}
+}
+
+// SearchAll returns every item matching the search, fetching all pages.
+// Items found on several pages are returned once. When more than maxResults
+// items match, the first maxResults are returned with ErrSearchLimit.
+func (s *SearchService) SearchAll(ctx context.Context, opt *ItemSearchOptions, maxResults int) ([]SearchItem, *Response, error) {
+	var page ItemSearchOptions
+
+	if opt != nil {
+		page = *opt
+	}
+
+	if page.Limit == 0 {
+		page.Limit = searchPageLimit
+	}
+
+	return searchAll(ctx, s.client.NewPager("/itemSearch", page.Start), maxResults, func(start uint) (*ItemSearchResponse, *Response, error) {
+		page.Start = start
+
+		return s.Items(ctx, &page)
+	})
+}
@@ -53,14 +53,14 @@ type StageDealsResponse struct {
 // StagesListOptions specifices the optional parameters to the
 // StagesService.List method.
 type StagesListOptions struct {
-	PipelineID uint `url:"pipeline_id"`
+	PipelineID uint `url:"pipeline_id,omitempty"`
 }
 
 // List returns data about all stages.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Stages
 func (s *StagesService) List(ctx context.Context, opt *StagesListOptions) (*StagesResponse, *Response, error) {
-	req, err := s.client.NewRequest(http.MethodGet, "/stages", nil, opt)
+	req, err := s.client.NewRequest(http.MethodGet, "/stages", opt, nil)
 
 	if err != nil {
 		return nil, nil, err
@@ -110,10 +110,40 @@ type StagesGetDealsInStageOptions struct {
 
 // GetDealsInStage lists deals in a specific stage.
 //
+// Deprecated: use ListDeals instead.
+//
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Stages/get_stages_id_deals
 func (s *StagesService) GetDealsInStage(ctx context.Context, id int, opt *StagesGetDealsInStageOptions) (*StageDealsResponse, *Response, error) {
+	if opt == nil {
+		return s.ListDeals(ctx, id, nil)
+	}
+
+	return s.ListDeals(ctx, id, &StagesListDealsOptions{
+		FilterID: opt.FilterID,
+		UserID:   opt.UserID,
+		Everyone: opt.Everyone,
+		Start:    opt.Start,
+		Limit:    opt.Limit,
+	})
+}
+
+// StagesListDealsOptions specifices the optional parameters to the
+// StagesService.ListDeals method.
+type StagesListDealsOptions struct {
+	FilterID uint  `url:"filter_id,omitempty"`
+	UserID   uint  `url:"user_id,omitempty"`
+	Everyone uint8 `url:"everyone,omitempty"`
+	Start    uint  `url:"start,omitempty"`
+	Limit    uint  `url:"limit,omitempty"`
+}
+
+// ListDeals lists deals in a specific stage. If no filter or user is given,
+// deals of the authorized user are returned unless Everyone is set.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Stages/get_stages_id_deals
+func (s *StagesService) ListDeals(ctx context.Context, id int, opt *StagesListDealsOptions) (*StageDealsResponse, *Response, error) {
 	uri := fmt.Sprintf("/stages/%v/deals", id)
-	req, err := s.client.NewRequest(http.MethodGet, uri, nil, opt)
+	req, err := s.client.NewRequest(http.MethodGet, uri, opt, nil)
 
 	if err != nil {
 		return nil, nil, err
@@ -163,13 +193,15 @@ func (s *StagesService) Create(ctx context.Context, opt *StagesCreateOptions) (*
 
 // StagesUpdateOptions specifices the optional parameters to the
 // StagesService.Update method.
+//
+// Only the fields that are set are sent.
 type StagesUpdateOptions struct {
-	Name            string `url:"name"`
-	PipelineID      uint   `url:"pipeline_id"`
-	OrderNr         uint   `url:"order_nr"`
-	DealProbability uint   `url:"deal_probability"`
-	RottenFlag      uint8  `url:"rotten_flag"`
-	RottenDays      uint   `url:"rotten_days"`
+	Name            *string `json:"name,omitempty"`
+	PipelineID      *uint   `json:"pipeline_id,omitempty"`
+	OrderNr         *uint   `json:"order_nr,omitempty"`
+	DealProbability *uint   `json:"deal_probability,omitempty"`
+	RottenFlag      *bool   `json:"rotten_flag,omitempty"`
+	RottenDays      *uint   `json:"rotten_days,omitempty"`
 }
 
 // Update the properties of a stage.
@@ -199,7 +231,7 @@ func (s *StagesService) Update(ctx context.Context, id int, opt *StagesUpdateOpt
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Stages/put_stages_id
 func (s *StagesService) DeleteMultiple(ctx context.Context, ids []int) (*Response, error) {
 	req, err := s.client.NewRequest(http.MethodDelete, "/stages", &DeleteMultipleOptions{
-		Ids: arrayToString(ids, ","),
+		Ids: ids,
 	}, nil)
 
 	if err != nil {
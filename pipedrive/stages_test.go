@@ -0,0 +1,32 @@
+package pipedrive
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestStagesService_GetDealsInStage(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/stages/1/deals", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+
+		if got, want := r.URL.Query().Encode(), "everyone=1&limit=10"; got != want {
+			t.Errorf("Request query: %v, want %v", got, want)
+		}
+
+		if r.ContentLength > 0 {
+			t.Errorf("Request has a body of %v bytes, want none", r.ContentLength)
+		}
+
+		writeJSON(w, http.StatusOK, `{"success": true, "data": [{"id": 2}]}`)
+	})
+
+	deals, _, err := client.Stages.GetDealsInStage(context.Background(), 1, &StagesGetDealsInStageOptions{Everyone: 1, Limit: 10})
+
+	if err != nil || len(deals.Data) != 1 {
+		t.Errorf("GetDealsInStage returned %+v, %v", deals, err)
+	}
+}
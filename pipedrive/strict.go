@@ -0,0 +1,172 @@
+package pipedrive
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// UnknownFieldsError is returned by Client.Do in strict decoding mode when
+// a response holds fields the models do not declare. The response is still
+// decoded completely.
+type UnknownFieldsError struct {
+	// Fields holds the paths of the unknown fields, for example
+	// "data[].next_activity_note".
+	Fields []string
+}
+
+func (e *UnknownFieldsError) Error() string {
+	return fmt.Sprintf("response has fields unknown to the models: %v", strings.Join(e.Fields, ", "))
+}
+
+// fieldPrefixer is implemented by models that decode groups of fields
+// themselves, such as the components of an Address.
+type fieldPrefixer interface {
+	knownFieldPrefixes() []string
+}
+
+var fieldPrefixerType = reflect.TypeOf((*fieldPrefixer)(nil)).Elem()
+
+// unknownFields returns the sorted paths of the fields in data that have
+// no counterpart in t. Custom field keys are never reported.
+func unknownFields(data []byte, t reflect.Type) []string {
+	found := make(map[string]bool)
+
+	collectUnknownFields(data, t, "", found)
+
+	fields := make([]string, 0, len(found))
+
+	for field := range found {
+		fields = append(fields, field)
+	}
+
+	sort.Strings(fields)
+
+	return fields
+}
+
+func collectUnknownFields(data []byte, t reflect.Type, path string, found map[string]bool) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	data = bytes.TrimSpace(data)
+
+	if len(data) == 0 || t == reflect.TypeOf(json.RawMessage{}) {
+		return
+	}
+
+	switch {
+	case data[0] == '[' && (t.Kind() == reflect.Slice || t.Kind() == reflect.Array):
+		var items []json.RawMessage
+
+		if json.Unmarshal(data, &items) != nil {
+			return
+		}
+
+		for _, item := range items {
+			collectUnknownFields(item, t.Elem(), path+"[]", found)
+		}
+	case data[0] == '{' && t.Kind() == reflect.Map:
+		var object map[string]json.RawMessage
+
+		if json.Unmarshal(data, &object) != nil {
+			return
+		}
+
+		for _, value := range object {
+			collectUnknownFields(value, t.Elem(), path+".*", found)
+		}
+	case data[0] == '{' && t.Kind() == reflect.Struct:
+		var object map[string]json.RawMessage
+
+		if json.Unmarshal(data, &object) != nil {
+			return
+		}
+
+		fields := jsonFields(t)
+
+		var prefixes []string
+
+		if reflect.PtrTo(t).Implements(fieldPrefixerType) {
+			prefixes = reflect.New(t).Interface().(fieldPrefixer).knownFieldPrefixes()
+		}
+
+		for key, value := range object {
+			name := key
+
+			if path != "" {
+				name = path + "." + key
+			}
+
+			if field, ok := fields[strings.ToLower(key)]; ok {
+				collectUnknownFields(value, field.Type, name, found)
+				continue
+			}
+
+			if !isCustomFieldKey(key) && !hasAnyPrefix(key, prefixes) {
+				found[name] = true
+			}
+		}
+	}
+}
+
+// jsonFields returns the fields of struct type t by their lowercase JSON
+// name, including the fields of embedded structs.
+func jsonFields(t reflect.Type) map[string]reflect.StructField {
+	fields := make(map[string]reflect.StructField)
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+
+		if tag == "-" {
+			continue
+		}
+
+		name := strings.Split(tag, ",")[0]
+
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+
+			if embedded.Kind() == reflect.Struct {
+				for key, value := range jsonFields(embedded) {
+					if _, ok := fields[key]; !ok {
+						fields[key] = value
+					}
+				}
+
+				continue
+			}
+		}
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		fields[strings.ToLower(name)] = field
+	}
+
+	return fields
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
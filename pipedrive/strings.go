@@ -3,34 +3,198 @@ package pipedrive
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"reflect"
+	"sort"
+	"strings"
+	"time"
 )
 
+// redacted replaces the value of secret fields in Stringify output.
+const redacted = `"[REDACTED]"`
+
+var rawMessageType = reflect.TypeOf(json.RawMessage{})
+
+// secretNames holds the lowercase name fragments of fields whose values
+// are never written by Stringify.
+var secretNames = []string{"password", "token", "secret", "apikey", "api_key"}
+
+// Stringify returns a readable representation of message, suitable for
+// logging. Struct fields are written in declaration order and map entries
+// sorted by key, so the output is deterministic. Values of password, token,
+// secret and API key fields are redacted.
 func Stringify(message interface{}) string {
 	var buf bytes.Buffer
 
-	value := reflect.ValueOf(message)
+	stringifyValue(&buf, reflect.ValueOf(message))
+
+	return buf.String()
+}
+
+func stringifyValue(w *bytes.Buffer, val reflect.Value) {
+	if !val.IsValid() {
+		w.WriteString("<nil>")
+		return
+	}
 
-	if value.Kind() == reflect.Ptr && value.IsNil() {
-		buf.Write([]byte("<nil>"))
+	if (val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface) && val.IsNil() {
+		w.WriteString("<nil>")
+		return
 	}
 
-	v := reflect.Indirect(value)
+	v := val
+
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		v = v.Elem()
+
+		if (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil() {
+			w.WriteString("<nil>")
+			return
+		}
+	}
+
+	if v.CanInterface() {
+		switch t := v.Interface().(type) {
+		case Timestamp:
+			w.WriteString(t.String())
+			return
+		case time.Time:
+			w.WriteString(t.String())
+			return
+		case json.RawMessage:
+			w.Write(t)
+			return
+		}
+	}
 
 	switch v.Kind() {
+	case reflect.String:
+		fmt.Fprintf(w, "%q", v.String())
+	case reflect.Slice, reflect.Array:
+		w.WriteByte('[')
+
+		for i := 0; i < v.Len(); i++ {
+			if i > 0 {
+				w.WriteByte(' ')
+			}
+
+			stringifyValue(w, v.Index(i))
+		}
+
+		w.WriteByte(']')
+	case reflect.Map:
+		stringifyMap(w, v)
 	case reflect.Struct:
-		data, err := json.Marshal(message)
+		stringifyStruct(w, v)
+	default:
+		if v.CanInterface() {
+			fmt.Fprint(w, v.Interface())
+		}
+	}
+}
+
+func stringifyMap(w *bytes.Buffer, v reflect.Value) {
+	keys := v.MapKeys()
+	names := make([]string, len(keys))
 
-		if err != nil {
-			break
+	for i, key := range keys {
+		names[i] = fmt.Sprint(key.Interface())
+	}
+
+	sort.Sort(byName{keys, names})
+
+	w.WriteString("map[")
+
+	for i, key := range keys {
+		if i > 0 {
+			w.WriteByte(' ')
 		}
 
-		if v.Type() == reflect.TypeOf(Timestamp{}) {
-			break
+		w.WriteString(names[i])
+		w.WriteByte(':')
+
+		if isSecretName(names[i]) {
+			w.WriteString(redacted)
+			continue
 		}
 
-		buf.Write([]byte(string(data)))
+		stringifyValue(w, v.MapIndex(key))
 	}
 
-	return buf.String()
+	w.WriteByte(']')
+}
+
+func stringifyStruct(w *bytes.Buffer, v reflect.Value) {
+	if v.Type().Name() != "" {
+		w.WriteString(v.Type().String())
+	}
+
+	w.WriteByte('{')
+
+	sep := false
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		fv := v.Field(i)
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		// Raw JSON kept by models repeats the other fields.
+		if field.Type == rawMessageType && field.Tag.Get("json") == "-" {
+			continue
+		}
+
+		switch fv.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map:
+			if fv.IsNil() {
+				continue
+			}
+		}
+
+		if sep {
+			w.WriteString(", ")
+		} else {
+			sep = true
+		}
+
+		w.WriteString(field.Name)
+		w.WriteByte(':')
+
+		if isSecretName(field.Name) || isSecretName(field.Tag.Get("json")) {
+			w.WriteString(redacted)
+			continue
+		}
+
+		stringifyValue(w, fv)
+	}
+
+	w.WriteByte('}')
+}
+
+func isSecretName(name string) bool {
+	name = strings.ToLower(name)
+
+	for _, secret := range secretNames {
+		if strings.Contains(name, secret) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// byName sorts map keys by their string representation.
+type byName struct {
+	keys  []reflect.Value
+	names []string
+}
+
+func (b byName) Len() int           { return len(b.keys) }
+func (b byName) Less(i, j int) bool { return b.names[i] < b.names[j] }
+
+func (b byName) Swap(i, j int) {
+	b.keys[i], b.keys[j] = b.keys[j], b.keys[i]
+	b.names[i], b.names[j] = b.names[j], b.names[i]
 }
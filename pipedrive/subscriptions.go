@@ -0,0 +1,376 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SubscriptionsService handles subscriptions related
+// methods of the Pipedrive API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Subscriptions
+type SubscriptionsService service
+
+// CadenceType represents how often a recurring subscription is billed.
+type CadenceType string
+
+// CadenceType constants.
+const (
+	CadenceTypeWeekly    CadenceType = "weekly"
+	CadenceTypeMonthly   CadenceType = "monthly"
+	CadenceTypeQuarterly CadenceType = "quarterly"
+	CadenceTypeYearly    CadenceType = "yearly"
+)
+
+// Subscription represents a Pipedrive recurring or installment subscription.
+type Subscription struct {
+	ID            int         `json:"id"`
+	UserID        int         `json:"user_id"`
+	DealID        int         `json:"deal_id"`
+	Description   string      `json:"description"`
+	IsActive      bool        `json:"is_active"`
+	CyclesCount   int         `json:"cycles_count"`
+	CycleAmount   float64     `json:"cycle_amount"`
+	Infinite      bool        `json:"infinite"`
+	Currency      string      `json:"currency"`
+	CadenceType   CadenceType `json:"cadence_type"`
+	StartDate     string      `json:"start_date"`
+	EndDate       string      `json:"end_date"`
+	LifetimeValue float64     `json:"lifetime_value"`
+	FinalStatus   string      `json:"final_status"`
+	AddTime       string      `json:"add_time"`
+	UpdateTime    string      `json:"update_time"`
+}
+
+func (s Subscription) String() string {
+	return Stringify(s)
+}
+
+// SubscriptionPayment represents a single payment of a subscription.
+type SubscriptionPayment struct {
+	ID                  int     `json:"id"`
+	SubscriptionID      int     `json:"subscription_id"`
+	DealID              int     `json:"deal_id"`
+	IsActive            bool    `json:"is_active"`
+	Amount              float64 `json:"amount"`
+	Currency            string  `json:"currency"`
+	ChangeAmount        float64 `json:"change_amount"`
+	DueAt               string  `json:"due_at"`
+	RevenueMovementType string  `json:"revenue_movement_type"`
+	PaymentType         string  `json:"payment_type"`
+	Description         string  `json:"description"`
+	AddTime             string  `json:"add_time"`
+	UpdateTime          string  `json:"update_time"`
+}
+
+func (p SubscriptionPayment) String() string {
+	return Stringify(p)
+}
+
+// SubscriptionResponse represents single subscription response.
+type SubscriptionResponse struct {
+	Success bool         `json:"success"`
+	Data    Subscription `json:"data"`
+}
+
+// SubscriptionPaymentsResponse represents multiple subscription payments response.
+type SubscriptionPaymentsResponse struct {
+	Success bool                  `json:"success"`
+	Data    []SubscriptionPayment `json:"data"`
+}
+
+// SubscriptionPaymentOptions represents a payment given when creating or
+// updating a subscription.
+type SubscriptionPaymentOptions struct {
+	Amount      float64 `json:"amount"`
+	Description string  `json:"description"`
+	DueAt       string  `json:"due_at,omitempty"`
+}
+
+// GetByID returns a specific subscription.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Subscriptions/get_subscriptions_id
+func (s *SubscriptionsService) GetByID(ctx context.Context, id int) (*SubscriptionResponse, *Response, error) {
+	uri := fmt.Sprintf("/subscriptions/%v", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *SubscriptionResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// FindByDeal returns the subscription of a specific deal.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Subscriptions/get_subscriptions_find_dealId
+func (s *SubscriptionsService) FindByDeal(ctx context.Context, dealID int) (*SubscriptionResponse, *Response, error) {
+	uri := fmt.Sprintf("/subscriptions/find/%v", dealID)
+	req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *SubscriptionResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// ListPayments returns all payments of a specific subscription.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Subscriptions/get_subscriptions_id_payments
+func (s *SubscriptionsService) ListPayments(ctx context.Context, id int) (*SubscriptionPaymentsResponse, *Response, error) {
+	uri := fmt.Sprintf("/subscriptions/%v/payments", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *SubscriptionPaymentsResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// SubscriptionCreateRecurringOptions specifices the parameters to the
+// SubscriptionsService.CreateRecurring method.
+type SubscriptionCreateRecurringOptions struct {
+	DealID          int                          `json:"deal_id"`
+	Currency        string                       `json:"currency"`
+	Description     string                       `json:"description,omitempty"`
+	CadenceType     CadenceType                  `json:"cadence_type"`
+	CyclesCount     int                          `json:"cycles_count,omitempty"`
+	CycleAmount     float64                      `json:"cycle_amount"`
+	StartDate       string                       `json:"start_date"`
+	Infinite        bool                         `json:"infinite,omitempty"`
+	Payments        []SubscriptionPaymentOptions `json:"payments,omitempty"`
+	UpdateDealValue bool                         `json:"update_deal_value,omitempty"`
+}
+
+// Validate checks the required fields, the cadence type and that either
+// a number of cycles or an infinite subscription is given.
+func (o SubscriptionCreateRecurringOptions) Validate() error {
+	if o.DealID == 0 {
+		return requiredError("deal_id")
+	}
+
+	if o.Currency == "" {
+		return requiredError("currency")
+	}
+
+	switch o.CadenceType {
+	case "":
+		return requiredError("cadence_type")
+	case CadenceTypeWeekly, CadenceTypeMonthly, CadenceTypeQuarterly, CadenceTypeYearly:
+	default:
+		return enumError("cadence_type", o.CadenceType)
+	}
+
+	if o.Infinite && o.CyclesCount != 0 {
+		return exclusiveError("cycles_count", "infinite")
+	}
+
+	if !o.Infinite && o.CyclesCount == 0 {
+		return requiredError("cycles_count or infinite")
+	}
+
+	if o.StartDate == "" {
+		return requiredError("start_date")
+	}
+
+	return nil
+}
+
+// CreateRecurring adds a new recurring subscription to a deal.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Subscriptions/post_subscriptions_recurring
+func (s *SubscriptionsService) CreateRecurring(ctx context.Context, opt *SubscriptionCreateRecurringOptions) (*SubscriptionResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "/subscriptions/recurring", nil, opt)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *SubscriptionResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// SubscriptionCreateInstallmentOptions specifices the parameters to the
+// SubscriptionsService.CreateInstallment method.
+type SubscriptionCreateInstallmentOptions struct {
+	DealID          int                          `json:"deal_id"`
+	Currency        string                       `json:"currency"`
+	Payments        []SubscriptionPaymentOptions `json:"payments"`
+	UpdateDealValue bool                         `json:"update_deal_value,omitempty"`
+}
+
+// Validate checks the required fields.
+func (o SubscriptionCreateInstallmentOptions) Validate() error {
+	if o.DealID == 0 {
+		return requiredError("deal_id")
+	}
+
+	if o.Currency == "" {
+		return requiredError("currency")
+	}
+
+	if len(o.Payments) == 0 {
+		return requiredError("payments")
+	}
+
+	return nil
+}
+
+// CreateInstallment adds a new installment subscription to a deal.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Subscriptions/post_subscriptions_installment
+func (s *SubscriptionsService) CreateInstallment(ctx context.Context, opt *SubscriptionCreateInstallmentOptions) (*SubscriptionResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "/subscriptions/installment", nil, opt)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *SubscriptionResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// SubscriptionUpdateRecurringOptions specifices the parameters to the
+// SubscriptionsService.UpdateRecurring method.
+type SubscriptionUpdateRecurringOptions struct {
+	EffectiveDate   string                       `json:"effective_date"`
+	Description     string                       `json:"description,omitempty"`
+	CycleAmount     float64                      `json:"cycle_amount,omitempty"`
+	Payments        []SubscriptionPaymentOptions `json:"payments,omitempty"`
+	UpdateDealValue bool                         `json:"update_deal_value,omitempty"`
+}
+
+// UpdateRecurring updates a recurring subscription.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Subscriptions/put_subscriptions_recurring_id
+func (s *SubscriptionsService) UpdateRecurring(ctx context.Context, id int, opt *SubscriptionUpdateRecurringOptions) (*SubscriptionResponse, *Response, error) {
+	uri := fmt.Sprintf("/subscriptions/recurring/%v", id)
+	req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *SubscriptionResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// SubscriptionUpdateInstallmentOptions specifices the parameters to the
+// SubscriptionsService.UpdateInstallment method.
+type SubscriptionUpdateInstallmentOptions struct {
+	Payments        []SubscriptionPaymentOptions `json:"payments"`
+	UpdateDealValue bool                         `json:"update_deal_value,omitempty"`
+}
+
+// UpdateInstallment replaces the payments of an installment subscription.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Subscriptions/put_subscriptions_installment_id
+func (s *SubscriptionsService) UpdateInstallment(ctx context.Context, id int, opt *SubscriptionUpdateInstallmentOptions) (*SubscriptionResponse, *Response, error) {
+	uri := fmt.Sprintf("/subscriptions/installment/%v", id)
+	req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *SubscriptionResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// SubscriptionCancelOptions specifices the optional parameters to the
+// SubscriptionsService.CancelRecurring method.
+type SubscriptionCancelOptions struct {
+	EndDate string `json:"end_date,omitempty"`
+}
+
+// CancelRecurring cancels a recurring subscription. Without an end date
+// the subscription is cancelled immediately.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Subscriptions/put_subscriptions_recurring_id_cancel
+func (s *SubscriptionsService) CancelRecurring(ctx context.Context, id int, opt *SubscriptionCancelOptions) (*SubscriptionResponse, *Response, error) {
+	uri := fmt.Sprintf("/subscriptions/recurring/%v/cancel", id)
+	req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *SubscriptionResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// Delete marks a subscription as deleted.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Subscriptions/delete_subscriptions_id
+func (s *SubscriptionsService) Delete(ctx context.Context, id int) (*Response, error) {
+	uri := fmt.Sprintf("/subscriptions/%v", id)
+	req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
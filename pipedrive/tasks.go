@@ -0,0 +1,207 @@
+package pipedrive
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// TasksService handles project tasks related
+// methods of the Pipedrive API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Tasks
+type TasksService service
+
+// Task represents a Pipedrive project task.
+type Task struct {
+	ID               int    `json:"id"`
+	Title            string `json:"title"`
+	ProjectID        int    `json:"project_id"`
+	ParentTaskID     int    `json:"parent_task_id"`
+	CreatorID        int    `json:"creator_id"`
+	AssigneeID       int    `json:"assignee_id"`
+	Description      string `json:"description"`
+	Done             uint8  `json:"done"`
+	DueDate          string `json:"due_date"`
+	AddTime          string `json:"add_time"`
+	UpdateTime       string `json:"update_time"`
+	MarkedAsDoneTime string `json:"marked_as_done_time"`
+}
+
+func (t Task) String() string {
+	return Stringify(t)
+}
+
+// IsDone reports whether the task has been marked as done.
+func (t Task) IsDone() bool {
+	return t.Done == 1
+}
+
+// IsSubtask reports whether the task belongs to a parent task.
+func (t Task) IsSubtask() bool {
+	return t.ParentTaskID != 0
+}
+
+// TasksResponse represents multiple tasks response.
+type TasksResponse struct {
+	Success        bool           `json:"success"`
+	Data           []Task         `json:"data"`
+	AdditionalData AdditionalData `json:"additional_data"`
+}
+
+// TaskResponse represents single task response.
+type TaskResponse struct {
+	Success bool `json:"success"`
+	Data    Task `json:"data"`
+}
+
+// TasksListOptions specifices the optional parameters to the
+// TasksService.List method.
+//
+// Set ParentTaskID to "null" to only list top level tasks.
+type TasksListOptions struct {
+	Cursor       string `url:"cursor,omitempty"`
+	Limit        uint   `url:"limit,omitempty"`
+	AssigneeID   uint   `url:"assignee_id,omitempty"`
+	ProjectID    uint   `url:"project_id,omitempty"`
+	ParentTaskID string `url:"parent_task_id,omitempty"`
+	Done         *uint8 `url:"done,omitempty"`
+}
+
+// List returns all tasks. Results are paginated with a cursor, use
+// AdditionalData.NextCursor to fetch the next page.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Tasks/get_tasks
+func (s *TasksService) List(ctx context.Context, opt *TasksListOptions) (*TasksResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "/tasks", opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *TasksResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// GetByID returns a specific task.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Tasks/get_tasks_id
+func (s *TasksService) GetByID(ctx context.Context, id int) (*TaskResponse, *Response, error) {
+	uri := fmt.Sprintf("/tasks/%v", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *TaskResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// TaskCreateOptions specifices the parameters to the
+// TasksService.Create method.
+type TaskCreateOptions struct {
+	Title        string `json:"title"`
+	ProjectID    uint   `json:"project_id"`
+	Description  string `json:"description,omitempty"`
+	ParentTaskID uint   `json:"parent_task_id,omitempty"`
+	AssigneeID   uint   `json:"assignee_id,omitempty"`
+	Done         uint8  `json:"done,omitempty"`
+	DueDate      string `json:"due_date,omitempty"`
+}
+
+// Validate checks the required title and project.
+func (o TaskCreateOptions) Validate() error {
+	if o.Title == "" {
+		return requiredError("title")
+	}
+
+	if o.ProjectID == 0 {
+		return requiredError("project_id")
+	}
+
+	return nil
+}
+
+// Create a new task.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Tasks/post_tasks
+func (s *TasksService) Create(ctx context.Context, opt *TaskCreateOptions) (*TaskResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "/tasks", nil, opt)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *TaskResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// TaskUpdateOptions specifices the optional parameters to the
+// TasksService.Update method.
+type TaskUpdateOptions struct {
+	Title        string `json:"title,omitempty"`
+	ProjectID    uint   `json:"project_id,omitempty"`
+	Description  string `json:"description,omitempty"`
+	ParentTaskID uint   `json:"parent_task_id,omitempty"`
+	AssigneeID   uint   `json:"assignee_id,omitempty"`
+	Done         *uint8 `json:"done,omitempty"`
+	DueDate      string `json:"due_date,omitempty"`
+}
+
+// Update a specific task.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Tasks/put_tasks_id
+func (s *TasksService) Update(ctx context.Context, id int, opt *TaskUpdateOptions) (*TaskResponse, *Response, error) {
+	uri := fmt.Sprintf("/tasks/%v", id)
+	req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *TaskResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// Delete marks a task as deleted. Subtasks of the task are deleted as well.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Tasks/delete_tasks_id
+func (s *TasksService) Delete(ctx context.Context, id int) (*Response, error) {
+	uri := fmt.Sprintf("/tasks/%v", id)
+	req, err := s.client.NewRequest(http.MethodDelete, uri, nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
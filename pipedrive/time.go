@@ -1,7 +1,11 @@
 package pipedrive
 
 // http://fuckinggodateformat.com/
-import "time"
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
 
 type Timestamp struct {
 	time.Time
@@ -18,3 +22,76 @@ func (t Timestamp) Format() string {
 func (t Timestamp) FormatFull() string {
 	return t.Time.Format("2006-01-02 15:04:05")
 }
+
+// DueDate represents a date without time, formatted as YYYY-MM-DD.
+type DueDate string
+
+// NewDueDate returns the date of t.
+func NewDueDate(t time.Time) DueDate {
+	return DueDate(t.Format("2006-01-02"))
+}
+
+// Time parses the date, it is midnight in UTC.
+func (d DueDate) Time() (time.Time, error) {
+	return time.Parse("2006-01-02", string(d))
+}
+
+// MarshalJSON fails on malformed dates, which the API would ignore.
+func (d DueDate) MarshalJSON() ([]byte, error) {
+	return marshalTimeString(string(d), "2006-01-02", "due date")
+}
+
+// ClockTime represents a time of day in UTC, formatted as HH:MM.
+type ClockTime string
+
+// NewClockTime returns the time of day of t in UTC.
+func NewClockTime(t time.Time) ClockTime {
+	return ClockTime(t.UTC().Format("15:04"))
+}
+
+// MarshalJSON fails on malformed times, which the API would ignore.
+func (c ClockTime) MarshalJSON() ([]byte, error) {
+	return marshalTimeString(string(c), "15:04", "clock time")
+}
+
+// DurationHM represents a duration formatted as HH:MM.
+type DurationHM string
+
+// NewDurationHM returns d rounded down to whole minutes.
+func NewDurationHM(d time.Duration) DurationHM {
+	minutes := int(d / time.Minute)
+
+	return DurationHM(fmt.Sprintf("%02d:%02d", minutes/60, minutes%60))
+}
+
+// Duration parses the duration.
+func (d DurationHM) Duration() (time.Duration, error) {
+	var hours, minutes int
+
+	if _, err := fmt.Sscanf(string(d), "%d:%d", &hours, &minutes); err != nil || minutes < 0 || minutes > 59 {
+		return 0, fmt.Errorf("malformed duration %q, expected HH:MM", string(d))
+	}
+
+	return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute, nil
+}
+
+// MarshalJSON fails on malformed durations, which the API would ignore.
+func (d DurationHM) MarshalJSON() ([]byte, error) {
+	if d != "" {
+		if _, err := d.Duration(); err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(string(d))
+}
+
+func marshalTimeString(value, layout, name string) ([]byte, error) {
+	if value != "" {
+		if _, err := time.Parse(layout, value); err != nil {
+			return nil, fmt.Errorf("malformed %v %q, expected %v", name, value, layout)
+		}
+	}
+
+	return json.Marshal(value)
+}
@@ -0,0 +1,38 @@
+package pipedrive
+
+import "net/http"
+
+// headerAPIToken is the header the API token is sent in.
+const headerAPIToken = "x-api-token"
+
+// TokenAuth represents how the API token is sent.
+type TokenAuth int
+
+// TokenAuth constants.
+const (
+	// TokenInHeader sends the token in the x-api-token header, which keeps
+	// it out of the access logs of proxies and servers.
+	TokenInHeader TokenAuth = iota
+
+	// TokenInQuery sends the token in the api_token query parameter, as
+	// older versions of the client did.
+	TokenInQuery
+
+	// TokenOAuth sends the token as an OAuth bearer token, for the access
+	// tokens of Marketplace apps, see MarketplaceApp.
+	TokenOAuth
+)
+
+// authorize adds the token header to the request.
+func (c *Client) authorize(request *http.Request) {
+	if c.ApiKey == "" {
+		return
+	}
+
+	switch c.TokenAuth {
+	case TokenInHeader:
+		request.Header.Set(headerAPIToken, c.ApiKey)
+	case TokenOAuth:
+		request.Header.Set("Authorization", "Bearer "+c.ApiKey)
+	}
+}
@@ -0,0 +1,124 @@
+package pipedrive
+
+import (
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultMaxIdleConnsPerHost = 100
+	defaultIdleConnTimeout     = 90 * time.Second
+)
+
+// The functions below return options for Client.SetOptions that tune the
+// connections to the API. The default transport of net/http keeps only two
+// idle connections per host, so with many concurrent calls most of them
+// open a new connection. The first tuning option gives the client its own
+// transport, with 100 idle connections per host. Set the options before
+// making requests.
+
+// WithHTTPClient sets the HTTP client the requests are sent with.
+func WithHTTPClient(httpClient *http.Client) func(*Client) error {
+	return func(c *Client) error {
+		if httpClient == nil {
+			return errors.New("the HTTP client must not be nil")
+		}
+
+		c.client = httpClient
+
+		return nil
+	}
+}
+
+// WithMaxIdleConnsPerHost sets the number of idle connections kept open
+// to the API for reuse.
+func WithMaxIdleConnsPerHost(n int) func(*Client) error {
+	return func(c *Client) error {
+		transport, err := c.transport()
+
+		if err != nil {
+			return err
+		}
+
+		transport.MaxIdleConnsPerHost = n
+
+		if transport.MaxIdleConns != 0 && transport.MaxIdleConns < n {
+			transport.MaxIdleConns = n
+		}
+
+		return nil
+	}
+}
+
+// WithIdleConnTimeout sets how long an idle connection is kept open, no
+// limit when zero.
+func WithIdleConnTimeout(d time.Duration) func(*Client) error {
+	return func(c *Client) error {
+		transport, err := c.transport()
+
+		if err != nil {
+			return err
+		}
+
+		transport.IdleConnTimeout = d
+
+		return nil
+	}
+}
+
+// WithHTTP2 enables or disables HTTP/2, which is enabled by default. Over
+// HTTP/2 concurrent requests share one connection, which can be slower
+// than separate connections when many large lists are fetched at once.
+func WithHTTP2(enabled bool) func(*Client) error {
+	return func(c *Client) error {
+		transport, err := c.transport()
+
+		if err != nil {
+			return err
+		}
+
+		if enabled {
+			transport.TLSNextProto = nil
+		} else {
+			// A non-nil, empty map disables HTTP/2.
+			transport.TLSNextProto = make(map[string]func(string, *tls.Conn) http.RoundTripper)
+		}
+
+		return nil
+	}
+}
+
+// transport returns the transport of the client, which is replaced with a
+// transport of its own while the client uses http.DefaultClient.
+func (c *Client) transport() (*http.Transport, error) {
+	if c.client == http.DefaultClient {
+		c.client = &http.Client{Transport: newTransport()}
+	}
+
+	if c.client.Transport == nil {
+		c.client.Transport = newTransport()
+	}
+
+	transport, ok := c.client.Transport.(*http.Transport)
+
+	if !ok {
+		return nil, errors.New("the HTTP client has a custom transport")
+	}
+
+	return transport, nil
+}
+
+// newTransport returns a transport like http.DefaultTransport, with more
+// idle connections per host.
+func newTransport() *http.Transport {
+	return &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		MaxIdleConns:          defaultMaxIdleConnsPerHost,
+		MaxIdleConnsPerHost:   defaultMaxIdleConnsPerHost,
+		IdleConnTimeout:       defaultIdleConnTimeout,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: time.Second,
+	}
+}
@@ -0,0 +1,398 @@
+package pipedrive
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultUsageInterval = time.Minute
+	defaultUsageWindows  = 60
+)
+
+type usageFeatureKey struct{}
+
+// WithUsageFeature returns a copy of ctx that attributes the requests sent
+// with it to a feature, such as "deal-sync" or "nightly-export", in the
+// UsageTracker of the client.
+func WithUsageFeature(ctx context.Context, feature string) context.Context {
+	return context.WithValue(ctx, usageFeatureKey{}, feature)
+}
+
+// UsageFeature returns the feature the requests sent with ctx are
+// attributed to, empty when none.
+func UsageFeature(ctx context.Context) string {
+	feature, _ := ctx.Value(usageFeatureKey{}).(string)
+
+	return feature
+}
+
+// UsageCounts counts requests sent to the API.
+type UsageCounts struct {
+	Requests int64 `json:"requests"`
+
+	// Errors counts the requests that failed, including those without a
+	// response and those hitting the rate limit.
+	Errors int64 `json:"errors"`
+
+	// RateLimited counts the requests rejected by the rate limit of the
+	// API.
+	RateLimited int64 `json:"rate_limited"`
+
+	// Duration is the time spent on the requests.
+	Duration time.Duration `json:"duration"`
+}
+
+func (u *UsageCounts) add(err error, duration time.Duration) {
+	u.Requests++
+	u.Duration += duration
+
+	if err != nil {
+		u.Errors++
+	}
+
+	if _, ok := err.(*RateLimitError); ok {
+		u.RateLimited++
+	}
+}
+
+// EndpointUsage is the usage of an endpoint by a feature.
+type EndpointUsage struct {
+	// Feature is empty for the requests not attributed to a feature, see
+	// WithUsageFeature.
+	Feature string `json:"feature"`
+
+	// Endpoint is the method and the path of the requests with the IDs
+	// replaced, such as GET /v1/deals/:id/files.
+	Endpoint string `json:"endpoint"`
+
+	UsageCounts
+}
+
+// UsageWindow is the usage during an interval of time.
+type UsageWindow struct {
+	Start time.Time `json:"start"`
+
+	UsageCounts
+
+	// Features holds the number of requests of every feature.
+	Features map[string]int64 `json:"features"`
+
+	// Limit is the rate limit reported by the API in the window, and
+	// Remaining the fewest requests reported left of it. Both are zero
+	// when no response carried the rate limit headers.
+	Limit     int `json:"limit"`
+	Remaining int `json:"remaining"`
+}
+
+// Utilization returns the largest part of the rate limit used up in the
+// window, between 0 and 1.
+func (w UsageWindow) Utilization() float64 {
+	if w.Limit <= 0 {
+		return 0
+	}
+
+	return float64(w.Limit-w.Remaining) / float64(w.Limit)
+}
+
+// UsageSnapshot is the usage recorded by a UsageTracker up to a point in
+// time.
+type UsageSnapshot struct {
+	// Since is when the tracker was created or last reset.
+	Since time.Time `json:"since"`
+	Taken time.Time `json:"taken"`
+
+	Total UsageCounts `json:"total"`
+
+	// Endpoints are sorted by feature, then by endpoint.
+	Endpoints []EndpointUsage `json:"endpoints"`
+
+	// Windows are the most recent windows, oldest first. Windows without
+	// requests are left out.
+	Windows []UsageWindow `json:"windows"`
+
+	// Rate is the rate limit reported by the last response.
+	Rate Rate `json:"rate"`
+}
+
+// Features returns the usage of every feature, summed over its endpoints.
+func (s *UsageSnapshot) Features() map[string]UsageCounts {
+	features := make(map[string]UsageCounts)
+
+	for _, endpoint := range s.Endpoints {
+		counts := features[endpoint.Feature]
+		counts.Requests += endpoint.Requests
+		counts.Errors += endpoint.Errors
+		counts.RateLimited += endpoint.RateLimited
+		counts.Duration += endpoint.Duration
+		features[endpoint.Feature] = counts
+	}
+
+	return features
+}
+
+// UsageTracker aggregates the requests of clients per feature and
+// endpoint, and the requests and the rate limit consumed over time, to
+// attribute the API budget to the features using it and to find quiet
+// windows for batch work. Set it as Client.Usage, possibly of several
+// clients of the same account. Its counters can be exposed to Prometheus
+// with PrometheusHandler.
+type UsageTracker struct {
+	// Interval is the length of the windows, 1 minute when zero.
+	Interval time.Duration
+
+	// Windows is the number of recent windows kept, 60 when zero.
+	Windows int
+
+	mu        sync.Mutex
+	since     time.Time
+	total     UsageCounts
+	endpoints map[usageKey]*UsageCounts
+	windows   []UsageWindow
+	rate      Rate
+}
+
+type usageKey struct {
+	feature  string
+	endpoint string
+}
+
+// NewUsageTracker returns an empty UsageTracker.
+func NewUsageTracker() *UsageTracker {
+	return &UsageTracker{
+		since:     time.Now(),
+		endpoints: make(map[usageKey]*UsageCounts),
+	}
+}
+
+// record adds a request, whose response is nil when none was received.
+func (t *UsageTracker) record(ctx context.Context, request *http.Request, response *Response, started time.Time, err error) {
+	if t == nil {
+		return
+	}
+
+	now := time.Now()
+	duration := now.Sub(started)
+	feature := UsageFeature(ctx)
+	key := usageKey{feature: feature, endpoint: request.Method + " " + usageEndpoint(request.URL.Path)}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.endpoints == nil {
+		t.since = now
+		t.endpoints = make(map[usageKey]*UsageCounts)
+	}
+
+	t.total.add(err, duration)
+
+	counts := t.endpoints[key]
+
+	if counts == nil {
+		counts = &UsageCounts{}
+		t.endpoints[key] = counts
+	}
+
+	counts.add(err, duration)
+
+	window := t.window(now)
+	window.add(err, duration)
+	window.Features[feature]++
+
+	if response == nil || response.Limit == 0 {
+		return
+	}
+
+	t.rate = response.Rate
+
+	if window.Limit == 0 || response.Remaining < window.Remaining {
+		window.Remaining = response.Remaining
+	}
+
+	if response.Limit > window.Limit {
+		window.Limit = response.Limit
+	}
+}
+
+// window returns the window now falls in, dropping the oldest windows
+// when there are too many.
+func (t *UsageTracker) window(now time.Time) *UsageWindow {
+	interval := t.Interval
+
+	if interval <= 0 {
+		interval = defaultUsageInterval
+	}
+
+	start := now.Truncate(interval)
+
+	if n := len(t.windows); n > 0 && t.windows[n-1].Start.Equal(start) {
+		return &t.windows[n-1]
+	}
+
+	size := t.Windows
+
+	if size <= 0 {
+		size = defaultUsageWindows
+	}
+
+	if len(t.windows) >= size {
+		t.windows = append(t.windows[:0], t.windows[len(t.windows)-size+1:]...)
+	}
+
+	t.windows = append(t.windows, UsageWindow{Start: start, Features: make(map[string]int64)})
+
+	return &t.windows[len(t.windows)-1]
+}
+
+// Snapshot returns the usage recorded so far.
+func (t *UsageTracker) Snapshot() *UsageSnapshot {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.snapshot()
+}
+
+func (t *UsageTracker) snapshot() *UsageSnapshot {
+	snapshot := &UsageSnapshot{
+		Since:     t.since,
+		Taken:     time.Now(),
+		Total:     t.total,
+		Endpoints: make([]EndpointUsage, 0, len(t.endpoints)),
+		Windows:   make([]UsageWindow, len(t.windows)),
+		Rate:      t.rate,
+	}
+
+	for key, counts := range t.endpoints {
+		snapshot.Endpoints = append(snapshot.Endpoints, EndpointUsage{
+			Feature:     key.feature,
+			Endpoint:    key.endpoint,
+			UsageCounts: *counts,
+		})
+	}
+
+	sort.Sort(byFeatureAndEndpoint(snapshot.Endpoints))
+
+	for i, window := range t.windows {
+		snapshot.Windows[i] = window
+		snapshot.Windows[i].Features = make(map[string]int64, len(window.Features))
+
+		for feature, requests := range window.Features {
+			snapshot.Windows[i].Features[feature] = requests
+		}
+	}
+
+	return snapshot
+}
+
+// Reset clears the usage and returns the usage recorded until then.
+func (t *UsageTracker) Reset() *UsageSnapshot {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	snapshot := t.snapshot()
+
+	t.since = snapshot.Taken
+	t.total = UsageCounts{}
+	t.endpoints = make(map[usageKey]*UsageCounts)
+	t.windows = nil
+
+	return snapshot
+}
+
+type byFeatureAndEndpoint []EndpointUsage
+
+func (s byFeatureAndEndpoint) Len() int      { return len(s) }
+func (s byFeatureAndEndpoint) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s byFeatureAndEndpoint) Less(i, j int) bool {
+	if s[i].Feature != s[j].Feature {
+		return s[i].Feature < s[j].Feature
+	}
+
+	return s[i].Endpoint < s[j].Endpoint
+}
+
+// usageEndpoint returns the path with the numeric segments, the IDs,
+// replaced by :id, so that requests for different records of the same
+// endpoint are counted together.
+func usageEndpoint(path string) string {
+	segments := strings.Split(path, "/")
+
+	for i, segment := range segments {
+		if segment == "" {
+			continue
+		}
+
+		if _, err := strconv.ParseUint(segment, 10, 64); err == nil {
+			segments[i] = ":id"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// PrometheusHandler returns a handler serving the usage in the Prometheus
+// text format, to be scraped from a path such as /metrics. See
+// WritePrometheus.
+func (t *UsageTracker) PrometheusHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		t.WritePrometheus(w)
+	})
+}
+
+// WritePrometheus writes the usage in the Prometheus text format: the
+// counters pipedrive_requests_total, pipedrive_request_errors_total,
+// pipedrive_requests_rate_limited_total and
+// pipedrive_request_duration_seconds_total labeled with the feature and
+// the endpoint, and the gauges pipedrive_rate_limit and
+// pipedrive_rate_limit_remaining of the last response.
+func (t *UsageTracker) WritePrometheus(w io.Writer) error {
+	snapshot := t.Snapshot()
+	buf := bufio.NewWriter(w)
+
+	counters := []struct {
+		name  string
+		help  string
+		value func(UsageCounts) string
+	}{
+		{"pipedrive_requests_total", "Requests sent to the Pipedrive API.", func(u UsageCounts) string {
+			return strconv.FormatInt(u.Requests, 10)
+		}},
+		{"pipedrive_request_errors_total", "Requests to the Pipedrive API that failed.", func(u UsageCounts) string {
+			return strconv.FormatInt(u.Errors, 10)
+		}},
+		{"pipedrive_requests_rate_limited_total", "Requests rejected by the rate limit of the Pipedrive API.", func(u UsageCounts) string {
+			return strconv.FormatInt(u.RateLimited, 10)
+		}},
+		{"pipedrive_request_duration_seconds_total", "Time spent on requests to the Pipedrive API.", func(u UsageCounts) string {
+			return strconv.FormatFloat(u.Duration.Seconds(), 'g', -1, 64)
+		}},
+	}
+
+	for _, counter := range counters {
+		fmt.Fprintf(buf, "# HELP %v %v\n# TYPE %v counter\n", counter.name, counter.help, counter.name)
+
+		for _, endpoint := range snapshot.Endpoints {
+			fmt.Fprintf(buf, "%v{feature=\"%v\",endpoint=\"%v\"} %v\n", counter.name,
+				prometheusLabel(endpoint.Feature), prometheusLabel(endpoint.Endpoint), counter.value(endpoint.UsageCounts))
+		}
+	}
+
+	if snapshot.Rate.Limit > 0 {
+		fmt.Fprintf(buf, "# HELP pipedrive_rate_limit Rate limit reported by the last response.\n# TYPE pipedrive_rate_limit gauge\npipedrive_rate_limit %v\n", snapshot.Rate.Limit)
+		fmt.Fprintf(buf, "# HELP pipedrive_rate_limit_remaining Requests left of the rate limit reported by the last response.\n# TYPE pipedrive_rate_limit_remaining gauge\npipedrive_rate_limit_remaining %v\n", snapshot.Rate.Remaining)
+	}
+
+	return buf.Flush()
+}
+
+// prometheusLabel escapes a label value of the Prometheus text format.
+var prometheusLabel = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace
@@ -0,0 +1,162 @@
+package pipedrive
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUsageEndpoint(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/v1/deals", want: "/v1/deals"},
+		{path: "/v1/deals/15/files", want: "/v1/deals/:id/files"},
+		{path: "/api/v2/persons/7", want: "/api/v2/persons/:id"},
+		{path: "/v1/leads/adf21080-0e10-11eb", want: "/v1/leads/adf21080-0e10-11eb"},
+		{path: "/v1/deals/-1", want: "/v1/deals/-1"},
+	}
+
+	for _, tt := range tests {
+		if got := usageEndpoint(tt.path); got != tt.want {
+			t.Errorf("usageEndpoint(%v) returned %v, want %v", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestUsageWindow_Utilization(t *testing.T) {
+	tests := []struct {
+		window UsageWindow
+		want   float64
+	}{
+		{window: UsageWindow{}, want: 0},
+		{window: UsageWindow{Limit: 100, Remaining: 100}, want: 0},
+		{window: UsageWindow{Limit: 100, Remaining: 25}, want: 0.75},
+		{window: UsageWindow{Limit: 80, Remaining: 0}, want: 1},
+	}
+
+	for _, tt := range tests {
+		if got := tt.window.Utilization(); got != tt.want {
+			t.Errorf("Utilization of %+v returned %v, want %v", tt.window, got, tt.want)
+		}
+	}
+}
+
+func TestUsageTracker_record(t *testing.T) {
+	tracker := NewUsageTracker()
+	syncing := WithUsageFeature(context.Background(), "sync")
+
+	get, _ := http.NewRequest(http.MethodGet, "https://example.com/v1/deals/1", nil)
+	post, _ := http.NewRequest(http.MethodPost, "https://example.com/v1/deals", nil)
+
+	tests := []struct {
+		ctx      context.Context
+		request  *http.Request
+		response *Response
+		err      error
+	}{
+		{ctx: syncing, request: get, response: &Response{Rate: Rate{Limit: 100, Remaining: 90}}},
+		{ctx: syncing, request: get, response: &Response{Rate: Rate{Limit: 100, Remaining: 95}}},
+		{ctx: syncing, request: post, err: &RateLimitError{}},
+		{ctx: context.Background(), request: get, err: errors.New("timeout")},
+	}
+
+	for _, tt := range tests {
+		tracker.record(tt.ctx, tt.request, tt.response, time.Now(), tt.err)
+	}
+
+	snapshot := tracker.Snapshot()
+
+	if want := (UsageCounts{Requests: 4, Errors: 2, RateLimited: 1}); snapshot.Total.Requests != want.Requests ||
+		snapshot.Total.Errors != want.Errors || snapshot.Total.RateLimited != want.RateLimited {
+		t.Errorf("Snapshot has total %+v, want %+v", snapshot.Total, want)
+	}
+
+	endpoints := []struct {
+		feature, endpoint string
+		requests          int64
+	}{
+		{"", "GET /v1/deals/:id", 1},
+		{"sync", "GET /v1/deals/:id", 2},
+		{"sync", "POST /v1/deals", 1},
+	}
+
+	if len(snapshot.Endpoints) != len(endpoints) {
+		t.Fatalf("Snapshot has endpoints %+v, want %v", snapshot.Endpoints, len(endpoints))
+	}
+
+	for i, want := range endpoints {
+		got := snapshot.Endpoints[i]
+
+		if got.Feature != want.feature || got.Endpoint != want.endpoint || got.Requests != want.requests {
+			t.Errorf("Snapshot has endpoint %+v, want %+v", got, want)
+		}
+	}
+
+	if features := snapshot.Features(); features["sync"].Requests != 3 {
+		t.Errorf("Features returned %+v, want 3 sync requests", features)
+	}
+
+	if window := snapshot.Windows[len(snapshot.Windows)-1]; window.Limit != 100 || window.Remaining != 90 {
+		t.Errorf("Snapshot has window %+v, want the fewest remaining requests", window)
+	}
+
+	if tracker.Reset(); tracker.Snapshot().Total.Requests != 0 {
+		t.Error("Reset left requests")
+	}
+}
+
+func TestUsageTracker_window(t *testing.T) {
+	tracker := &UsageTracker{Interval: time.Minute, Windows: 2}
+	start := time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	for _, offset := range []time.Duration{0, 30 * time.Second, time.Minute, 2 * time.Minute, 2*time.Minute + 59*time.Second} {
+		tracker.window(start.Add(offset)).Requests++
+	}
+
+	want := []struct {
+		start    time.Time
+		requests int64
+	}{
+		{start.Add(time.Minute), 1},
+		{start.Add(2 * time.Minute), 2},
+	}
+
+	if len(tracker.windows) != len(want) {
+		t.Fatalf("window kept %+v, want %v windows", tracker.windows, len(want))
+	}
+
+	for i, w := range want {
+		if got := tracker.windows[i]; !got.Start.Equal(w.start) || got.Requests != w.requests {
+			t.Errorf("window %v is %+v, want start %v with %v requests", i, got, w.start, w.requests)
+		}
+	}
+}
+
+func TestUsageTracker_WritePrometheus(t *testing.T) {
+	tracker := NewUsageTracker()
+	request, _ := http.NewRequest(http.MethodGet, "https://example.com/v1/deals", nil)
+	tracker.record(WithUsageFeature(context.Background(), `a"b`), request, &Response{Rate: Rate{Limit: 100, Remaining: 40}}, time.Now(), nil)
+
+	var buf bytes.Buffer
+
+	if err := tracker.WritePrometheus(&buf); err != nil {
+		t.Fatalf("WritePrometheus returned error: %v", err)
+	}
+
+	for _, line := range []string{
+		`pipedrive_requests_total{feature="a\"b",endpoint="GET /v1/deals"} 1`,
+		`pipedrive_request_errors_total{feature="a\"b",endpoint="GET /v1/deals"} 0`,
+		`pipedrive_rate_limit 100`,
+		`pipedrive_rate_limit_remaining 40`,
+	} {
+		if !strings.Contains(buf.String(), line+"\n") {
+			t.Errorf("WritePrometheus wrote %q, want line %q", buf.String(), line)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package pipedrive
+
+import (
+	"errors"
+	"net/http"
+	"runtime"
+	"strings"
+)
+
+// Version is the version of the package, it is sent in the User-Agent
+// header.
+const Version = "1.0.0"
+
+// headerClientName is the header the name of the client is sent in.
+const headerClientName = "X-Client-Name"
+
+// defaultUserAgent identifies the package and the Go version, such as
+// go-pipedrive/1.0.0 (go1.12.5).
+var defaultUserAgent = "go-pipedrive/" + Version + " (" + runtime.Version() + ")"
+
+// WithUserAgent returns an option for Client.SetOptions that identifies the
+// service making the requests in the User-Agent header, such as
+// billing-sync/2.3. The version of the package and of Go follow it.
+func WithUserAgent(userAgent string) func(*Client) error {
+	return func(c *Client) error {
+		userAgent = strings.TrimSpace(userAgent)
+
+		if strings.ContainsAny(userAgent, "\r\n") {
+			return errors.New("the user agent must be a single line")
+		}
+
+		c.UserAgent = userAgent
+
+		return nil
+	}
+}
+
+// WithClientName returns an option for Client.SetOptions that sends the
+// name in the X-Client-Name header of every request, so the requests of
+// the services sharing an API token can be told apart in Pipedrive's logs
+// and support tickets.
+func WithClientName(name string) func(*Client) error {
+	return func(c *Client) error {
+		name = strings.TrimSpace(name)
+
+		if strings.ContainsAny(name, "\r\n") {
+			return errors.New("the client name must be a single line")
+		}
+
+		c.ClientName = name
+
+		return nil
+	}
+}
+
+// userAgent returns the User-Agent header of the requests.
+func (c *Client) userAgent() string {
+	if c.UserAgent == "" {
+		return defaultUserAgent
+	}
+
+	return c.UserAgent + " " + defaultUserAgent
+}
+
+// identify adds the User-Agent and X-Client-Name headers to the request.
+func (c *Client) identify(request *http.Request) {
+	request.Header.Set("User-Agent", c.userAgent())
+
+	if c.ClientName != "" {
+		request.Header.Set(headerClientName, c.ClientName)
+	}
+}
@@ -5,13 +5,13 @@ import (
 	"net/http"
 )
 
-// UserConnectionsService handles activities related
+// UserConnectionsService handles user connections related
 // methods of the Pipedrive API.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/UserConnections
 type UserConnectionsService service
 
-// UserConnections represents a Pipedrive user connections.
+// UserConnections represents the external accounts linked to a user.
 type UserConnections struct {
 	Success bool `json:"success"`
 	Data    struct {
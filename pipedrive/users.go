@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 )
 
 // UsersService handles users related
@@ -41,6 +42,34 @@ func (u User) String() string {
 	return Stringify(u)
 }
 
+// Location returns the time zone location configured for the user.
+func (u User) Location() (*time.Location, error) {
+	return time.LoadLocation(u.TimezoneName)
+}
+
+// CurrentUser represents the authorized Pipedrive user with company details.
+type CurrentUser struct {
+	User
+	CompanyID       int    `json:"company_id"`
+	CompanyName     string `json:"company_name"`
+	CompanyCountry  string `json:"company_country"`
+	CompanyIndustry string `json:"company_industry"`
+	Language        struct {
+		LanguageCode string `json:"language_code"`
+		CountryCode  string `json:"country_code"`
+	} `json:"language"`
+}
+
+func (u CurrentUser) String() string {
+	return Stringify(u)
+}
+
+// CurrentUserResponse represents current user response.
+type CurrentUserResponse struct {
+	Success bool        `json:"success"`
+	Data    CurrentUser `json:"data"`
+}
+
 // UsersResponse represents multiple users response.
 type UsersResponse struct {
 	Success        bool           `json:"success"`
@@ -64,60 +93,85 @@ type UserFollowersResponse struct {
 	AdditionalData AdditionalData `json:"additional_data"`
 }
 
+// UserPermissions represents the permissions of a user, aggregated over
+// the permission sets assigned to the user.
+type UserPermissions struct {
+	CanAddProducts              bool `json:"can_add_products"`
+	CanBulkEditItems            bool `json:"can_bulk_edit_items"`
+	CanChangeVisibilityOfItems  bool `json:"can_change_visibility_of_items"`
+	CanDeleteActivities         bool `json:"can_delete_activities"`
+	CanDeleteDeals              bool `json:"can_delete_deals"`
+	CanEditDealsClosedDate      bool `json:"can_edit_deals_closed_date"`
+	CanEditProducts             bool `json:"can_edit_products"`
+	CanEditSharedFilters        bool `json:"can_edit_shared_filters"`
+	CanExportDataFromLists      bool `json:"can_export_data_from_lists"`
+	CanFollowOtherUsers         bool `json:"can_follow_other_users"`
+	CanMergeDeals               bool `json:"can_merge_deals"`
+	CanMergeOrganizations       bool `json:"can_merge_organizations"`
+	CanMergePeople              bool `json:"can_merge_people"`
+	CanSeeCompanyWideStatistics bool `json:"can_see_company_wide_statistics"`
+	CanSeeDealsListSummary      bool `json:"can_see_deals_list_summary"`
+	CanSeeHiddenItemsNames      bool `json:"can_see_hidden_items_names"`
+	CanSeeOtherUsers            bool `json:"can_see_other_users"`
+	CanSeeOtherUsersStatistics  bool `json:"can_see_other_users_statistics"`
+	CanShareFilters             bool `json:"can_share_filters"`
+	CanUseAPI                   bool `json:"can_use_api"`
+}
+
 // UserPermissionsResponse represents user permissions response.
 type UserPermissionsResponse struct {
-	Success bool `json:"success"`
-	Data    struct {
-		CanAddProducts              bool `json:"can_add_products"`
-		CanBulkEditItems            bool `json:"can_bulk_edit_items"`
-		CanChangeVisibilityOfItems  bool `json:"can_change_visibility_of_items"`
-		CanDeleteActivities         bool `json:"can_delete_activities"`
-		CanDeleteDeals              bool `json:"can_delete_deals"`
-		CanEditDealsClosedDate      bool `json:"can_edit_deals_closed_date"`
-		CanEditProducts             bool `json:"can_edit_products"`
-		CanEditSharedFilters        bool `json:"can_edit_shared_filters"`
-		CanExportDataFromLists      bool `json:"can_export_data_from_lists"`
-		CanFollowOtherUsers         bool `json:"can_follow_other_users"`
-		CanMergeDeals               bool `json:"can_merge_deals"`
-		CanMergeOrganizations       bool `json:"can_merge_organizations"`
-		CanMergePeople              bool `json:"can_merge_people"`
-		CanSeeCompanyWideStatistics bool `json:"can_see_company_wide_statistics"`
-		CanSeeDealsListSummary      bool `json:"can_see_deals_list_summary"`
-		CanSeeHiddenItemsNames      bool `json:"can_see_hidden_items_names"`
-		CanSeeOtherUsers            bool `json:"can_see_other_users"`
-		CanSeeOtherUsersStatistics  bool `json:"can_see_other_users_statistics"`
-		CanShareFilters             bool `json:"can_share_filters"`
-		CanUseAPI                   bool `json:"can_use_api"`
-	} `json:"data"`
-}
-
-type Roles struct {
-	Success bool   `json:"success"`
-	Data    []Role `json:"data"`
-}
-type Role struct {
-	ID              int    `json:"id"`
-	ParentRoleID    int    `json:"parent_role_id"`
-	Name            string `json:"name"`
-	ActiveFlag      bool   `json:"active_flag"`
-	AssignmentCount string `json:"assignment_count"`
-	SubRoleCount    string `json:"sub_role_count"`
-	Level           int    `json:"level"`
+	Success bool            `json:"success"`
+	Data    UserPermissions `json:"data"`
+}
+
+// RoleSettings represents visibility and access level settings of a role.
+type RoleSettings struct {
+	DealDefaultVisibility    int `json:"deal_default_visibility"`
+	LeadDefaultVisibility    int `json:"lead_default_visibility"`
+	OrgDefaultVisibility     int `json:"org_default_visibility"`
+	PersonDefaultVisibility  int `json:"person_default_visibility"`
+	ProductDefaultVisibility int `json:"product_default_visibility"`
+	DealAccessLevel          int `json:"deal_access_level"`
+	OrgAccessLevel           int `json:"org_access_level"`
+	PersonAccessLevel        int `json:"person_access_level"`
+	ProductAccessLevel       int `json:"product_access_level"`
+}
+
+func (rs RoleSettings) String() string {
+	return Stringify(rs)
 }
 
 // UserRoleSettingsResponse represents user role settings response.
 type UserRoleSettingsResponse struct {
-	Success bool `json:"success"`
-	Data    struct {
-		DealDefaultVisibility    int `json:"deal_default_visibility"`
-		OrgDefaultVisibility     int `json:"org_default_visibility"`
-		PersonDefaultVisibility  int `json:"person_default_visibility"`
-		ProductDefaultVisibility int `json:"product_default_visibility"`
-		DealAccessLevel          int `json:"deal_access_level"`
-		OrgAccessLevel           int `json:"org_access_level"`
-		PersonAccessLevel        int `json:"person_access_level"`
-		ProductAccessLevel       int `json:"product_access_level"`
-	} `json:"data"`
+	Success bool         `json:"success"`
+	Data    RoleSettings `json:"data"`
+}
+
+// RoleAssignment represents an assignment of a user to a role.
+type RoleAssignment struct {
+	UserID       int    `json:"user_id"`
+	RoleID       int    `json:"role_id"`
+	ParentRoleID int    `json:"parent_role_id"`
+	Name         string `json:"name"`
+	ActiveFlag   bool   `json:"active_flag"`
+	Type         string `json:"type"`
+}
+
+func (ra RoleAssignment) String() string {
+	return Stringify(ra)
+}
+
+// RoleAssignmentsResponse represents multiple role assignments response.
+type RoleAssignmentsResponse struct {
+	Success        bool             `json:"success"`
+	Data           []RoleAssignment `json:"data"`
+	AdditionalData AdditionalData   `json:"additional_data"`
+}
+
+// RoleAssignmentResponse represents single role assignment response.
+type RoleAssignmentResponse struct {
+	Success bool           `json:"success"`
+	Data    RoleAssignment `json:"data"`
 }
 
 // ListFollowers lists followers of a specific user.
@@ -166,12 +220,14 @@ func (s *UsersService) List(ctx context.Context) (*UsersResponse, *Response, err
 // UserCreateOptions specifices the optional parameters to the
 // UsersService.Create method.
 type UserCreateOptions struct {
-	Name       string `url:"name"`
-	Email      string `url:"email"`
-	ActiveFlag uint8  `url:"active_flag"`
+	Name       string `json:"name,omitempty"`
+	Email      string `json:"email"`
+	ActiveFlag bool   `json:"active_flag"`
 }
 
-// List returns data about all Roles within the company.
+// Roles returns data about all roles within the company.
+//
+// Deprecated: use RolesService.List instead.
 func (s *UsersService) Roles(ctx context.Context) (*Roles, *Response, error) {
 	req, err := s.client.NewRequest(http.MethodGet, "/roles", nil, nil)
 
@@ -239,8 +295,20 @@ func (s *UsersService) FindByName(ctx context.Context, opt *UsersFindByNameOptio
 	return record, resp, nil
 }
 
+// FindByEmail finds users by their email.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users/get_users_find
+func (s *UsersService) FindByEmail(ctx context.Context, email string) (*UsersResponse, *Response, error) {
+	return s.FindByName(ctx, &UsersFindByNameOptions{
+		Term:          email,
+		SearchByEmail: 1,
+	})
+}
+
 // GetCurrentUserData returns data about an authorized user within the company.
 //
+// Deprecated: use Me instead, which includes company, locale and timezone details.
+//
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users/get_users_me
 func (s *UsersService) GetCurrentUserData(ctx context.Context) (*UserSingleResponse, *Response, error) {
 	req, err := s.client.NewRequest(http.MethodGet, "/users/me", nil, nil)
@@ -260,10 +328,33 @@ func (s *UsersService) GetCurrentUserData(ctx context.Context) (*UserSingleRespo
 	return record, resp, nil
 }
 
+// Me returns data about an authorized user within the company with bound
+// company data: company ID, company name, and domain. Useful for validating
+// the API token and resolving the default owner.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users/get_users_me
+func (s *UsersService) Me(ctx context.Context) (*CurrentUserResponse, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "/users/me", nil, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *CurrentUserResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
 // GetByID returns specific user.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users/get_users_id
-func (s *UsersService) GetByID(ctx context.Context, id int) (*UserFollowersResponse, *Response, error) {
+func (s *UsersService) GetByID(ctx context.Context, id int) (*UserSingleResponse, *Response, error) {
 	uri := fmt.Sprintf("/users/%v", id)
 	req, err := s.client.NewRequest(http.MethodGet, uri, nil, nil)
 
@@ -271,7 +362,7 @@ func (s *UsersService) GetByID(ctx context.Context, id int) (*UserFollowersRespo
 		return nil, nil, err
 	}
 
-	var record *UserFollowersResponse
+	var record *UserSingleResponse
 
 	resp, err := s.client.Do(ctx, req, &record)
 
@@ -326,6 +417,61 @@ func (s *UsersService) ListUserRoleSettings(ctx context.Context, id int) (*UserR
 	return record, resp, nil
 }
 
+// UserListRoleAssignmentsOptions specifices the optional parameters to the
+// UsersService.ListRoleAssignments method.
+type UserListRoleAssignmentsOptions struct {
+	Start uint `url:"start,omitempty"`
+	Limit uint `url:"limit,omitempty"`
+}
+
+// ListRoleAssignments lists role assignments for a user.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users/get_users_id_roleAssignments
+func (s *UsersService) ListRoleAssignments(ctx context.Context, id int, opt *UserListRoleAssignmentsOptions) (*RoleAssignmentsResponse, *Response, error) {
+	uri := fmt.Sprintf("/users/%v/roleAssignments", id)
+	req, err := s.client.NewRequest(http.MethodGet, uri, opt, nil)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *RoleAssignmentsResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// AddRoleAssignment assigns a user to a role.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Roles/post_roles_id_assignments
+func (s *UsersService) AddRoleAssignment(ctx context.Context, id int, roleID int) (*RoleAssignmentResponse, *Response, error) {
+	uri := fmt.Sprintf("/roles/%v/assignments", roleID)
+	req, err := s.client.NewRequest(http.MethodPost, uri, nil, struct {
+		UserID int `json:"user_id"`
+	}{
+		id,
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *RoleAssignmentResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
 // UsersUpdateUserDetailsOptions specifices the optional parameters to the
 // UsersService.UpdateUserDetails method.
 type UsersUpdateUserDetailsOptions struct {
@@ -334,6 +480,8 @@ type UsersUpdateUserDetailsOptions struct {
 
 // UpdateUserDetails updates the properties of a user. Currently, only active_flag can be updated.
 //
+// Deprecated: use Update instead.
+//
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users/put_users_id
 func (s *UsersService) UpdateUserDetails(ctx context.Context, id int, opt *UsersUpdateUserDetailsOptions) (*Response, error) {
 	uri := fmt.Sprintf("/users/%v", id)
@@ -352,6 +500,44 @@ func (s *UsersService) UpdateUserDetails(ctx context.Context, id int, opt *Users
 	return resp, nil
 }
 
+// UserUpdateOptions specifices the parameters to the
+// UsersService.Update method.
+type UserUpdateOptions struct {
+	ActiveFlag bool `json:"active_flag"`
+}
+
+// Update the properties of a user. Currently, only active_flag can be updated.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Users/put_users_id
+func (s *UsersService) Update(ctx context.Context, id int, opt *UserUpdateOptions) (*UserSingleResponse, *Response, error) {
+	uri := fmt.Sprintf("/users/%v", id)
+	req, err := s.client.NewRequest(http.MethodPut, uri, nil, opt)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *UserSingleResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// Activate a specific user.
+func (s *UsersService) Activate(ctx context.Context, id int) (*UserSingleResponse, *Response, error) {
+	return s.Update(ctx, id, &UserUpdateOptions{ActiveFlag: true})
+}
+
+// Deactivate a specific user.
+func (s *UsersService) Deactivate(ctx context.Context, id int) (*UserSingleResponse, *Response, error) {
+	return s.Update(ctx, id, &UserUpdateOptions{ActiveFlag: false})
+}
+
 // DeletePermissionSetAssignmentOptions specifices the optional parameters to the
 // UsersService.DeletePermissionSetAssignment method.
 type DeletePermissionSetAssignmentOptions struct {
@@ -403,3 +589,11 @@ func (s *UsersService) DeleteRoleAssignment(ctx context.Context, id int, opt *De
 
 	return resp, nil
 }
+
+// ListConnections returns the external accounts, such as Google, linked
+// to the authorized user. It is the same as UserConnectionsService.List.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/UserConnections/get_userConnections
+func (s *UsersService) ListConnections(ctx context.Context) (*UserConnections, *Response, error) {
+	return (*UserConnectionsService)(s).List(ctx)
+}
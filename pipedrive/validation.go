@@ -0,0 +1,89 @@
+package pipedrive
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Validator is implemented by options which can be checked before a
+// request is sent. NewRequest validates bodies and query options
+// implementing it, so invalid options fail locally instead of with an
+// API error.
+type Validator interface {
+	Validate() error
+}
+
+// ValidationError occurs when options fail validation before a request
+// is sent.
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %v: %v", e.Field, e.Message)
+}
+
+// validate validates v when it implements Validator.
+func validate(v interface{}) error {
+	if value := reflect.ValueOf(v); value.Kind() == reflect.Ptr && value.IsNil() {
+		return nil
+	}
+
+	if validator, ok := v.(Validator); ok {
+		return validator.Validate()
+	}
+
+	return nil
+}
+
+func requiredError(field string) error {
+	return &ValidationError{Field: field, Message: "is required"}
+}
+
+func exclusiveError(field, other string) error {
+	return &ValidationError{Field: field, Message: fmt.Sprintf("can not be used together with %v", other)}
+}
+
+func enumError(field string, value interface{}) error {
+	return &ValidationError{Field: field, Message: fmt.Sprintf("unknown value %q", fmt.Sprint(value))}
+}
+
+func lengthError(field string, max int) error {
+	return &ValidationError{Field: field, Message: fmt.Sprintf("is longer than %v characters", max)}
+}
+
+func validateVisibleTo(visibleTo VisibleTo) error {
+	switch visibleTo {
+	case 0, VisibleToOwnersFollowers, VisibleToEntireCompany,
+		VisibleToOwnersVisibilityGroup, VisibleToOwnersVisibilityGroupAndSubgroups:
+		return nil
+	}
+
+	return enumError("visible_to", visibleTo)
+}
+
+// visibleToValue returns the visibility v points to, or 0 if it is unset.
+func visibleToValue(v *VisibleTo) VisibleTo {
+	if v == nil {
+		return 0
+	}
+
+	return *v
+}
+
+func validateFieldCreate(name string, fieldType FieldType, options []Option) error {
+	if name == "" {
+		return requiredError("name")
+	}
+
+	if fieldType == "" {
+		return requiredError("field_type")
+	}
+
+	if fieldType.HasOptions() && len(options) == 0 {
+		return requiredError("options")
+	}
+
+	return nil
+}
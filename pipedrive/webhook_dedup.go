@@ -0,0 +1,119 @@
+package pipedrive
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+const defaultDedupTTL = 24 * time.Hour
+
+// DedupStore records the keys of the events a Deduplicator has seen. It
+// must be safe for concurrent use. Share a store between processes, for
+// example one backed by Redis, to deduplicate across them.
+type DedupStore interface {
+	// Add records the key until it expires and reports whether it was
+	// not recorded yet.
+	Add(key string, expires time.Time) (bool, error)
+
+	// Remove forgets the key, so the event is accepted again.
+	Remove(key string) error
+}
+
+// Deduplicator recognizes webhook events that Pipedrive delivered before.
+// Events are identified by object, ID, action and timestamp.
+type Deduplicator struct {
+	Store DedupStore
+
+	// TTL is how long delivered events are remembered, 24 hours when
+	// zero. Redeliveries after that are not recognized.
+	TTL time.Duration
+}
+
+// NewDeduplicator returns a Deduplicator with the store, an in-memory
+// store when nil.
+func NewDeduplicator(store DedupStore) *Deduplicator {
+	if store == nil {
+		store = NewMemoryDedupStore()
+	}
+
+	return &Deduplicator{Store: store}
+}
+
+// Key returns the key identifying the event.
+func (d *Deduplicator) Key(event *Event) string {
+	timestamp := event.Meta.TimestampMicro
+
+	if timestamp == 0 {
+		timestamp = event.Meta.Timestamp
+	}
+
+	return fmt.Sprintf("%v:%v:%v:%v", event.Meta.Object, event.Meta.ID, event.Meta.Action, timestamp)
+}
+
+// Duplicate records the event and reports whether it was seen before.
+func (d *Deduplicator) Duplicate(event *Event) (bool, error) {
+	ttl := d.TTL
+
+	if ttl <= 0 {
+		ttl = defaultDedupTTL
+	}
+
+	added, err := d.Store.Add(d.Key(event), time.Now().Add(ttl))
+
+	return !added, err
+}
+
+// Forget removes the event from the store, for events that could not be
+// processed and are to be accepted when delivered again.
+func (d *Deduplicator) Forget(event *Event) error {
+	return d.Store.Remove(d.Key(event))
+}
+
+// MemoryDedupStore is a DedupStore in memory. Expired keys are removed
+// as new keys are added.
+type MemoryDedupStore struct {
+	mu    sync.Mutex
+	keys  map[string]time.Time
+	swept time.Time
+}
+
+// NewMemoryDedupStore returns an empty MemoryDedupStore.
+func NewMemoryDedupStore() *MemoryDedupStore {
+	return &MemoryDedupStore{keys: make(map[string]time.Time)}
+}
+
+// Add implements DedupStore.
+func (s *MemoryDedupStore) Add(key string, expires time.Time) (bool, error) {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.swept) > time.Minute {
+		for k, at := range s.keys {
+			if now.After(at) {
+				delete(s.keys, k)
+			}
+		}
+
+		s.swept = now
+	}
+
+	if at, ok := s.keys[key]; ok && now.Before(at) {
+		return false, nil
+	}
+
+	s.keys[key] = expires
+
+	return true, nil
+}
+
+// Remove implements DedupStore.
+func (s *MemoryDedupStore) Remove(key string) error {
+	s.mu.Lock()
+	delete(s.keys, key)
+	s.mu.Unlock()
+
+	return nil
+}
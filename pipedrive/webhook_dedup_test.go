@@ -0,0 +1,163 @@
+package pipedrive
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDeduplicator_Key(t *testing.T) {
+	d := NewDeduplicator(nil)
+
+	tests := []struct {
+		meta EventMeta
+		want string
+	}{
+		{meta: EventMeta{Object: OBJECT_DEAL, ID: 1, Action: ACTION_UPDATED, Timestamp: 1600000000}, want: "deal:1:updated:1600000000"},
+		{meta: EventMeta{Object: OBJECT_DEAL, ID: 1, Action: ACTION_UPDATED, Timestamp: 1600000000, TimestampMicro: 1600000000000001}, want: "deal:1:updated:1600000000000001"},
+	}
+
+	for _, tt := range tests {
+		if got := d.Key(&Event{Meta: tt.meta}); got != tt.want {
+			t.Errorf("Key of %+v returned %v, want %v", tt.meta, got, tt.want)
+		}
+	}
+}
+
+func TestDeduplicator_Duplicate(t *testing.T) {
+	d := NewDeduplicator(nil)
+	event := testEvent(1)
+
+	for i, want := range []bool{false, true, true} {
+		if duplicate, err := d.Duplicate(event); err != nil || duplicate != want {
+			t.Errorf("Duplicate #%v returned %v, %v, want %v", i, duplicate, err, want)
+		}
+	}
+
+	if duplicate, _ := d.Duplicate(testEvent(2)); duplicate {
+		t.Error("Duplicate recognized another event")
+	}
+
+	d.Forget(event)
+
+	if duplicate, _ := d.Duplicate(event); duplicate {
+		t.Error("Duplicate recognized a forgotten event")
+	}
+}
+
+func TestDeduplicator_Duplicate_expired(t *testing.T) {
+	d := NewDeduplicator(nil)
+	d.TTL = time.Millisecond
+
+	d.Duplicate(testEvent(1))
+	time.Sleep(5 * time.Millisecond)
+
+	if duplicate, _ := d.Duplicate(testEvent(1)); duplicate {
+		t.Error("Duplicate recognized an expired event")
+	}
+}
+
+func TestDeduplicator_Duplicate_concurrent(t *testing.T) {
+	d := NewDeduplicator(nil)
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		accepted int
+	)
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if duplicate, _ := d.Duplicate(testEvent(1)); !duplicate {
+				mu.Lock()
+				accepted++
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if accepted != 1 {
+		t.Errorf("Concurrent deliveries were accepted %v times, want once", accepted)
+	}
+}
+
+type failingDedupStore struct{}
+
+func (failingDedupStore) Add(key string, expires time.Time) (bool, error) {
+	return false, errors.New("store unavailable")
+}
+
+func (failingDedupStore) Remove(key string) error {
+	return nil
+}
+
+func TestWebhookHandler_duplicateDelivery(t *testing.T) {
+	h := NewWebhookHandler()
+	h.Deduplicator = NewDeduplicator(nil)
+
+	events, _ := h.Subscribe(context.Background())
+
+	for i := 0; i < 2; i++ {
+		r := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(testEventBody))
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		if w.Code != http.StatusOK {
+			t.Errorf("Delivery #%v answered %v, want %v", i, w.Code, http.StatusOK)
+		}
+	}
+
+	if len(events) != 1 {
+		t.Errorf("Subscriber received %v events, want the duplicate dropped", len(events))
+	}
+}
+
+func TestWebhookHandler_duplicateDelivery_rejected(t *testing.T) {
+	h := NewWebhookHandler()
+	h.BufferSize = 1
+	h.Backpressure = BackpressureReject
+	h.Deduplicator = NewDeduplicator(nil)
+
+	events, _ := h.Subscribe(context.Background())
+	h.Publish(context.Background(), testEvent(1))
+
+	if err := h.Publish(context.Background(), testEvent(2)); err != errSubscriberFull {
+		t.Fatalf("Publish to a full subscriber returned %v, want %v", err, errSubscriberFull)
+	}
+
+	<-events
+
+	if err := h.Publish(context.Background(), testEvent(2)); err != nil {
+		t.Errorf("Redelivery of a rejected event returned %v, want it accepted", err)
+	}
+
+	if event, _ := receive(t, events); event.Meta.ID != 2 {
+		t.Errorf("Subscriber received event %v, want the redelivered 2", event.Meta.ID)
+	}
+}
+
+func TestWebhookHandler_duplicateDelivery_storeError(t *testing.T) {
+	h := NewWebhookHandler()
+	h.Deduplicator = NewDeduplicator(failingDedupStore{})
+
+	events, _ := h.Subscribe(context.Background())
+
+	if err := h.Publish(context.Background(), testEvent(1)); err == nil {
+		t.Error("Publish expected error when the store fails")
+	}
+
+	if len(events) != 0 {
+		t.Errorf("Subscriber received %v events, want none", len(events))
+	}
+}
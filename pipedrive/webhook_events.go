@@ -0,0 +1,83 @@
+package pipedrive
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// EventMeta represents the meta data of a webhook event.
+type EventMeta struct {
+	Action         EventAction `json:"action"`
+	Object         EventObject `json:"object"`
+	ID             int         `json:"id"`
+	CompanyID      int         `json:"company_id"`
+	UserID         int         `json:"user_id"`
+	Host           string      `json:"host"`
+	Timestamp      int64       `json:"timestamp"`
+	TimestampMicro int64       `json:"timestamp_micro"`
+	PermittedUsers []int       `json:"permitted_user_ids"`
+	IsBulkUpdate   bool        `json:"is_bulk_update"`
+	MatchesFilters struct {
+		Current  []int `json:"current"`
+		Previous []int `json:"previous"`
+	} `json:"matches_filters"`
+}
+
+func (m EventMeta) String() string {
+	return Stringify(m)
+}
+
+// Time returns the time the event happened.
+func (m EventMeta) Time() time.Time {
+	if m.TimestampMicro > 0 {
+		return time.Unix(0, m.TimestampMicro*int64(time.Microsecond))
+	}
+
+	return time.Unix(m.Timestamp, 0)
+}
+
+// Event represents a webhook event as delivered by Pipedrive.
+type Event struct {
+	Version  string          `json:"v"`
+	Event    string          `json:"event"`
+	Retry    int             `json:"retry"`
+	Meta     EventMeta       `json:"meta"`
+	Current  json.RawMessage `json:"current"`
+	Previous json.RawMessage `json:"previous"`
+}
+
+func (e Event) String() string {
+	return Stringify(e)
+}
+
+// Action returns the action of the event.
+func (e Event) Action() EventAction {
+	return e.Meta.Action
+}
+
+// Object returns the object of the event.
+func (e Event) Object() EventObject {
+	return e.Meta.Object
+}
+
+// DecodeCurrent decodes the object after the event into v, for example a
+// *Deal for deal events. Deleted objects have no current state and leave
+// v unchanged.
+func (e Event) DecodeCurrent(v interface{}) error {
+	return decodeEventObject(e.Current, v)
+}
+
+// DecodePrevious decodes the object before the event into v. Added objects
+// have no previous state and leave v unchanged.
+func (e Event) DecodePrevious(v interface{}) error {
+	return decodeEventObject(e.Previous, v)
+}
+
+func decodeEventObject(data json.RawMessage, v interface{}) error {
+	if data = bytes.TrimSpace(data); len(data) == 0 || string(data) == "null" {
+		return nil
+	}
+
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,370 @@
+package pipedrive
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+)
+
+const defaultEventBufferSize = 100
+
+// Backpressure decides what a WebhookHandler does when a subscriber does
+// not keep up with the events.
+type Backpressure int
+
+// Backpressure constants.
+const (
+	// BackpressureBlock holds the webhook request until the subscriber has
+	// room. When Pipedrive gives up on the request, it redelivers the
+	// event later.
+	BackpressureBlock Backpressure = iota
+
+	// BackpressureReject answers the webhook request with 503 Service
+	// Unavailable, so Pipedrive redelivers the event later. Subscribers
+	// that had room receive the event again.
+	BackpressureReject
+
+	// BackpressureDrop drops the event for the subscriber.
+	BackpressureDrop
+)
+
+// EventFilter reports whether a subscriber wants an event.
+type EventFilter func(*Event) bool
+
+// FilterActions returns a filter accepting events with any of the actions.
+func FilterActions(actions ...EventAction) EventFilter {
+	return func(e *Event) bool {
+		for _, action := range actions {
+			if action == ACTION_ALL || action == e.Meta.Action {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// FilterObjects returns a filter accepting events on any of the objects.
+func FilterObjects(objects ...EventObject) EventFilter {
+	return func(e *Event) bool {
+		for _, object := range objects {
+			if object == OBJECT_ALL || object == e.Meta.Object {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// errSubscriberFull is returned when an event can not be delivered under
+// BackpressureReject, or the webhook request ends under BackpressureBlock.
+var errSubscriberFull = errors.New("event subscriber is full")
+
+// ErrHandlerClosed is returned for events published, and subscriptions
+// made, after a WebhookHandler was shut down. ServeHTTP answers these
+// events with 503 Service Unavailable, so Pipedrive redelivers them later.
+var ErrHandlerClosed = errors.New("webhook handler closed")
+
+// WebhookHandler is an http.Handler receiving webhook events and
+// delivering them to the channels returned by Subscribe.
+type WebhookHandler struct {
+	// HTTPAuthUser and HTTPAuthPassword are checked against the basic auth
+	// credentials of the requests when set, they match the credentials
+	// the webhooks were created with.
+	HTTPAuthUser     string
+	HTTPAuthPassword string
+
+	// BufferSize is the number of events buffered per subscriber, 100 when
+	// zero.
+	BufferSize int
+
+	// Backpressure decides what happens when the buffer of a subscriber
+	// is full.
+	Backpressure Backpressure
+
+	// Deduplicator, if set, drops events that were delivered before. An
+	// event that can not be delivered to every subscriber is forgotten,
+	// so it is accepted when Pipedrive redelivers it.
+	Deduplicator *Deduplicator
+
+	mu            sync.Mutex
+	subscriptions map[*eventSubscription]struct{}
+	closed        bool
+	publishing    sync.WaitGroup
+}
+
+// NewWebhookHandler returns a WebhookHandler.
+func NewWebhookHandler() *WebhookHandler {
+	return &WebhookHandler{}
+}
+
+type eventSubscription struct {
+	ctx     context.Context
+	filters []EventFilter
+	events  chan Event
+	stop    chan struct{}
+	once    sync.Once
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+// Subscribe returns a channel receiving the events that pass all filters.
+// The channel is closed once ctx is done or the handler was shut down.
+func (h *WebhookHandler) Subscribe(ctx context.Context, filters ...EventFilter) (<-chan Event, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	size := h.BufferSize
+
+	if size <= 0 {
+		size = defaultEventBufferSize
+	}
+
+	s := &eventSubscription{
+		ctx:     ctx,
+		filters: filters,
+		events:  make(chan Event, size),
+		stop:    make(chan struct{}),
+	}
+
+	h.mu.Lock()
+
+	if h.closed {
+		h.mu.Unlock()
+		return nil, ErrHandlerClosed
+	}
+
+	if h.subscriptions == nil {
+		h.subscriptions = make(map[*eventSubscription]struct{})
+	}
+
+	h.subscriptions[s] = struct{}{}
+	h.mu.Unlock()
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-s.stop:
+		}
+
+		h.mu.Lock()
+		delete(h.subscriptions, s)
+		h.mu.Unlock()
+
+		s.close()
+	}()
+
+	return s.events, nil
+}
+
+// Start implements Lifecycle. A handler accepts events from the start,
+// Start accepts them again after Shutdown.
+func (h *WebhookHandler) Start(ctx context.Context) error {
+	h.mu.Lock()
+	h.closed = false
+	h.mu.Unlock()
+
+	return nil
+}
+
+// Shutdown implements Lifecycle. New events are rejected with
+// ErrHandlerClosed, the events being published are delivered and then
+// the channels of the subscribers are closed, so they can process the
+// buffered events before they stop. When ctx is done first, the events
+// still waiting for a subscriber are rejected, so Pipedrive redelivers
+// them.
+func (h *WebhookHandler) Shutdown(ctx context.Context) error {
+	h.mu.Lock()
+	h.closed = true
+	h.mu.Unlock()
+
+	published := make(chan struct{})
+
+	go func() {
+		h.publishing.Wait()
+		close(published)
+	}()
+
+	var err error
+
+	select {
+	case <-published:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	h.mu.Lock()
+	subscriptions := h.subscriptions
+	h.subscriptions = nil
+	h.mu.Unlock()
+
+	for s := range subscriptions {
+		s.close()
+	}
+
+	return err
+}
+
+// ServeHTTP decodes a webhook event and delivers it to the subscribers.
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if !h.authorized(r) {
+		w.Header().Set("WWW-Authenticate", `Basic realm="pipedrive"`)
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	var event Event
+
+	if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+		http.Error(w, "malformed event: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Publish(r.Context(), &event); err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// Publish delivers an event to the subscribers, as if it was received by
+// ServeHTTP. Under BackpressureBlock it waits until ctx is done at most.
+func (h *WebhookHandler) Publish(ctx context.Context, event *Event) error {
+	h.mu.Lock()
+
+	if h.closed {
+		h.mu.Unlock()
+		return ErrHandlerClosed
+	}
+
+	h.publishing.Add(1)
+	h.mu.Unlock()
+
+	defer h.publishing.Done()
+
+	if h.Deduplicator != nil {
+		duplicate, err := h.Deduplicator.Duplicate(event)
+
+		if err != nil || duplicate {
+			return err
+		}
+	}
+
+	err := h.publish(ctx, event)
+
+	if err != nil && h.Deduplicator != nil {
+		h.Deduplicator.Forget(event)
+	}
+
+	return err
+}
+
+func (h *WebhookHandler) publish(ctx context.Context, event *Event) error {
+	h.mu.Lock()
+	subscriptions := make([]*eventSubscription, 0, len(h.subscriptions))
+
+	for s := range h.subscriptions {
+		subscriptions = append(subscriptions, s)
+	}
+
+	h.mu.Unlock()
+
+	var err error
+
+	for _, s := range subscriptions {
+		if !s.accepts(event) {
+			continue
+		}
+
+		if deliverErr := s.deliver(ctx, *event, h.Backpressure); deliverErr != nil {
+			err = deliverErr
+		}
+	}
+
+	return err
+}
+
+func (h *WebhookHandler) authorized(r *http.Request) bool {
+	if h.HTTPAuthUser == "" && h.HTTPAuthPassword == "" {
+		return true
+	}
+
+	user, password, ok := r.BasicAuth()
+
+	if !ok {
+		return false
+	}
+
+	userMatches := subtle.ConstantTimeCompare([]byte(user), []byte(h.HTTPAuthUser)) == 1
+	passwordMatches := subtle.ConstantTimeCompare([]byte(password), []byte(h.HTTPAuthPassword)) == 1
+
+	return userMatches && passwordMatches
+}
+
+func (s *eventSubscription) accepts(event *Event) bool {
+	for _, filter := range s.filters {
+		if !filter(event) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// close stops deliveries to the subscription and closes its channel.
+func (s *eventSubscription) close() {
+	s.once.Do(func() {
+		close(s.stop)
+
+		s.mu.Lock()
+		s.closed = true
+		close(s.events)
+		s.mu.Unlock()
+	})
+}
+
+func (s *eventSubscription) deliver(ctx context.Context, event Event, backpressure Backpressure) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed {
+		return nil
+	}
+
+	switch backpressure {
+	case BackpressureReject, BackpressureDrop:
+		select {
+		case s.events <- event:
+		default:
+			if backpressure == BackpressureReject {
+				return errSubscriberFull
+			}
+		}
+	default:
+		select {
+		case s.events <- event:
+		case <-s.ctx.Done():
+		case <-s.stop:
+			if s.ctx.Err() == nil {
+				return ErrHandlerClosed
+			}
+		case <-ctx.Done():
+			return errSubscriberFull
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,311 @@
+package pipedrive
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testEventBody = `{"v":"1.0","event":"updated.deal","meta":{"action":"updated","object":"deal","id":1,"timestamp":1600000000},"current":{"id":1}}`
+
+func testEvent(id int) *Event {
+	return &Event{Meta: EventMeta{Action: ACTION_UPDATED, Object: OBJECT_DEAL, ID: id, Timestamp: 1600000000}}
+}
+
+// receive returns the next event on the channel, failing the test when
+// none arrives in time.
+func receive(t *testing.T, events <-chan Event) (Event, bool) {
+	select {
+	case event, ok := <-events:
+		return event, ok
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for an event")
+		return Event{}, false
+	}
+}
+
+func TestWebhookHandler_ServeHTTP(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		body     string
+		user     string
+		password string
+		want     int
+	}{
+		{name: "method", method: http.MethodGet, body: testEventBody, user: "user", password: "secret", want: http.StatusMethodNotAllowed},
+		{name: "no auth", method: http.MethodPost, body: testEventBody, want: http.StatusUnauthorized},
+		{name: "wrong password", method: http.MethodPost, body: testEventBody, user: "user", password: "wrong", want: http.StatusUnauthorized},
+		{name: "malformed", method: http.MethodPost, body: `{"meta":`, user: "user", password: "secret", want: http.StatusBadRequest},
+		{name: "delivered", method: http.MethodPost, body: testEventBody, user: "user", password: "secret", want: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		h := NewWebhookHandler()
+		h.HTTPAuthUser = "user"
+		h.HTTPAuthPassword = "secret"
+
+		ctx, cancel := context.WithCancel(context.Background())
+		deals, _ := h.Subscribe(ctx, FilterObjects(OBJECT_DEAL), FilterActions(ACTION_UPDATED))
+		persons, _ := h.Subscribe(ctx, FilterObjects(OBJECT_PERSON))
+
+		r := httptest.NewRequest(tt.method, "/webhooks", strings.NewReader(tt.body))
+
+		if tt.user != "" {
+			r.SetBasicAuth(tt.user, tt.password)
+		}
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		if w.Code != tt.want {
+			t.Errorf("%v: ServeHTTP answered %v, want %v", tt.name, w.Code, tt.want)
+		}
+
+		if len(deals) != 0 && tt.want != http.StatusOK {
+			t.Errorf("%v: deal subscriber received %v events, want none", tt.name, len(deals))
+		}
+
+		if tt.want == http.StatusOK {
+			if event, _ := receive(t, deals); event.Meta.ID != 1 || string(event.Current) != `{"id":1}` {
+				t.Errorf("%v: deal subscriber received %+v", tt.name, event)
+			}
+		}
+
+		if len(persons) != 0 {
+			t.Errorf("%v: person subscriber received %v events, want none", tt.name, len(persons))
+		}
+
+		cancel()
+	}
+}
+
+func TestWebhookHandler_Backpressure(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		backpressure Backpressure
+		ctx          context.Context
+		want         error
+		status       int
+	}{
+		{backpressure: BackpressureReject, ctx: context.Background(), want: errSubscriberFull, status: http.StatusServiceUnavailable},
+		{backpressure: BackpressureDrop, ctx: context.Background(), want: nil, status: http.StatusOK},
+		{backpressure: BackpressureBlock, ctx: cancelled, want: errSubscriberFull, status: http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		h := NewWebhookHandler()
+		h.BufferSize = 1
+		h.Backpressure = tt.backpressure
+
+		events, _ := h.Subscribe(context.Background())
+
+		if err := h.Publish(context.Background(), testEvent(1)); err != nil {
+			t.Fatalf("Publish with room returned error: %v", err)
+		}
+
+		if err := h.Publish(tt.ctx, testEvent(2)); err != tt.want {
+			t.Errorf("Publish under backpressure %v returned %v, want %v", tt.backpressure, err, tt.want)
+		}
+
+		r := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(testEventBody)).WithContext(tt.ctx)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		if w.Code != tt.status {
+			t.Errorf("ServeHTTP under backpressure %v answered %v, want %v", tt.backpressure, w.Code, tt.status)
+		}
+
+		if event, _ := receive(t, events); event.Meta.ID != 1 {
+			t.Errorf("Subscriber under backpressure %v received event %v, want 1", tt.backpressure, event.Meta.ID)
+		}
+
+		if len(events) != 0 {
+			t.Errorf("Subscriber under backpressure %v has %v events buffered, want none", tt.backpressure, len(events))
+		}
+
+		h.Shutdown(context.Background())
+	}
+}
+
+// publishBlocked subscribes with room for one event, fills it and
+// publishes the event, returning once that publish blocks on the
+// subscriber.
+func publishBlocked(t *testing.T, h *WebhookHandler, event *Event) (<-chan Event, <-chan error) {
+	entered := make(chan struct{})
+	errc := make(chan error, 1)
+
+	events, _ := h.Subscribe(context.Background(), func(e *Event) bool {
+		if e.Meta.ID == event.Meta.ID {
+			close(entered)
+		}
+
+		return true
+	})
+
+	if err := h.Publish(context.Background(), testEvent(1)); err != nil {
+		t.Fatalf("Publish with room returned error: %v", err)
+	}
+
+	go func() {
+		errc <- h.Publish(context.Background(), event)
+	}()
+
+	select {
+	case <-entered:
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the publish")
+	}
+
+	return events, errc
+}
+
+func waitClosed(t *testing.T, h *WebhookHandler) {
+	deadline := time.Now().Add(time.Second)
+
+	for {
+		h.mu.Lock()
+		closed := h.closed
+		h.mu.Unlock()
+
+		if closed {
+			return
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the shutdown")
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestWebhookHandler_Shutdown_drains(t *testing.T) {
+	h := NewWebhookHandler()
+	h.BufferSize = 1
+
+	events, published := publishBlocked(t, h, testEvent(2))
+
+	shutdown := make(chan error, 1)
+
+	go func() {
+		shutdown <- h.Shutdown(context.Background())
+	}()
+
+	waitClosed(t, h)
+
+	if err := h.Publish(context.Background(), testEvent(3)); err != ErrHandlerClosed {
+		t.Errorf("Publish after Shutdown returned %v, want %v", err, ErrHandlerClosed)
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(testEventBody))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("ServeHTTP after Shutdown answered %v, want %v", w.Code, http.StatusServiceUnavailable)
+	}
+
+	if _, err := h.Subscribe(context.Background()); err != ErrHandlerClosed {
+		t.Errorf("Subscribe after Shutdown returned %v, want %v", err, ErrHandlerClosed)
+	}
+
+	select {
+	case err := <-shutdown:
+		t.Fatalf("Shutdown returned %v before the publish was delivered", err)
+	default:
+	}
+
+	for _, want := range []int{1, 2} {
+		if event, ok := receive(t, events); !ok || event.Meta.ID != want {
+			t.Errorf("Subscriber received event %v (open %v), want %v", event.Meta.ID, ok, want)
+		}
+	}
+
+	if err := <-published; err != nil {
+		t.Errorf("Publish in flight returned error: %v", err)
+	}
+
+	if err := <-shutdown; err != nil {
+		t.Errorf("Shutdown returned error: %v", err)
+	}
+
+	if _, ok := receive(t, events); ok {
+		t.Error("Subscriber channel is open after Shutdown")
+	}
+}
+
+func TestWebhookHandler_Shutdown_timeout(t *testing.T) {
+	h := NewWebhookHandler()
+	h.BufferSize = 1
+
+	events, published := publishBlocked(t, h, testEvent(2))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := h.Shutdown(ctx); err != context.DeadlineExceeded {
+		t.Errorf("Shutdown returned %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	if err := <-published; err != ErrHandlerClosed {
+		t.Errorf("Publish in flight returned %v, want %v", err, ErrHandlerClosed)
+	}
+
+	if event, ok := receive(t, events); !ok || event.Meta.ID != 1 {
+		t.Errorf("Subscriber received event %v (open %v), want the buffered 1", event.Meta.ID, ok)
+	}
+
+	if _, ok := receive(t, events); ok {
+		t.Error("Subscriber channel is open after Shutdown")
+	}
+}
+
+func TestWebhookHandler_Start(t *testing.T) {
+	h := NewWebhookHandler()
+	h.Shutdown(context.Background())
+
+	if err := h.Start(context.Background()); err != nil {
+		t.Fatalf("Start returned error: %v", err)
+	}
+
+	events, err := h.Subscribe(context.Background())
+
+	if err != nil {
+		t.Fatalf("Subscribe after Start returned error: %v", err)
+	}
+
+	if err := h.Publish(context.Background(), testEvent(1)); err != nil {
+		t.Errorf("Publish after Start returned error: %v", err)
+	}
+
+	if event, _ := receive(t, events); event.Meta.ID != 1 {
+		t.Errorf("Subscriber received event %v, want 1", event.Meta.ID)
+	}
+}
+
+func TestWebhookHandler_Subscribe_cancel(t *testing.T) {
+	h := NewWebhookHandler()
+	h.Backpressure = BackpressureBlock
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, _ := h.Subscribe(ctx)
+	cancel()
+
+	if _, ok := receive(t, events); ok {
+		t.Error("Subscriber channel is open after its context was cancelled")
+	}
+
+	if err := h.Publish(context.Background(), testEvent(1)); err != nil {
+		t.Errorf("Publish without subscribers returned error: %v", err)
+	}
+
+	if _, err := h.Subscribe(ctx); err != context.Canceled {
+		t.Errorf("Subscribe with a cancelled context returned %v, want %v", err, context.Canceled)
+	}
+}
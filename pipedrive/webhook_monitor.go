@@ -0,0 +1,220 @@
+package pipedrive
+
+import (
+	"context"
+	"time"
+)
+
+const defaultWebhookMonitorInterval = 5 * time.Minute
+
+// WebhookProblem describes why a webhook needs attention.
+type WebhookProblem string
+
+// WebhookProblem constants.
+const (
+	// WebhookMissing means the webhook does not exist, for example
+	// because Pipedrive deleted it.
+	WebhookMissing WebhookProblem = "missing"
+
+	// WebhookInactive means Pipedrive stopped sending events to the
+	// webhook, usually after repeated delivery failures.
+	WebhookInactive WebhookProblem = "inactive"
+
+	// WebhookMoved means the webhook has the name of the subscription
+	// but another subscription URL.
+	WebhookMoved WebhookProblem = "moved"
+
+	// WebhookFailing means the last delivery was not answered with a
+	// 2xx status.
+	WebhookFailing WebhookProblem = "failing"
+
+	// WebhookSilent means nothing was delivered for longer than
+	// WebhookMonitor.MaxSilence.
+	WebhookSilent WebhookProblem = "silent"
+)
+
+// WebhookHealth represents the state of a monitored subscription.
+type WebhookHealth struct {
+	Subscription WebhooksCreateOptions
+
+	// Webhook is the webhook found for the subscription, nil when it is
+	// missing. After a repair it is the recreated webhook.
+	Webhook *Webhook
+
+	// Problem is empty when the webhook is healthy.
+	Problem WebhookProblem
+
+	// Repaired reports whether the webhook was recreated.
+	Repaired bool
+
+	// Err is the error of a failed repair.
+	Err error
+}
+
+// WebhookMonitor periodically checks that webhook subscriptions exist and
+// deliver events, and recreates missing, inactive and moved webhooks.
+type WebhookMonitor struct {
+	client        *Client
+	subscriptions []WebhooksCreateOptions
+
+	// Interval is the time between checks, 5 minutes when zero.
+	Interval time.Duration
+
+	// MaxSilence reports webhooks without a delivery for longer than this
+	// as silent. Zero disables the check, which suits rarely changing
+	// objects.
+	MaxSilence time.Duration
+
+	// Repair recreates missing, inactive and moved webhooks. Failing and
+	// silent webhooks are only reported, as recreating them does not fix
+	// the receiving end.
+	Repair bool
+
+	// OnProblem is called for every subscription with a problem.
+	OnProblem func(WebhookHealth)
+
+	lifecycle LifecycleRunner
+}
+
+// NewWebhookMonitor returns a WebhookMonitor for the given subscriptions.
+// Webhooks are matched to subscriptions like in
+// WebhooksService.EnsureWebhooks and EnsureSubscription, by event action,
+// event object and name, or subscription URL when the subscription has no
+// name.
+func (c *Client) NewWebhookMonitor(subscriptions ...WebhooksCreateOptions) *WebhookMonitor {
+	return &WebhookMonitor{
+		client:        c,
+		subscriptions: subscriptions,
+		Repair:        true,
+	}
+}
+
+// Start implements Lifecycle, it calls Run in the background.
+func (m *WebhookMonitor) Start(ctx context.Context) error {
+	return m.lifecycle.Start(ctx, m.Run)
+}
+
+// Shutdown implements Lifecycle. A running check, with its repairs, is
+// finished before Run returns nil.
+func (m *WebhookMonitor) Shutdown(ctx context.Context) error {
+	return m.lifecycle.Shutdown(ctx)
+}
+
+// Run checks the subscriptions every Interval until ctx is done, then
+// returns ctx.Err(). Errors listing the webhooks are retried on the next
+// check. After Shutdown, it returns nil.
+func (m *WebhookMonitor) Run(ctx context.Context) error {
+	interval := m.Interval
+
+	if interval <= 0 {
+		interval = defaultWebhookMonitorInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		m.Check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-m.lifecycle.Draining():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check checks the subscriptions once and returns their health.
+func (m *WebhookMonitor) Check(ctx context.Context) ([]WebhookHealth, error) {
+	webhooks, _, err := m.client.Webhooks.List(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	health := make([]WebhookHealth, len(m.subscriptions))
+
+	for i, subscription := range m.subscriptions {
+		health[i] = m.inspect(subscription, webhooks.Data)
+
+		if m.Repair && repairable(health[i].Problem) {
+			m.repair(ctx, &health[i])
+		}
+
+		if health[i].Problem != "" && m.OnProblem != nil {
+			m.OnProblem(health[i])
+		}
+	}
+
+	return health, nil
+}
+
+func (m *WebhookMonitor) inspect(subscription WebhooksCreateOptions, webhooks []Webhook) WebhookHealth {
+	health := WebhookHealth{Subscription: subscription, Problem: WebhookMissing}
+
+	for i := range webhooks {
+		webhook := webhooks[i]
+
+		if !webhookMatches(webhook, WebhookSpec(subscription)) {
+			continue
+		}
+
+		health.Webhook = &webhook
+		health.Problem = webhookProblem(&webhook, subscription, m.MaxSilence)
+
+		// Prefer an active webhook when there are duplicates.
+		if webhook.IsActive == 1 {
+			break
+		}
+	}
+
+	return health
+}
+
+func webhookProblem(webhook *Webhook, subscription WebhooksCreateOptions, maxSilence time.Duration) WebhookProblem {
+	switch {
+	case webhook.IsActive != 1:
+		return WebhookInactive
+	case webhook.SubscriptionURL != subscription.SubscriptionURL:
+		return WebhookMoved
+	case webhook.LastHTTPStatus != 0 && (webhook.LastHTTPStatus < 200 || webhook.LastHTTPStatus > 299):
+		return WebhookFailing
+	case maxSilence > 0 && !webhook.LastDeliveryTime.IsZero() && time.Since(webhook.LastDeliveryTime) > maxSilence:
+		return WebhookSilent
+	}
+
+	return ""
+}
+
+// repairable reports whether recreating the webhook fixes the problem.
+func repairable(problem WebhookProblem) bool {
+	return problem == WebhookMissing || problem == WebhookInactive || problem == WebhookMoved
+}
+
+// repair creates the webhook again, an inactive or moved webhook is
+// deleted first.
+func (m *WebhookMonitor) repair(ctx context.Context, health *WebhookHealth) {
+	if health.Webhook != nil {
+		if _, err := m.client.Webhooks.Delete(ctx, health.Webhook.ID); err != nil {
+			health.Err = err
+			return
+		}
+	}
+
+	subscription := health.Subscription
+	created, _, err := m.client.Webhooks.Create(ctx, &subscription)
+
+	if err == nil {
+		err = checkWebhookResponse(created)
+	}
+
+	if err != nil {
+		health.Err = err
+		return
+	}
+
+	health.Webhook = &created.Data
+	health.Repaired = true
+}
@@ -0,0 +1,65 @@
+package pipedrive
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestWebhookMonitor_Check(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var deleted []string
+
+	mux.HandleFunc("/v1/webhooks", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			writeJSON(w, http.StatusOK, `{"success": true, "data": {"id": 4, "name": "sync", "event_action": "updated", "event_object": "deal", "subscription_url": "https://example.com/new", "is_active": 1}}`)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, testWebhooks)
+	})
+	mux.HandleFunc("/v1/webhooks/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		deleted = append(deleted, r.URL.Path)
+		writeJSON(w, http.StatusOK, `{"success": true}`)
+	})
+
+	monitor := client.NewWebhookMonitor(
+		WebhooksCreateOptions{EventAction: ACTION_ADDED, EventObject: OBJECT_DEAL, SubscriptionURL: "https://example.com/deals"},
+		WebhooksCreateOptions{Name: "sync", EventAction: ACTION_UPDATED, EventObject: OBJECT_DEAL, SubscriptionURL: "https://example.com/new"},
+		WebhooksCreateOptions{EventAction: ACTION_DELETED, EventObject: OBJECT_DEAL, SubscriptionURL: "https://example.com/other"},
+	)
+	monitor.Repair = false
+
+	health, err := monitor.Check(context.Background())
+
+	if err != nil {
+		t.Fatalf("Check returned error: %v", err)
+	}
+
+	// Webhook 3 of another URL may belong to another integration.
+	want := []WebhookProblem{"", WebhookMoved, WebhookMissing}
+
+	for i := range want {
+		if health[i].Problem != want[i] {
+			t.Errorf("Check returned problem %q for subscription %v, want %q", health[i].Problem, i, want[i])
+		}
+	}
+
+	if len(deleted) != 0 {
+		t.Errorf("Check deleted %v without Repair", deleted)
+	}
+
+	monitor.Repair = true
+	health, _ = monitor.Check(context.Background())
+
+	if !health[1].Repaired || health[1].Webhook.ID != 4 {
+		t.Errorf("Check returned %+v, want the moved webhook recreated", health[1])
+	}
+
+	if len(deleted) != 1 || deleted[0] != "/v1/webhooks/2" {
+		t.Errorf("Check deleted %v, want the moved webhook 2", deleted)
+	}
+}
@@ -0,0 +1,220 @@
+package pipedrive
+
+import (
+	"context"
+	"strings"
+)
+
+// WebhookSpec describes a webhook that should exist, see
+// WebhooksService.EnsureWebhooks.
+type WebhookSpec WebhooksCreateOptions
+
+// EnsureWebhooksOptions specifices the optional parameters to the
+// WebhooksService.EnsureWebhooks method.
+type EnsureWebhooksOptions struct {
+	// Prefix selects the webhooks that are managed: webhooks whose
+	// subscription URL starts with it are deleted unless desired. When
+	// empty, only webhooks with the subscription URL or the name of a
+	// desired webhook are managed, so webhooks of other integrations are
+	// left alone. Set it to the common prefix of the URLs, such as
+	// https://example.com/, to also remove webhooks of URLs no longer in
+	// use.
+	Prefix string
+
+	// DryRun reports the changes without making them.
+	DryRun bool
+}
+
+// WebhookChanges reports what EnsureWebhooks did, or would do in a dry
+// run. Created webhooks of a dry run only hold the fields of their spec.
+type WebhookChanges struct {
+	Created   []Webhook
+	Updated   []Webhook
+	Deleted   []Webhook
+	Unchanged []Webhook
+}
+
+// Changed reports whether any webhook was created, updated or deleted.
+func (c *WebhookChanges) Changed() bool {
+	return len(c.Created)+len(c.Updated)+len(c.Deleted) > 0
+}
+
+// EnsureWebhooks converges the webhooks of the account to the desired
+// ones, so webhook setup can run on every start of an app. Each spec is
+// matched to a managed webhook with the same event action and object, and
+// the same subscription URL or, when the spec has one, name. Matching
+// webhooks are kept, or updated when they are inactive, point elsewhere
+// or the spec has credentials, which can not be compared. Missing webhooks
+// are created, and managed webhooks matching no spec, including
+// duplicates, are deleted.
+//
+// All specs are validated before any change is made.
+func (s *WebhooksService) EnsureWebhooks(ctx context.Context, desired []WebhookSpec, opt *EnsureWebhooksOptions) (*WebhookChanges, *Response, error) {
+	if opt == nil {
+		opt = &EnsureWebhooksOptions{}
+	}
+
+	for _, spec := range desired {
+		if err := WebhooksCreateOptions(spec).Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	webhooks, resp, err := s.List(ctx)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var managed []Webhook
+
+	for _, webhook := range webhooks.Data {
+		if webhookManaged(webhook, desired, opt.Prefix) {
+			managed = append(managed, webhook)
+		}
+	}
+
+	changes := &WebhookChanges{}
+	matched := make([]bool, len(managed))
+
+	for _, spec := range desired {
+		found := -1
+
+		for i, webhook := range managed {
+			if !matched[i] && webhookMatches(webhook, spec) {
+				found = i
+				break
+			}
+		}
+
+		var existing *Webhook
+
+		if found >= 0 {
+			matched[found] = true
+			existing = &managed[found]
+		}
+
+		webhook, changed, changeResp, err := s.ensureWebhook(ctx, existing, spec, opt.DryRun)
+
+		if changeResp != nil {
+			resp = changeResp
+		}
+
+		if err != nil {
+			return changes, resp, err
+		}
+
+		switch {
+		case existing == nil:
+			changes.Created = append(changes.Created, webhook)
+		case changed:
+			changes.Updated = append(changes.Updated, webhook)
+		default:
+			changes.Unchanged = append(changes.Unchanged, webhook)
+		}
+	}
+
+	for i, webhook := range managed {
+		if matched[i] {
+			continue
+		}
+
+		if !opt.DryRun {
+			if resp, err = s.Delete(ctx, webhook.ID); err != nil {
+				return changes, resp, err
+			}
+		}
+
+		changes.Deleted = append(changes.Deleted, webhook)
+	}
+
+	return changes, resp, nil
+}
+
+// ensureWebhook creates the webhook of the spec when existing is nil, or
+// updates existing when it is inactive, points elsewhere or the spec has
+// credentials, which can not be compared. It returns the webhook and
+// whether it was created or updated, along with the response of the
+// change, nil when none was made. In a dry run, no change is made and
+// created webhooks only hold the fields of their spec.
+func (s *WebhooksService) ensureWebhook(ctx context.Context, existing *Webhook, spec WebhookSpec, dryRun bool) (Webhook, bool, *Response, error) {
+	if existing == nil {
+		webhook := Webhook{
+			Name:            spec.Name,
+			EventAction:     string(spec.EventAction),
+			EventObject:     string(spec.EventObject),
+			SubscriptionURL: spec.SubscriptionURL,
+		}
+
+		if dryRun {
+			return webhook, true, nil, nil
+		}
+
+		options := WebhooksCreateOptions(spec)
+		created, resp, err := s.Create(ctx, &options)
+
+		if err == nil {
+			err = checkWebhookResponse(created)
+		}
+
+		if err != nil {
+			return Webhook{}, false, resp, err
+		}
+
+		return created.Data, true, resp, nil
+	}
+
+	if existing.SubscriptionURL == spec.SubscriptionURL && existing.IsActive == 1 && spec.HTTPAuthUser == "" {
+		return *existing, false, nil, nil
+	}
+
+	if dryRun {
+		return *existing, true, nil, nil
+	}
+
+	updated, resp, err := s.Update(ctx, existing.ID, &WebhooksUpdateOptions{
+		SubscriptionURL:  spec.SubscriptionURL,
+		Name:             spec.Name,
+		HTTPAuthUser:     spec.HTTPAuthUser,
+		HTTPAuthPassword: spec.HTTPAuthPassword,
+	})
+
+	if err == nil {
+		err = checkWebhookResponse(updated)
+	}
+
+	if err != nil {
+		return Webhook{}, false, resp, err
+	}
+
+	return updated.Data, true, resp, nil
+}
+
+// webhookManaged reports whether EnsureWebhooks may change the webhook.
+func webhookManaged(webhook Webhook, desired []WebhookSpec, prefix string) bool {
+	if prefix != "" {
+		return strings.HasPrefix(webhook.SubscriptionURL, prefix)
+	}
+
+	for _, spec := range desired {
+		if webhook.SubscriptionURL == spec.SubscriptionURL || spec.Name != "" && webhook.Name == spec.Name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// webhookMatches reports whether the webhook is the one described by the
+// spec, possibly with outdated settings.
+func webhookMatches(webhook Webhook, spec WebhookSpec) bool {
+	if EventAction(webhook.EventAction) != spec.EventAction || EventObject(webhook.EventObject) != spec.EventObject {
+		return false
+	}
+
+	if spec.Name != "" {
+		return webhook.Name == spec.Name
+	}
+
+	return webhook.SubscriptionURL == spec.SubscriptionURL
+}
@@ -0,0 +1,150 @@
+package pipedrive
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+const testWebhooks = `{"success": true, "data": [
+	{"id": 1, "event_action": "added", "event_object": "deal", "subscription_url": "https://example.com/deals", "is_active": 1},
+	{"id": 2, "name": "sync", "event_action": "updated", "event_object": "deal", "subscription_url": "https://example.com/old", "is_active": 1},
+	{"id": 3, "event_action": "deleted", "event_object": "deal", "subscription_url": "https://example.com/deleted", "is_active": 1}
+]}`
+
+func TestWebhooksService_EnsureWebhooks(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var deleted []string
+
+	mux.HandleFunc("/v1/webhooks", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			writeJSON(w, http.StatusOK, `{"success": true, "data": {"id": 4, "event_action": "added", "event_object": "person", "subscription_url": "https://example.com/persons"}}`)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, testWebhooks)
+	})
+	mux.HandleFunc("/v1/webhooks/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		deleted = append(deleted, r.URL.Path)
+		writeJSON(w, http.StatusOK, `{"success": true}`)
+	})
+
+	changes, _, err := client.Webhooks.EnsureWebhooks(context.Background(), []WebhookSpec{
+		{EventAction: ACTION_ADDED, EventObject: OBJECT_DEAL, SubscriptionURL: "https://example.com/deals"},
+		{EventAction: ACTION_ADDED, EventObject: OBJECT_PERSON, SubscriptionURL: "https://example.com/persons"},
+	}, &EnsureWebhooksOptions{Prefix: "https://example.com/"})
+
+	if err != nil {
+		t.Fatalf("EnsureWebhooks returned error: %v", err)
+	}
+
+	if len(changes.Unchanged) != 1 || changes.Unchanged[0].ID != 1 {
+		t.Errorf("EnsureWebhooks left %+v unchanged, want webhook 1", changes.Unchanged)
+	}
+
+	if len(changes.Created) != 1 || changes.Created[0].ID != 4 {
+		t.Errorf("EnsureWebhooks created %+v, want webhook 4", changes.Created)
+	}
+
+	if len(changes.Deleted) != 2 || len(deleted) != 2 {
+		t.Errorf("EnsureWebhooks deleted %+v with requests %v, want webhooks 2 and 3", changes.Deleted, deleted)
+	}
+}
+
+func TestWebhooksService_EnsureSubscription_update(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/webhooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		writeJSON(w, http.StatusOK, testWebhooks)
+	})
+	mux.HandleFunc("/api/v2/webhooks/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		writeJSON(w, http.StatusOK, `{"success": true, "data": {"id": 2, "event_action": "updated", "event_object": "deal", "subscription_url": "https://example.com/new", "is_active": 1}}`)
+	})
+
+	webhook, _, err := client.Webhooks.EnsureSubscription(context.Background(), &WebhooksCreateOptions{
+		Name:            "sync",
+		EventAction:     ACTION_UPDATED,
+		EventObject:     OBJECT_DEAL,
+		SubscriptionURL: "https://example.com/new",
+	})
+
+	if err != nil {
+		t.Fatalf("EnsureSubscription returned error: %v", err)
+	}
+
+	if webhook.ID != 2 || webhook.SubscriptionURL != "https://example.com/new" {
+		t.Errorf("EnsureSubscription returned %+v, want webhook 2 with the new URL", webhook)
+	}
+}
+
+func TestWebhooksService_EnsureSubscription_otherURL(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/webhooks", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			writeJSON(w, http.StatusOK, `{"success": true, "data": {"id": 4, "event_action": "updated", "event_object": "deal", "subscription_url": "https://example.com/new"}}`)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, testWebhooks)
+	})
+	mux.HandleFunc("/api/v2/webhooks/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("EnsureSubscription updated %v, the webhook of another URL", r.URL.Path)
+	})
+
+	// Without a name, webhook 2 of another URL may belong to another
+	// integration.
+	webhook, _, err := client.Webhooks.EnsureSubscription(context.Background(), &WebhooksCreateOptions{
+		EventAction:     ACTION_UPDATED,
+		EventObject:     OBJECT_DEAL,
+		SubscriptionURL: "https://example.com/new",
+	})
+
+	if err != nil {
+		t.Fatalf("EnsureSubscription returned error: %v", err)
+	}
+
+	if webhook.ID != 4 {
+		t.Errorf("EnsureSubscription returned %+v, want the created webhook 4", webhook)
+	}
+}
+
+func TestWebhooksService_EnsureSubscription_nilOptions(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	if _, _, err := client.Webhooks.EnsureSubscription(context.Background(), nil); err == nil {
+		t.Error("EnsureSubscription returned no error for nil options")
+	}
+}
+
+func TestWebhooksService_EnsureSubscription_noData(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/v1/webhooks", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			writeJSON(w, http.StatusOK, `{"success": true, "data": null}`)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, `{"success": true, "data": []}`)
+	})
+
+	_, _, err := client.Webhooks.EnsureSubscription(context.Background(), &WebhooksCreateOptions{
+		EventAction:     ACTION_ADDED,
+		EventObject:     OBJECT_DEAL,
+		SubscriptionURL: "https://example.com/deals",
+	})
+
+	if err == nil {
+		t.Error("EnsureSubscription returned no error for a response without a webhook")
+	}
+}
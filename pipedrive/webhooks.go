@@ -2,9 +2,13 @@ package pipedrive
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 // WebhooksService handles webhooks related
@@ -19,6 +23,7 @@ type Webhook struct {
 	CompanyID        int         `json:"company_id"`
 	OwnerID          int         `json:"owner_id"`
 	UserID           int         `json:"user_id"`
+	Name             string      `json:"name"`
 	EventAction      string      `json:"event_action"`
 	EventObject      string      `json:"event_object"`
 	SubscriptionURL  string      `json:"subscription_url"`
@@ -34,7 +39,11 @@ type Webhook struct {
 	AdminID          int         `json:"admin_id"`
 }
 
+// String returns the webhook with its credentials redacted, including
+// those in the subscription URL.
 func (w Webhook) String() string {
+	w.SubscriptionURL = DefaultRedactor.String(w.SubscriptionURL)
+
 	return Stringify(w)
 }
 
@@ -76,15 +85,70 @@ func (s *WebhooksService) List(ctx context.Context) (*WebhooksResponse, *Respons
 // WebhooksCreateOptions specifices the optional parameters to the
 // WebhooksService.Create method.
 type WebhooksCreateOptions struct {
-	SubscriptionURL  string      `url:"subscription_url"`
-	EventAction      EventAction `url:"event_action"`
-	DealProbability  EventObject `url:"event_object"`
-	UserID           uint        `url:"user_id"`
-	HTTPAuthUser     string      `url:"http_auth_user"`
-	HTTPAuthPassword string      `url:"http_auth_password"`
+	SubscriptionURL  string      `json:"subscription_url"`
+	EventAction      EventAction `json:"event_action"`
+	EventObject      EventObject `json:"event_object"`
+	Name             string      `json:"name,omitempty"`
+	UserID           uint        `json:"user_id,omitempty"`
+	HTTPAuthUser     string      `json:"http_auth_user,omitempty"`
+	HTTPAuthPassword string      `json:"http_auth_password,omitempty"`
+}
+
+// webhookAuthMaxLength is the longest HTTP auth user or password accepted
+// for webhooks.
+const webhookAuthMaxLength = 255
+
+// Validate checks that the subscription URL is an absolute https URL, that
+// the event action and object form a known combination and that the HTTP
+// auth credentials can be used for basic authentication.
+func (o WebhooksCreateOptions) Validate() error {
+	if o.SubscriptionURL == "" {
+		return requiredError("subscription_url")
+	}
+
+	subscriptionURL, err := url.Parse(o.SubscriptionURL)
+
+	if err != nil || subscriptionURL.Host == "" {
+		return &ValidationError{Field: "subscription_url", Message: fmt.Sprintf("%q is not an absolute URL", o.SubscriptionURL)}
+	}
+
+	if subscriptionURL.Scheme != "https" {
+		return &ValidationError{Field: "subscription_url", Message: fmt.Sprintf("scheme %q is not supported, use https", subscriptionURL.Scheme)}
+	}
+
+	if !o.EventAction.Valid() {
+		return enumError("event_action", o.EventAction)
+	}
+
+	if !o.EventObject.Valid() {
+		return enumError("event_object", o.EventObject)
+	}
+
+	if !o.EventAction.ValidFor(o.EventObject) {
+		return &ValidationError{Field: "event_action", Message: fmt.Sprintf("%q events are not sent for %q objects", o.EventAction, o.EventObject)}
+	}
+
+	if o.HTTPAuthPassword != "" && o.HTTPAuthUser == "" {
+		return requiredError("http_auth_user")
+	}
+
+	if strings.Contains(o.HTTPAuthUser, ":") {
+		return &ValidationError{Field: "http_auth_user", Message: "can not contain a colon"}
+	}
+
+	if utf8.RuneCountInString(o.HTTPAuthUser) > webhookAuthMaxLength {
+		return lengthError("http_auth_user", webhookAuthMaxLength)
+	}
+
+	if utf8.RuneCountInString(o.HTTPAuthPassword) > webhookAuthMaxLength {
+		return lengthError("http_auth_password", webhookAuthMaxLength)
+	}
+
+	return nil
 }
 
-// Create a webhook.
+// Create a webhook. The event action and object are validated before
+// the request is sent.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Webhooks/post_webhooks
 func (s *WebhooksService) Create(ctx context.Context, opt *WebhooksCreateOptions) (*WebhookResponse, *Response, error) {
@@ -105,6 +169,97 @@ func (s *WebhooksService) Create(ctx context.Context, opt *WebhooksCreateOptions
 	return record, resp, nil
 }
 
+// WebhooksUpdateOptions specifices the optional parameters to the
+// WebhooksService.Update method.
+type WebhooksUpdateOptions struct {
+	SubscriptionURL  string      `json:"subscription_url,omitempty"`
+	EventAction      EventAction `json:"event_action,omitempty"`
+	EventObject      EventObject `json:"event_object,omitempty"`
+	Name             string      `json:"name,omitempty"`
+	HTTPAuthUser     string      `json:"http_auth_user,omitempty"`
+	HTTPAuthPassword string      `json:"http_auth_password,omitempty"`
+}
+
+// Update a webhook. Updating is only available in v2 of the API.
+//
+// Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Webhooks
+func (s *WebhooksService) Update(ctx context.Context, id int, opt *WebhooksUpdateOptions) (*WebhookResponse, *Response, error) {
+	uri := fmt.Sprintf("/webhooks/%v", id)
+	req, err := s.client.newVersionedRequest(apiVersion2, http.MethodPatch, uri, nil, opt)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var record *WebhookResponse
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return record, resp, nil
+}
+
+// EnsureSubscription makes sure a webhook matching opt exists. A webhook
+// with the same event action, event object and name, or subscription URL
+// when opt has no name, is updated to the subscription URL and
+// credentials of opt when needed, like in EnsureWebhooks. Webhooks of
+// other integrations are therefore left alone. When there is no such
+// webhook, a new one is created.
+//
+// Webhooks with basic auth credentials in opt are always updated, as the
+// stored credentials can not be compared.
+func (s *WebhooksService) EnsureSubscription(ctx context.Context, opt *WebhooksCreateOptions) (*Webhook, *Response, error) {
+	if opt == nil {
+		return nil, nil, requiredError("subscription_url")
+	}
+
+	spec := WebhookSpec(*opt)
+
+	if err := opt.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	webhooks, resp, err := s.List(ctx)
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var existing *Webhook
+
+	for i, webhook := range webhooks.Data {
+		if webhookMatches(webhook, spec) {
+			existing = &webhooks.Data[i]
+			break
+		}
+	}
+
+	webhook, _, changeResp, err := s.ensureWebhook(ctx, existing, spec, false)
+
+	if changeResp != nil {
+		resp = changeResp
+	}
+
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &webhook, resp, nil
+}
+
+// checkWebhookResponse returns an error when the response of a create or
+// an update holds no webhook.
+func checkWebhookResponse(record *WebhookResponse) error {
+	if record == nil || record.Data.ID == 0 {
+		return errors.New("pipedrive: the response holds no webhook")
+	}
+
+	return nil
+}
+
 // Delete a webhook.
 //
 // Pipedrive API docs: https://developers.pipedrive.com/docs/api/v1/#!/Webhooks/delete_webhooks_id
@@ -0,0 +1,34 @@
+package syncer
+
+import "context"
+
+// Conflict represents a record changed on both sides since the last sync.
+// The fields of both records are keyed by Pipedrive field keys.
+type Conflict struct {
+	Pipedrive Record
+	External  Record
+}
+
+// ConflictResolver merges the records of a conflict into the fields that
+// are written to both sides.
+type ConflictResolver func(ctx context.Context, c *Conflict) (map[string]interface{}, error)
+
+// LastWriteWins keeps the record that was updated last, Pipedrive wins
+// ties.
+func LastWriteWins(ctx context.Context, c *Conflict) (map[string]interface{}, error) {
+	if c.External.UpdatedAt.After(c.Pipedrive.UpdatedAt) {
+		return c.External.Fields, nil
+	}
+
+	return c.Pipedrive.Fields, nil
+}
+
+// PreferPipedrive always keeps the Pipedrive record.
+func PreferPipedrive(ctx context.Context, c *Conflict) (map[string]interface{}, error) {
+	return c.Pipedrive.Fields, nil
+}
+
+// PreferExternal always keeps the external record.
+func PreferExternal(ctx context.Context, c *Conflict) (map[string]interface{}, error) {
+	return c.External.Fields, nil
+}
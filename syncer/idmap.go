@@ -0,0 +1,23 @@
+package syncer
+
+import "github.com/genert/pipedrive-api/idmap"
+
+// IDMap links the IDs of records in Pipedrive to the IDs of the same
+// records in the external store. To keep the links of several entities in
+// one idmap.Store, such as a SQL table, use idmap.For.
+type IDMap interface {
+	// ExternalID returns the external ID linked to a Pipedrive ID.
+	ExternalID(pipedriveID string) (string, bool, error)
+
+	// PipedriveID returns the Pipedrive ID linked to an external ID.
+	PipedriveID(externalID string) (string, bool, error)
+
+	// Link links a Pipedrive ID to an external ID.
+	Link(pipedriveID, externalID string) error
+}
+
+// NewMemoryIDMap returns an empty IDMap held in memory, an idmap.Memory
+// of a single entity.
+func NewMemoryIDMap() *idmap.EntityMap {
+	return idmap.For(idmap.NewMemory(), "")
+}
@@ -0,0 +1,167 @@
+package syncer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/genert/pipedrive-api/pipedrive"
+)
+
+const (
+	pipedriveTimeLayout = "2006-01-02 15:04:05"
+	recentsPageLimit    = 500
+)
+
+// PipedriveStore is the Pipedrive side of a sync, for one kind of entity.
+// Related objects, such as the owner in user_id, are reduced to their ID
+// so they can be written back.
+type PipedriveStore struct {
+	client   *pipedrive.Client
+	item     string
+	resource string
+}
+
+// NewPipedriveStore returns a Store for the entities of the given item,
+// for example "deal", "person", "organization", "product" or "activity".
+func NewPipedriveStore(client *pipedrive.Client, item string) *PipedriveStore {
+	resource := item + "s"
+
+	if item == "activity" {
+		resource = "activities"
+	}
+
+	return &PipedriveStore{client: client, item: item, resource: resource}
+}
+
+// Changes returns the entities changed after since, as listed by
+// RecentsService.List.
+func (s *PipedriveStore) Changes(ctx context.Context, since time.Time) ([]Record, error) {
+	opt := &pipedrive.RecentsListOptions{
+		SinceTimestamp: since.UTC().Format(pipedriveTimeLayout),
+		Items:          s.item,
+		Limit:          recentsPageLimit,
+	}
+
+	pager := s.client.NewPager("/recents", 0)
+
+	var records []Record
+
+	for {
+		opt.Start = pager.Start
+		page, _, err := s.client.Recents.List(ctx, opt)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, recent := range page.Data {
+			var fields map[string]interface{}
+
+			if err := json.Unmarshal(recent.Object, &fields); err != nil || fields == nil {
+				continue
+			}
+
+			records = append(records, s.record(fields))
+		}
+
+		more, err := pager.Next(page.AdditionalData.Pagination, len(page.Data))
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !more {
+			return records, nil
+		}
+	}
+}
+
+// Get returns an entity, nil when it does not exist.
+func (s *PipedriveStore) Get(ctx context.Context, id string) (*Record, error) {
+	req, err := s.client.NewRequest(http.MethodGet, fmt.Sprintf("/%v/%v", s.resource, id), nil, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var record struct {
+		Data map[string]interface{} `json:"data"`
+	}
+
+	resp, err := s.client.Do(ctx, req, &record)
+
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if record.Data == nil {
+		return nil, nil
+	}
+
+	r := s.record(record.Data)
+
+	return &r, nil
+}
+
+// Put creates or updates an entity.
+func (s *PipedriveStore) Put(ctx context.Context, record Record) (string, error) {
+	method, uri := http.MethodPost, "/"+s.resource
+
+	if record.ID != "" {
+		method, uri = http.MethodPut, fmt.Sprintf("/%v/%v", s.resource, record.ID)
+	}
+
+	req, err := s.client.NewRequest(method, uri, nil, record.Fields)
+
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		Data struct {
+			ID int `json:"id"`
+		} `json:"data"`
+	}
+
+	if _, err := s.client.Do(ctx, req, &result); err != nil {
+		return "", err
+	}
+
+	if result.Data.ID == 0 {
+		return record.ID, nil
+	}
+
+	return strconv.Itoa(result.Data.ID), nil
+}
+
+func (s *PipedriveStore) record(fields map[string]interface{}) Record {
+	for key, value := range fields {
+		if object, ok := value.(map[string]interface{}); ok {
+			if id, ok := object["value"]; ok {
+				fields[key] = id
+			} else if id, ok := object["id"]; ok {
+				fields[key] = id
+			}
+		}
+	}
+
+	record := Record{Fields: fields}
+
+	if id, ok := fields["id"].(float64); ok {
+		record.ID = strconv.FormatInt(int64(id), 10)
+	}
+
+	if updated, ok := fields["update_time"].(string); ok {
+		record.UpdatedAt, _ = time.Parse(pipedriveTimeLayout, updated)
+	}
+
+	return record
+}
@@ -0,0 +1,61 @@
+package syncer
+
+import (
+	"context"
+	"time"
+)
+
+const defaultInterval = time.Minute
+
+// Run syncs every Interval, beginning at Since, until ctx is done, then
+// returns ctx.Err(). After Shutdown, it returns nil once the running sync
+// finished. Records that failed are synced again by the next sync, as
+// Since is only advanced after a sync without errors.
+func (e *Engine) Run(ctx context.Context) error {
+	interval := e.Interval
+
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+
+	drain := e.lifecycle.Draining()
+
+	for {
+		started := time.Now()
+		result, err := e.Sync(ctx, e.Since)
+
+		if err == nil && len(result.Errors) == 0 {
+			e.Since = started
+		}
+
+		if e.OnSync != nil {
+			e.OnSync(result, err)
+		}
+
+		timer := time.NewTimer(interval)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-drain:
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+	}
+}
+
+// Start implements pipedrive.Lifecycle, it calls Run in the background.
+// An engine is started once, pipedrive.ErrStarted is returned after.
+func (e *Engine) Start(ctx context.Context) error {
+	return e.lifecycle.Start(ctx, e.Run)
+}
+
+// Shutdown implements pipedrive.Lifecycle. The running sync is finished,
+// so the writes it started complete and their IDs are linked, and no
+// further sync is started. When ctx is done first, the sync is canceled
+// between records and ctx.Err() is returned.
+func (e *Engine) Shutdown(ctx context.Context) error {
+	return e.lifecycle.Shutdown(ctx)
+}
@@ -0,0 +1,327 @@
+// Package syncer keeps Pipedrive entities and an external store aligned in
+// both directions. Changes on either side are written to the other one,
+// records changed on both sides are merged by a ConflictResolver.
+package syncer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/genert/pipedrive-api/pipedrive"
+)
+
+// Record represents an entity on one side of the sync.
+type Record struct {
+	// ID is the ID of the record in its own system, empty for records
+	// that are to be created.
+	ID        string
+	UpdatedAt time.Time
+	Fields    map[string]interface{}
+}
+
+// Store is one side of the sync.
+type Store interface {
+	// Changes returns the records changed after since.
+	Changes(ctx context.Context, since time.Time) ([]Record, error)
+
+	// Get returns a record, nil when it does not exist.
+	Get(ctx context.Context, id string) (*Record, error)
+
+	// Put creates the record when its ID is empty and updates it
+	// otherwise. It returns the ID of the record.
+	Put(ctx context.Context, record Record) (string, error)
+}
+
+// FieldMap maps Pipedrive field keys to the field names of the external
+// store. Fields that are not mapped are not synced.
+type FieldMap map[string]string
+
+// toExternal renames Pipedrive fields to external ones.
+func (m FieldMap) toExternal(fields map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(m))
+
+	for key, name := range m {
+		if value, ok := fields[key]; ok {
+			result[name] = value
+		}
+	}
+
+	return result
+}
+
+// mapped returns the mapped Pipedrive fields.
+func (m FieldMap) mapped(fields map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(m))
+
+	for key := range m {
+		if value, ok := fields[key]; ok {
+			result[key] = value
+		}
+	}
+
+	return result
+}
+
+// toPipedrive renames external fields to Pipedrive ones.
+func (m FieldMap) toPipedrive(fields map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(m))
+
+	for key, name := range m {
+		if value, ok := fields[name]; ok {
+			result[key] = value
+		}
+	}
+
+	return result
+}
+
+// Result summarizes a sync run.
+type Result struct {
+	ToExternal  int
+	ToPipedrive int
+	Conflicts   int
+	Unchanged   int
+
+	// Errors holds the errors of records that failed, the other records
+	// are synced regardless.
+	Errors []error
+}
+
+// Engine syncs one kind of entity between Pipedrive and an external store.
+type Engine struct {
+	Pipedrive Store
+	External  Store
+	IDs       IDMap
+	Fields    FieldMap
+
+	// Resolve merges records changed on both sides, LastWriteWins when
+	// nil.
+	Resolve ConflictResolver
+
+	// Since is the time the next sync of Run fetches the changes from.
+	// Run advances it after every sync without errors.
+	Since time.Time
+
+	// Interval is the time between the syncs of Run, 1 minute when zero.
+	Interval time.Duration
+
+	// OnSync, if set, is called with the result of every sync of Run.
+	OnSync func(*Result, error)
+
+	lifecycle pipedrive.LifecycleRunner
+}
+
+// Sync writes the changes made after since on either side to the other
+// side. Writes that would not change the target are skipped, so the
+// changes written by one run are not written back by the next one.
+func (e *Engine) Sync(ctx context.Context, since time.Time) (*Result, error) {
+	pipedriveChanges, err := e.Pipedrive.Changes(ctx, since)
+
+	if err != nil {
+		return nil, err
+	}
+
+	externalChanges, err := e.External.Changes(ctx, since)
+
+	if err != nil {
+		return nil, err
+	}
+
+	external := make(map[string]*Record, len(externalChanges))
+
+	for i := range externalChanges {
+		external[externalChanges[i].ID] = &externalChanges[i]
+	}
+
+	result := &Result{}
+
+	for i := range pipedriveChanges {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		p := &pipedriveChanges[i]
+		externalID, linked, err := e.IDs.ExternalID(p.ID)
+
+		if err != nil {
+			result.Errors = append(result.Errors, err)
+			continue
+		}
+
+		if x, changed := external[externalID]; linked && changed {
+			delete(external, externalID)
+
+			if err := e.resolve(ctx, p, x, result); err != nil {
+				result.Errors = append(result.Errors, fmt.Errorf("pipedrive %v: %v", p.ID, err))
+			}
+
+			continue
+		}
+
+		if err := e.toExternal(ctx, p, externalID, result); err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("pipedrive %v: %v", p.ID, err))
+		}
+	}
+
+	for i := range externalChanges {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		x := &externalChanges[i]
+
+		if _, pending := external[x.ID]; !pending {
+			continue
+		}
+
+		if err := e.toPipedrive(ctx, x, result); err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("external %v: %v", x.ID, err))
+		}
+	}
+
+	return result, nil
+}
+
+func (e *Engine) toExternal(ctx context.Context, p *Record, externalID string, result *Result) error {
+	fields := e.Fields.toExternal(p.Fields)
+	written, err := e.put(ctx, e.External, externalID, fields)
+
+	if err != nil {
+		return err
+	}
+
+	if written == "" {
+		result.Unchanged++
+		return nil
+	}
+
+	result.ToExternal++
+
+	if externalID == "" {
+		return e.IDs.Link(p.ID, written)
+	}
+
+	return nil
+}
+
+func (e *Engine) toPipedrive(ctx context.Context, x *Record, result *Result) error {
+	pipedriveID, _, err := e.IDs.PipedriveID(x.ID)
+
+	if err != nil {
+		return err
+	}
+
+	fields := e.Fields.toPipedrive(x.Fields)
+	written, err := e.put(ctx, e.Pipedrive, pipedriveID, fields)
+
+	if err != nil {
+		return err
+	}
+
+	if written == "" {
+		result.Unchanged++
+		return nil
+	}
+
+	result.ToPipedrive++
+
+	if pipedriveID == "" {
+		return e.IDs.Link(written, x.ID)
+	}
+
+	return nil
+}
+
+func (e *Engine) resolve(ctx context.Context, p, x *Record, result *Result) error {
+	resolve := e.Resolve
+
+	if resolve == nil {
+		resolve = LastWriteWins
+	}
+
+	// Both records are compared by their Pipedrive field keys.
+	external := Record{ID: x.ID, UpdatedAt: x.UpdatedAt, Fields: e.Fields.toPipedrive(x.Fields)}
+	pipedrive := Record{ID: p.ID, UpdatedAt: p.UpdatedAt, Fields: e.Fields.mapped(p.Fields)}
+
+	if equalFields(pipedrive.Fields, external.Fields) {
+		result.Unchanged++
+		return nil
+	}
+
+	result.Conflicts++
+
+	merged, err := resolve(ctx, &Conflict{Pipedrive: pipedrive, External: external})
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := e.put(ctx, e.Pipedrive, p.ID, merged); err != nil {
+		return err
+	}
+
+	_, err = e.put(ctx, e.External, x.ID, e.Fields.toExternal(merged))
+
+	return err
+}
+
+// put writes fields to the record with the given ID, creating it when id
+// is empty. It returns the ID written, empty when the record already has
+// the fields.
+func (e *Engine) put(ctx context.Context, store Store, id string, fields map[string]interface{}) (string, error) {
+	if id != "" {
+		current, err := store.Get(ctx, id)
+
+		if err != nil {
+			return "", err
+		}
+
+		if current != nil && containsFields(current.Fields, fields) {
+			return "", nil
+		}
+	}
+
+	return store.Put(ctx, Record{ID: id, Fields: fields})
+}
+
+// containsFields reports whether record has all fields with equal values.
+func containsFields(record, fields map[string]interface{}) bool {
+	for key, value := range fields {
+		current, ok := record[key]
+
+		if !ok || !equalValues(current, value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func equalFields(a, b map[string]interface{}) bool {
+	return len(a) == len(b) && containsFields(a, b)
+}
+
+// equalValues compares values by their JSON encoding, so numbers of
+// different types compare equal.
+func equalValues(a, b interface{}) bool {
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
+
+func normalize(v interface{}) interface{} {
+	data, err := json.Marshal(v)
+
+	if err != nil {
+		return v
+	}
+
+	var normalized interface{}
+
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return v
+	}
+
+	return normalized
+}
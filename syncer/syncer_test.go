@@ -0,0 +1,290 @@
+package syncer
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/genert/pipedrive-api/pipedrive"
+)
+
+// setup starts a test server answering the requests of the returned
+// client with mux.
+func setup() (client *pipedrive.Client, mux *http.ServeMux, teardown func()) {
+	mux = http.NewServeMux()
+	server := httptest.NewTLSServer(mux)
+
+	client = pipedrive.NewClient(&pipedrive.Config{APIKey: "token"})
+	client.BaseURL = &url.URL{Path: strings.TrimPrefix(server.URL, "https://") + "/"}
+	client.SetOptions(pipedrive.WithHTTPClient(&http.Client{
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}},
+	}))
+
+	return client, mux, server.Close
+}
+
+// memoryStore is an external Store in memory.
+type memoryStore struct {
+	mu      sync.Mutex
+	records map[string]Record
+	changed []string
+	puts    int
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{records: make(map[string]Record)}
+}
+
+func (s *memoryStore) Changes(ctx context.Context, since time.Time) ([]Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var records []Record
+
+	for _, id := range s.changed {
+		records = append(records, s.records[id])
+	}
+
+	return records, nil
+}
+
+func (s *memoryStore) Get(ctx context.Context, id string) (*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.records[id]
+
+	if !ok {
+		return nil, nil
+	}
+
+	return &record, nil
+}
+
+func (s *memoryStore) Put(ctx context.Context, record Record) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.puts++
+
+	if record.ID == "" {
+		record.ID = fmt.Sprintf("x%v", len(s.records)+1)
+	}
+
+	s.records[record.ID] = record
+
+	return record.ID, nil
+}
+
+// deals serves the deals of a test account, the deals in changed are
+// listed by /recents.
+type deals struct {
+	mu      sync.Mutex
+	deals   map[int]map[string]interface{}
+	changed []int
+}
+
+func (d *deals) register(mux *http.ServeMux) {
+	mux.HandleFunc("/v1/recents", func(w http.ResponseWriter, r *http.Request) {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+
+		var recents []interface{}
+
+		for _, id := range d.changed {
+			recents = append(recents, map[string]interface{}{"item": "deal", "id": id, "data": d.deals[id]})
+		}
+
+		writeData(w, recents)
+	})
+	mux.HandleFunc("/v1/deals/", func(w http.ResponseWriter, r *http.Request) {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+
+		id, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/v1/deals/"))
+		deal, ok := d.deals[id]
+
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if r.Method == http.MethodPut {
+			var fields map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&fields)
+
+			for key, value := range fields {
+				deal[key] = value
+			}
+		}
+
+		writeData(w, deal)
+	})
+}
+
+func writeData(w http.ResponseWriter, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{"success": true, "data": data})
+}
+
+func newTestEngine(client *pipedrive.Client, external Store) *Engine {
+	return &Engine{
+		Pipedrive: NewPipedriveStore(client, "deal"),
+		External:  external,
+		IDs:       NewMemoryIDMap(),
+		Fields:    FieldMap{"title": "name", "value": "amount"},
+	}
+}
+
+func TestEngine_Sync_toExternal(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	account := &deals{
+		deals:   map[int]map[string]interface{}{1: {"id": 1, "title": "Deal", "value": 10, "update_time": "2019-06-01 10:00:00"}},
+		changed: []int{1},
+	}
+	account.register(mux)
+
+	external := newMemoryStore()
+	engine := newTestEngine(client, external)
+
+	result, err := engine.Sync(context.Background(), time.Time{})
+
+	if err != nil {
+		t.Fatalf("Sync returned error: %v", err)
+	}
+
+	if result.ToExternal != 1 || len(result.Errors) != 0 {
+		t.Fatalf("Sync returned %+v, want 1 record written to the external store", result)
+	}
+
+	externalID, linked, _ := engine.IDs.ExternalID("1")
+
+	if !linked {
+		t.Fatal("Sync did not link the deal to the external record")
+	}
+
+	record := external.records[externalID]
+
+	if record.Fields["name"] != "Deal" || record.Fields["amount"] != float64(10) {
+		t.Errorf("Sync wrote %v, want the mapped fields of the deal", record.Fields)
+	}
+
+	// The deal is listed again, but the external record has its fields.
+	if result, err := engine.Sync(context.Background(), time.Time{}); err != nil || result.Unchanged != 1 || external.puts != 1 {
+		t.Errorf("Second Sync returned %+v, %v with %v writes, want the deal unchanged", result, err, external.puts)
+	}
+}
+
+func TestEngine_Sync_toPipedrive(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	account := &deals{deals: map[int]map[string]interface{}{1: {"id": 1, "title": "Deal", "value": 10}}}
+	account.register(mux)
+
+	external := newMemoryStore()
+	external.records["x1"] = Record{ID: "x1", Fields: map[string]interface{}{"name": "Renamed", "amount": 10}}
+	external.changed = []string{"x1"}
+
+	engine := newTestEngine(client, external)
+	engine.IDs.Link("1", "x1")
+
+	result, err := engine.Sync(context.Background(), time.Time{})
+
+	if err != nil {
+		t.Fatalf("Sync returned error: %v", err)
+	}
+
+	if result.ToPipedrive != 1 || len(result.Errors) != 0 {
+		t.Fatalf("Sync returned %+v, want 1 record written to Pipedrive", result)
+	}
+
+	if title := account.deals[1]["title"]; title != "Renamed" {
+		t.Errorf("Sync left the title %v, want Renamed", title)
+	}
+}
+
+func TestEngine_Sync_conflict(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	account := &deals{
+		deals:   map[int]map[string]interface{}{1: {"id": 1, "title": "Pipedrive", "value": 10}},
+		changed: []int{1},
+	}
+	account.register(mux)
+
+	external := newMemoryStore()
+	external.records["x1"] = Record{ID: "x1", Fields: map[string]interface{}{"name": "External", "amount": 10}}
+	external.changed = []string{"x1"}
+
+	engine := newTestEngine(client, external)
+	engine.IDs.Link("1", "x1")
+	engine.Resolve = PreferExternal
+
+	result, err := engine.Sync(context.Background(), time.Time{})
+
+	if err != nil {
+		t.Fatalf("Sync returned error: %v", err)
+	}
+
+	if result.Conflicts != 1 || len(result.Errors) != 0 {
+		t.Fatalf("Sync returned %+v, want 1 conflict", result)
+	}
+
+	if title := account.deals[1]["title"]; title != "External" {
+		t.Errorf("Sync left the title %v, want the external name", title)
+	}
+
+	// The external record already holds the merged fields.
+	if external.puts != 0 {
+		t.Errorf("Sync wrote the external record %v times, want 0", external.puts)
+	}
+}
+
+func TestEngine_Shutdown(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	(&deals{}).register(mux)
+
+	synced := make(chan struct{}, 1)
+	engine := newTestEngine(client, newMemoryStore())
+	engine.Interval = time.Hour
+	engine.OnSync = func(result *Result, err error) {
+		if err != nil {
+			t.Errorf("Sync returned error: %v", err)
+		}
+
+		synced <- struct{}{}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := engine.Start(ctx); err != nil {
+		t.Fatalf("Start returned error: %v", err)
+	}
+
+	<-synced
+
+	// Run is waiting for the next sync an hour later.
+	if err := engine.Shutdown(ctx); err != nil {
+		t.Errorf("Shutdown returned error: %v", err)
+	}
+
+	if err := engine.Start(ctx); err != pipedrive.ErrStarted {
+		t.Errorf("Start after Shutdown returned %v, want %v", err, pipedrive.ErrStarted)
+	}
+}
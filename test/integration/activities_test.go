@@ -3,10 +3,14 @@ package integration
 import (
 	"context"
 	"testing"
+
+	"github.com/genert/pipedrive-api/pipedrive"
 )
 
 func TestActivitiesService_List(t *testing.T) {
-	result, _, err := client.Activities.List(context.Background())
+	result, _, err := client.Activities.List(context.Background(), &pipedrive.ActivitiesListOptions{
+		Limit: 10,
+	})
 
 	if err != nil {
 		t.Errorf("Could not get result: %v", err)
@@ -0,0 +1,18 @@
+package integration
+
+import (
+	"context"
+	"testing"
+)
+
+func TestBillingService_ListAddons(t *testing.T) {
+	result, _, err := client.Billing.ListAddons(context.Background())
+
+	if err != nil {
+		t.Errorf("Could not get add-ons: %v", err)
+	}
+
+	if result.Success != true {
+		t.Error("Got invalid result")
+	}
+}
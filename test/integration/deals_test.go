@@ -0,0 +1,40 @@
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/genert/pipedrive-api/pipedrive"
+)
+
+func TestDealService_List(t *testing.T) {
+	result, _, err := client.Deals.List(context.Background(), &pipedrive.DealsListOptions{
+		Status: pipedrive.DealStatusOpen,
+		Limit:  10,
+	})
+
+	if err != nil {
+		t.Errorf("Could not get deals: %v", err)
+	}
+
+	if result.Success != true {
+		t.Error("Got invalid result")
+	}
+}
+
+func TestDealService_Search(t *testing.T) {
+	result, _, err := client.Deals.Search(context.Background(), &pipedrive.DealsSearchOptions{
+		Term:          "deal",
+		Fields:        pipedrive.Fields{"title"},
+		IncludeFields: pipedrive.Fields{"deal.cc_email"},
+		Limit:         10,
+	})
+
+	if err != nil {
+		t.Errorf("Could not search deals: %v", err)
+	}
+
+	if result.Success != true {
+		t.Error("Got invalid result")
+	}
+}
@@ -0,0 +1,22 @@
+package integration
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFiltersService_GetHelpers(t *testing.T) {
+	result, _, err := client.Filters.GetHelpers(context.Background())
+
+	if err != nil {
+		t.Errorf("Could not get filter helpers: %v", err)
+	}
+
+	if result.Success != true {
+		t.Error("Got invalid result")
+	}
+
+	if len(result.Data.Operators) == 0 {
+		t.Error("Got no filter operators")
+	}
+}
@@ -0,0 +1,23 @@
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/genert/pipedrive-api/pipedrive"
+)
+
+func TestMailThreadsService_List(t *testing.T) {
+	result, _, err := client.MailThreads.List(context.Background(), &pipedrive.MailThreadsListOptions{
+		Folder: pipedrive.MailFolderInbox,
+		Limit:  10,
+	})
+
+	if err != nil {
+		t.Errorf("Could not get mail threads: %v", err)
+	}
+
+	if result.Success != true {
+		t.Error("Got invalid result")
+	}
+}
@@ -0,0 +1,18 @@
+package integration
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNoteFieldsService_List(t *testing.T) {
+	result, _, err := client.NoteFields.List(context.Background())
+
+	if err != nil {
+		t.Errorf("Could not get note fields: %v", err)
+	}
+
+	if result.Success != true {
+		t.Error("Got invalid result")
+	}
+}
@@ -0,0 +1,22 @@
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/genert/pipedrive-api/pipedrive"
+)
+
+func TestNotesService_List(t *testing.T) {
+	result, _, err := client.Notes.ListWithOptions(context.Background(), &pipedrive.NotesListOptions{
+		Limit: 10,
+	})
+
+	if err != nil {
+		t.Errorf("Could not get notes: %v", err)
+	}
+
+	if result.Success != true {
+		t.Error("Got invalid result")
+	}
+}
@@ -0,0 +1,34 @@
+package integration
+
+import (
+	"context"
+	"testing"
+)
+
+func TestUsersService_Me(t *testing.T) {
+	result, _, err := client.Users.Me(context.Background())
+
+	if err != nil {
+		t.Errorf("Could not get current user: %v", err)
+	}
+
+	if result.Success != true {
+		t.Error("Got invalid result")
+	}
+
+	if result.Data.ID == 0 || result.Data.CompanyID == 0 {
+		t.Error("Got current user without user or company ID")
+	}
+}
+
+func TestUsersService_ListConnections(t *testing.T) {
+	result, _, err := client.Users.ListConnections(context.Background())
+
+	if err != nil {
+		t.Errorf("Could not get user connections: %v", err)
+	}
+
+	if result.Success != true {
+		t.Error("Got invalid result")
+	}
+}